@@ -33,6 +33,7 @@ func main() {
 	app := cli2.NewApp(ctn)
 	// Инициализация команд приложения
 	addressCli.RegisterImportCliEndpoint(app)
+	addressCli.RegisterNormalizeCliEndpoint(app)
 	indexCli.RegisterIndexCliEndpoint(app)
 	versionCli.RegisterVersionCliEndpoint(app)
 	osmCli.RegisterOsmCliEndpoint(app)
@@ -13,6 +13,8 @@ type Handler struct {
 	importService *service2.ImportService    // Сервис импорта
 	osmService    *osmService.OsmService     // Сервис OSM
 	logger        interfaces.LoggerInterface // Логгер
+	ReportFormat  string                     // Формат отчета об изменениях
+	ReportFile    string                     // Путь к файлу отчета об изменениях
 }
 
 // Инициализация обработчика
@@ -25,24 +27,42 @@ func NewHandler(s *service2.ImportService, osm *osmService.OsmService, logger in
 }
 
 // Проверка обновлений
-func (h *Handler) CheckUpdates(fiasApi *fiasApiService.FiasApiService, versionService *versionService.VersionService) {
+func (h *Handler) CheckUpdates(fiasApi *fiasApiService.FiasApiService, versionService *versionService.VersionService) error {
 	// Получает последнюю загруженную версию
 	v := versionService.GetLastVersionInfo()
 	h.logger.WithFields(interfaces.LoggerFields{
 		"version": v,
 	}).Debug("Last version info")
 
-	if v != nil {
+	// Загружает журнал прерванного импорта
+	if h.importService.Resume {
+		h.importService.LoadJournal()
+	}
+	// Проверяет список регионов импорта
+	if err := h.importService.PrepareRegions(v); err != nil {
+		return err
+	}
+
+	if h.importService.IsLocalImport() {
+		// Импорт из локального архива или директории
+		h.importService.StartLocalImport(versionService, v)
+	} else if v != nil {
 		// Загрузка дельт
 		h.importService.StartDeltaImport(fiasApi, versionService, v)
 	} else {
 		// Загрузка полного импорта
 		h.importService.StartFullImport(fiasApi, versionService)
 	}
+	// Выводит отчет об изменениях без индексации
+	if h.importService.DryRun {
+		return h.writeReport(h.importService.GetChangeReport())
+	}
 	// Обновление индексов
 	h.importService.Index()
 	// Обновление гео-данных
 	if !h.importService.SkipOsm {
 		h.osmService.Update()
 	}
+
+	return nil
 }
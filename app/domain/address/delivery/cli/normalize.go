@@ -0,0 +1,290 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	service2 "github.com/GarinAG/gofias/domain/address/service"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/dustin/go-humanize"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	normalizeFormatCsv   = "csv"   // Файл в формате CSV с заголовком
+	normalizeFormatJsonl = "jsonl" // Файл с JSON-объектом в каждой строке
+	// Количество строк в обработке на один поток, ограничивает буфер упорядочивания результатов
+	normalizeRowsPerWorker = 64
+)
+
+// Колонки результата нормализации, добавляемые к исходным данным
+var normalizeColumns = []string{
+	"fias_address_guid",
+	"fias_house_guid",
+	"fias_room_guid",
+	"fias_address",
+	"fias_kladr",
+	"fias_postal_code",
+	"fias_confidence",
+	"fias_remainder",
+}
+
+// Обработчик пакетной нормализации адресов
+type NormalizeHandler struct {
+	normalize func(term string) *entity.NormalizedAddress // Нормализация строки адреса
+	logger    interfaces.LoggerInterface                  // Логгер
+	Column    string                                      // Название колонки или поля с адресом
+	Format    string                                      // Формат файлов
+	Delimiter rune                                        // Разделитель колонок CSV
+	Workers   int                                         // Количество параллельных обработчиков
+}
+
+// Строка исходного файла
+type normalizeRow struct {
+	index  int                    // Порядковый номер строки
+	term   string                 // Строка адреса
+	record []string               // Колонки строки CSV
+	object map[string]interface{} // Объект строки JSONL
+	result *entity.NormalizedAddress
+}
+
+// Инициализация обработчика
+func NewNormalizeHandler(normalizeService *service2.NormalizeService, logger interfaces.LoggerInterface) *NormalizeHandler {
+	return &NormalizeHandler{
+		normalize: normalizeService.Normalize,
+		logger:    logger,
+	}
+}
+
+// Получить формат файла по расширению
+func normalizeFormatByPath(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson", ".json":
+		return normalizeFormatJsonl
+	}
+
+	return normalizeFormatCsv
+}
+
+// Нормализовать адреса из входного файла и записать результат в выходной файл
+func (h *NormalizeHandler) Normalize(inPath string, outPath string) error {
+	begin := time.Now()
+	in, err := os.Open(inPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(outPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	buffer := bufio.NewWriter(out)
+
+	var read func(rows chan<- *normalizeRow) error
+	var write func(row *normalizeRow) error
+	var flush func() error
+	if h.Format == normalizeFormatJsonl {
+		read = func(rows chan<- *normalizeRow) error { return h.readJsonl(in, rows) }
+		write = func(row *normalizeRow) error { return h.writeJsonl(buffer, row) }
+		flush = buffer.Flush
+	} else {
+		writer := csv.NewWriter(buffer)
+		writer.Comma = h.Delimiter
+		read = func(rows chan<- *normalizeRow) error { return h.readCsv(in, writer, rows) }
+		write = func(row *normalizeRow) error { return writer.Write(append(row.record, h.resultValues(row.result)...)) }
+		flush = func() error {
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+			return buffer.Flush()
+		}
+	}
+
+	// Чтение исходного файла, новая строка читается после записи одной из строк в обработке
+	slots := make(chan struct{}, h.Workers*normalizeRowsPerWorker)
+	rows := make(chan *normalizeRow)
+	results := make(chan *normalizeRow, h.Workers*2)
+	var readErr error
+	go func() {
+		source := make(chan *normalizeRow)
+		go func() {
+			readErr = read(source)
+			close(source)
+		}()
+		for row := range source {
+			slots <- struct{}{}
+			rows <- row
+		}
+		close(rows)
+	}()
+	// Нормализация адресов в нескольких потоках
+	var wg sync.WaitGroup
+	for i := 0; i < h.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range rows {
+				row.result = h.normalize(row.term)
+				results <- row
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Запись результатов в порядке исходных строк
+	var writeErr error
+	pending := make(map[int]*normalizeRow)
+	next, matched := 0, 0
+	for row := range results {
+		pending[row.index] = row
+		for {
+			current, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			if current.result.Address != nil {
+				matched++
+			}
+			if writeErr == nil {
+				writeErr = write(current)
+			}
+			<-slots
+			if next%10000 == 0 {
+				h.logger.WithFields(interfaces.LoggerFields{"count": next, "matched": matched}).Info("Normalize addresses")
+			}
+		}
+	}
+	if readErr != nil {
+		return readErr
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	h.logger.WithFields(interfaces.LoggerFields{"count": next, "matched": matched, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Normalize execution time")
+
+	return nil
+}
+
+// Прочитать строки CSV и записать заголовок выходного файла
+func (h *NormalizeHandler) readCsv(in io.Reader, writer *csv.Writer, rows chan<- *normalizeRow) error {
+	reader := csv.NewReader(bufio.NewReader(in))
+	reader.Comma = h.Delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("read csv header: %v", err)
+	}
+	column := -1
+	for i, name := range header {
+		if strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")) == h.Column {
+			column = i
+			break
+		}
+	}
+	if column < 0 {
+		return fmt.Errorf("column %q not found in csv header", h.Column)
+	}
+	if err := writer.Write(append(header, normalizeColumns...)); err != nil {
+		return err
+	}
+
+	for index := 0; ; index++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		row := &normalizeRow{index: index, record: record}
+		if column < len(record) {
+			row.term = record[column]
+		}
+		rows <- row
+	}
+}
+
+// Прочитать объекты JSONL
+func (h *NormalizeHandler) readJsonl(in io.Reader, rows chan<- *normalizeRow) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	index := 0
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.UseNumber()
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		term, _ := object[h.Column].(string)
+		rows <- &normalizeRow{index: index, term: term, object: object}
+		index++
+	}
+
+	return scanner.Err()
+}
+
+// Записать объект JSONL с результатом нормализации
+func (h *NormalizeHandler) writeJsonl(out io.Writer, row *normalizeRow) error {
+	values := h.resultValues(row.result)
+	for i, column := range normalizeColumns {
+		row.object[column] = values[i]
+	}
+	row.object["fias_confidence"] = row.result.Confidence
+	data, err := json.Marshal(row.object)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(append(data, '\n'))
+
+	return err
+}
+
+// Получить значения колонок результата нормализации
+func (h *NormalizeHandler) resultValues(result *entity.NormalizedAddress) []string {
+	values := make([]string, len(normalizeColumns))
+	values[6] = strconv.FormatFloat(result.Confidence, 'f', 2, 64)
+	values[7] = result.Remainder
+	if result.Address == nil {
+		return values
+	}
+	values[0] = result.Address.AoGuid
+	values[3] = result.Address.FullAddress
+	values[4] = result.Address.Code
+	values[5] = result.Address.PostalCode
+	if result.House != nil {
+		values[1] = result.House.HouseGuid
+		values[3] = result.House.FullAddress
+		if result.House.PostalCode != "" {
+			values[5] = result.House.PostalCode
+		}
+	}
+	if result.Room != nil {
+		values[2] = result.Room.RoomGuid
+	}
+
+	return values
+}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Нормализация, находящая адрес по строке вида "guid дом", пустая строка не находится
+func testNormalize(term string) *entity.NormalizedAddress {
+	fields := strings.Fields(term)
+	if len(fields) == 0 {
+		return &entity.NormalizedAddress{Remainder: term}
+	}
+	result := &entity.NormalizedAddress{
+		Address:    &entity.AddressObject{AoGuid: fields[0], FullAddress: "address " + fields[0], Code: "k" + fields[0], PostalCode: "630000"},
+		Confidence: 0.5,
+	}
+	if len(fields) > 1 {
+		result.House = &entity.HouseObject{HouseGuid: fields[0] + "-" + fields[1], FullAddress: "house " + fields[1]}
+		result.Confidence = 1
+	}
+
+	return result
+}
+
+// Создать временную директорию с входным файлом
+func testNormalizeFile(t *testing.T, name string, data string) (string, string, func()) {
+	dir, err := ioutil.TempDir("", "gofias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(in, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return in, filepath.Join(dir, "out"+filepath.Ext(name)), func() { os.RemoveAll(dir) }
+}
+
+// Строки CSV записываются в исходном порядке с добавленными колонками результата
+func TestNormalizeCsv(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("\ufeffid;address\n")
+	count := 500
+	for i := 0; i < count; i++ {
+		b.WriteString(strconv.Itoa(i) + ";g" + strconv.Itoa(i))
+		if i%3 == 0 {
+			b.WriteString(" " + strconv.Itoa(i))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("500;\n")
+	in, out, clean := testNormalizeFile(t, "in.csv", b.String())
+	defer clean()
+
+	handler := &NormalizeHandler{
+		normalize: func(term string) *entity.NormalizedAddress {
+			// Перемешивает порядок обработки строк
+			time.Sleep(time.Duration(len(term)%3) * time.Millisecond)
+			return testNormalize(term)
+		},
+		logger:    testLogger{},
+		Column:    "address",
+		Format:    normalizeFormatByPath(in),
+		Delimiter: ';',
+		Workers:   4,
+	}
+	if err := handler.Normalize(in, out); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	reader.Comma = ';'
+	records, err := reader.ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != count+2 {
+		t.Fatalf("got %d records, want %d", len(records), count+2)
+	}
+	if header := strings.Join(records[0], ";"); header != "\ufeffid;address;"+strings.Join(normalizeColumns, ";") {
+		t.Errorf("got header %q", header)
+	}
+	for i, record := range records[1 : count+1] {
+		guid := "g" + strconv.Itoa(i)
+		want := []string{strconv.Itoa(i), record[1], guid, "", "", "address " + guid, "k" + guid, "630000", "0.50", ""}
+		if i%3 == 0 {
+			want[3], want[5], want[8] = guid+"-"+strconv.Itoa(i), "house "+strconv.Itoa(i), "1.00"
+		}
+		if strings.Join(record, ";") != strings.Join(want, ";") {
+			t.Errorf("row %d: got %v, want %v", i, record, want)
+			break
+		}
+	}
+	if last := records[count+1]; last[2] != "" || last[8] != "0.00" {
+		t.Errorf("got empty address row %v", last)
+	}
+}
+
+// Объекты JSONL сохраняют исходные поля и получают поля результата
+func TestNormalizeJsonl(t *testing.T) {
+	in, out, clean := testNormalizeFile(t, "in.jsonl", `{"id":1,"address":"g1 7","extra":{"a":[1,2]}}
+
+{"id":12345678901234567890,"address":"g2"}
+{"id":3}
+`)
+	defer clean()
+
+	handler := &NormalizeHandler{normalize: testNormalize, logger: testLogger{}, Column: "address", Format: normalizeFormatByPath(in), Workers: 2}
+	if err := handler.Normalize(in, out); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	want := []string{
+		`{"address":"g1 7","extra":{"a":[1,2]},"fias_address":"house 7","fias_address_guid":"g1","fias_confidence":1,"fias_house_guid":"g1-7","fias_kladr":"kg1","fias_postal_code":"630000","fias_remainder":"","fias_room_guid":"","id":1}`,
+		`{"address":"g2","fias_address":"address g2","fias_address_guid":"g2","fias_confidence":0.5,"fias_house_guid":"","fias_kladr":"kg2","fias_postal_code":"630000","fias_remainder":"","fias_room_guid":"","id":12345678901234567890}`,
+		`{"fias_address":"","fias_address_guid":"","fias_confidence":0,"fias_house_guid":"","fias_kladr":"","fias_postal_code":"","fias_remainder":"","fias_room_guid":"","id":3}`,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d: got %s, want %s", i, lines[i], want[i])
+		}
+	}
+}
+
+// Пока первая строка не обработана, в обработку попадает ограниченное количество строк
+func TestNormalizeRowsInFlight(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("address\n")
+	count := 2000
+	for i := 0; i < count; i++ {
+		b.WriteString("g" + strconv.Itoa(i) + "\n")
+	}
+	in, out, clean := testNormalizeFile(t, "in.csv", b.String())
+	defer clean()
+
+	var mu sync.Mutex
+	started := 0
+	startedBeforeFirst := 0
+	handler := &NormalizeHandler{
+		normalize: func(term string) *entity.NormalizedAddress {
+			mu.Lock()
+			started++
+			mu.Unlock()
+			if term == "g0" {
+				time.Sleep(200 * time.Millisecond)
+				mu.Lock()
+				startedBeforeFirst = started
+				mu.Unlock()
+			}
+			return testNormalize(term)
+		},
+		logger:    testLogger{},
+		Column:    "address",
+		Delimiter: ',',
+		Workers:   2,
+	}
+	if err := handler.Normalize(in, out); err != nil {
+		t.Fatal(err)
+	}
+	if limit := handler.Workers * normalizeRowsPerWorker; startedBeforeFirst > limit {
+		t.Errorf("got %d rows in flight, want at most %d", startedBeforeFirst, limit)
+	}
+	file, err := os.Open(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	lines := 0
+	for scanner := bufio.NewScanner(file); scanner.Scan(); {
+		lines++
+	}
+	if lines != count+1 {
+		t.Errorf("got %d lines, want %d", lines, count+1)
+	}
+}
+
+// Значения колонок результата для адреса, дома и помещения
+func TestNormalizeResultValues(t *testing.T) {
+	address := &entity.AddressObject{AoGuid: "a", FullAddress: "ул Ленина", Code: "54000001000000100", PostalCode: "630000"}
+	tests := []struct {
+		name   string
+		result *entity.NormalizedAddress
+		values []string
+	}{
+		{"not found", &entity.NormalizedAddress{Remainder: "абв"}, []string{"", "", "", "", "", "", "0.00", "абв"}},
+		{"address", &entity.NormalizedAddress{Address: address, Confidence: 0.456, Remainder: "кв"}, []string{"a", "", "", "ул Ленина", "54000001000000100", "630000", "0.46", "кв"}},
+		{"house without postal code", &entity.NormalizedAddress{Address: address, House: &entity.HouseObject{HouseGuid: "h", FullAddress: "ул Ленина, д 1"}, Confidence: 1},
+			[]string{"a", "h", "", "ул Ленина, д 1", "54000001000000100", "630000", "1.00", ""}},
+		{"room", &entity.NormalizedAddress{Address: address, House: &entity.HouseObject{HouseGuid: "h", FullAddress: "ул Ленина, д 1", PostalCode: "630001"}, Room: &entity.RoomObject{RoomGuid: "r"}, Confidence: 1},
+			[]string{"a", "h", "r", "ул Ленина, д 1", "54000001000000100", "630001", "1.00", ""}},
+	}
+	handler := &NormalizeHandler{}
+	for _, test := range tests {
+		values := handler.resultValues(test.result)
+		if strings.Join(values, "|") != strings.Join(test.values, "|") {
+			t.Errorf("%s: got %v, want %v", test.name, values, test.values)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	reportFormatTable = "table" // Отчет в виде таблицы
+	reportFormatJson  = "json"  // Отчет в формате JSON
+)
+
+// Вывести отчет об изменениях в консоль или файл
+func (h *Handler) writeReport(report *entity.ChangeReport) error {
+	var out io.Writer = os.Stdout
+	if h.ReportFile != "" {
+		f, err := os.Create(h.ReportFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if h.ReportFormat == reportFormatJson {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(report)
+	}
+
+	return writeReportTable(out, report)
+}
+
+// Вывести отчет об изменениях в виде таблицы
+func writeReportTable(out io.Writer, report *entity.ChangeReport) error {
+	versions := make([]string, 0, len(report.Versions))
+	for _, version := range report.Versions {
+		versions = append(versions, fmt.Sprint(version))
+	}
+	fmt.Fprintf(out, "Versions: %s\n\n", strings.Join(versions, ", "))
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REGION\tOBJECTS\t"+strings.ToUpper(strings.Join(entity.ChangeTypes, "\t")))
+	for _, region := range report.Regions {
+		writeReportRow(w, region.Region, "addresses", region.Addresses)
+		writeReportRow(w, region.Region, "houses", region.Houses)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// Выводит примеры измененных объектов
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(out)
+	fmt.Fprintln(w, "REGION\tOBJECTS\tCHANGE\tGUID\tNAME")
+	for _, region := range report.Regions {
+		writeReportSamples(w, region.Region, "addresses", region.Addresses)
+		writeReportSamples(w, region.Region, "houses", region.Houses)
+	}
+
+	return w.Flush()
+}
+
+// Вывести строку количества изменений
+func writeReportRow(w io.Writer, region string, objects string, counters map[string]*entity.ChangeCounter) {
+	row := []string{region, objects}
+	for _, changeType := range entity.ChangeTypes {
+		row = append(row, fmt.Sprint(counters[changeType].Count))
+	}
+	fmt.Fprintln(w, strings.Join(row, "\t"))
+}
+
+// Вывести примеры измененных объектов
+func writeReportSamples(w io.Writer, region string, objects string, counters map[string]*entity.ChangeCounter) {
+	for _, changeType := range entity.ChangeTypes {
+		for _, sample := range counters[changeType].Samples {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", region, objects, changeType, sample.Guid, sample.Name)
+		}
+	}
+}
@@ -1,8 +1,14 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	service2 "github.com/GarinAG/gofias/domain/address/service"
 	cli2 "github.com/GarinAG/gofias/infrastructure/persistence/cli"
+	"github.com/GarinAG/gofias/util"
 	"github.com/urfave/cli/v2"
+	"runtime"
+	"time"
 )
 
 // Регистрация команды импорта
@@ -18,6 +24,18 @@ func RegisterImportCliEndpoint(app *cli2.App) {
 				Value: false,
 				Usage: "Skip houses import",
 			},
+			// Флаг пропуска помещений
+			&cli.BoolFlag{
+				Name:  "skip-rooms",
+				Value: false,
+				Usage: "Skip rooms import",
+			},
+			// Флаг пропуска земельных участков
+			&cli.BoolFlag{
+				Name:  "skip-steads",
+				Value: false,
+				Usage: "Skip steads import",
+			},
 			// Флаг запрета очистки временной директории
 			&cli.BoolFlag{
 				Name:  "skip-clear",
@@ -30,14 +48,206 @@ func RegisterImportCliEndpoint(app *cli2.App) {
 				Value: false,
 				Usage: "Skip osm update",
 			},
+			// Флаг импорта данных в формате ГАР
+			&cli.BoolFlag{
+				Name:  "gar",
+				Value: false,
+				Usage: "Use GAR XML format",
+			},
+			// Флаг продолжения прерванного импорта
+			&cli.BoolFlag{
+				Name:  "resume",
+				Value: false,
+				Usage: "Resume interrupted import from the journal",
+			},
+			// Список регионов импорта
+			&cli.StringFlag{
+				Name:  "regions",
+				Usage: "Comma-separated region codes to import, e.g. 77,50",
+			},
+			// Путь к локальному архиву
+			&cli.StringFlag{
+				Name:  "from-file",
+				Usage: "Import from local archive instead of FIAS API",
+			},
+			// Путь к директории с распакованными файлами
+			&cli.StringFlag{
+				Name:  "from-dir",
+				Usage: "Import from directory with extracted files instead of FIAS API",
+			},
+			// Номер версии локальных файлов
+			&cli.IntFlag{
+				Name:  "version-id",
+				Usage: "Version id of local files (required with --from-file or --from-dir)",
+			},
+			// Дата версии локальных файлов
+			&cli.StringFlag{
+				Name:  "version-date",
+				Usage: "Version date of local files in YYYY-MM-DD format (required with --from-file or --from-dir)",
+			},
+			// Флаг проверки изменений без записи в БД
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Value: false,
+				Usage: "Compare import files with the current storage and print a change report without writing",
+			},
+			// Формат отчета об изменениях
+			&cli.StringFlag{
+				Name:  "report-format",
+				Value: reportFormatTable,
+				Usage: "Change report format: table or json",
+			},
+			// Количество примеров объектов в отчете
+			&cli.IntFlag{
+				Name:  "report-samples",
+				Value: 5,
+				Usage: "Number of sample objects for each change type in the report",
+			},
+			// Путь к файлу отчета
+			&cli.StringFlag{
+				Name:  "report-file",
+				Usage: "Write change report to file instead of stdout",
+			},
 		},
 		Action: func(c *cli.Context) error {
+			if err := prepareLocalImport(c, app.ImportService); err != nil {
+				return err
+			}
+			if err := prepareDryRun(c, app.ImportService, h); err != nil {
+				return err
+			}
 			app.ImportService.SkipHouses = c.Bool("skip-houses")
+			app.ImportService.SkipRooms = c.Bool("skip-rooms")
+			app.ImportService.SkipSteads = c.Bool("skip-steads")
+			prepareGarImport(c, app)
 			app.ImportService.SkipClear = c.Bool("skip-clear")
 			app.ImportService.SkipOsm = c.Bool("skip-osm")
+			app.ImportService.IsGar = c.Bool("gar")
+			app.ImportService.Resume = c.Bool("resume")
+			app.ImportService.Regions = util.SplitRegions(c.String("regions"))
 
-			h.CheckUpdates(app.FiasApiService, app.VersionService)
-			return nil
+			return h.CheckUpdates(app.FiasApiService, app.VersionService)
 		},
 	})
 }
+
+// Регистрация команды пакетной нормализации адресов
+func RegisterNormalizeCliEndpoint(app *cli2.App) {
+	h := NewNormalizeHandler(app.NormalizeService, app.Logger)
+	app.Server.Commands = append(app.Server.Commands, &cli.Command{
+		Name:  "normalize",
+		Usage: "Normalize addresses from CSV or JSONL file",
+		Flags: []cli.Flag{
+			// Путь к исходному файлу
+			&cli.StringFlag{
+				Name:     "in",
+				Required: true,
+				Usage:    "Input CSV or JSONL file",
+			},
+			// Путь к файлу результата
+			&cli.StringFlag{
+				Name:     "out",
+				Required: true,
+				Usage:    "Output file in the input format with appended fias_* columns",
+			},
+			// Колонка с адресом
+			&cli.StringFlag{
+				Name:  "column",
+				Value: "address",
+				Usage: "CSV column or JSON field with address string",
+			},
+			// Формат файлов
+			&cli.StringFlag{
+				Name:  "format",
+				Usage: "File format: csv or jsonl, detected by input file extension by default",
+			},
+			// Разделитель колонок CSV
+			&cli.StringFlag{
+				Name:  "delimiter",
+				Value: ",",
+				Usage: "CSV column delimiter",
+			},
+			// Количество параллельных обработчиков
+			&cli.IntFlag{
+				Name:  "workers",
+				Value: runtime.NumCPU(),
+				Usage: "Number of parallel workers",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			h.Format = c.String("format")
+			if h.Format == "" {
+				h.Format = normalizeFormatByPath(c.String("in"))
+			}
+			if h.Format != normalizeFormatCsv && h.Format != normalizeFormatJsonl {
+				return fmt.Errorf("unknown format %q, use csv or jsonl", h.Format)
+			}
+			delimiter := []rune(c.String("delimiter"))
+			if len(delimiter) != 1 {
+				return errors.New("flag --delimiter must be a single character")
+			}
+			h.Delimiter = delimiter[0]
+			h.Column = c.String("column")
+			h.Workers = c.Int("workers")
+			if h.Workers < 1 {
+				h.Workers = 1
+			}
+
+			return h.Normalize(c.String("in"), c.String("out"))
+		},
+	})
+}
+
+// Проверить и установить параметры импорта из локальных файлов
+func prepareLocalImport(c *cli.Context, importService *service2.ImportService) error {
+	fromFile := c.String("from-file")
+	fromDir := c.String("from-dir")
+	if fromFile == "" && fromDir == "" {
+		return nil
+	}
+	if fromFile != "" && fromDir != "" {
+		return errors.New("flags --from-file and --from-dir can not be used together")
+	}
+	if c.Int("version-id") <= 0 {
+		return errors.New("flag --version-id is required for local import")
+	}
+	versionDate, err := time.Parse("2006-01-02", c.String("version-date"))
+	if err != nil {
+		return errors.New("flag --version-date is required for local import in YYYY-MM-DD format")
+	}
+	importService.FromFile = fromFile
+	importService.FromDir = fromDir
+	importService.VersionId = c.Int("version-id")
+	importService.VersionDate = versionDate
+
+	return nil
+}
+
+// Подготовить параметры импорта в формате ГАР: помещения и участки загружаются только из выгрузки ФИАС
+func prepareGarImport(c *cli.Context, app *cli2.App) {
+	if !c.Bool("gar") || (app.ImportService.SkipRooms && app.ImportService.SkipSteads) {
+		return
+	}
+	app.Logger.Warn("Rooms and steads are not supported in GAR format and will be skipped")
+	app.ImportService.SkipRooms = true
+	app.ImportService.SkipSteads = true
+}
+
+// Проверить и установить параметры проверки изменений без записи в БД
+func prepareDryRun(c *cli.Context, importService *service2.ImportService, h *Handler) error {
+	if !c.Bool("dry-run") {
+		return nil
+	}
+	if c.Bool("resume") {
+		return errors.New("flags --dry-run and --resume can not be used together")
+	}
+	format := c.String("report-format")
+	if format != reportFormatTable && format != reportFormatJson {
+		return fmt.Errorf("unknown report format %q, use table or json", format)
+	}
+	importService.EnableDryRun(c.Int("report-samples"))
+	h.ReportFormat = format
+	h.ReportFile = c.String("report-file")
+
+	return nil
+}
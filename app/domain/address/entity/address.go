@@ -1,55 +1,134 @@
 package entity
 
+import "time"
+
 // Объект адреса
 type AddressObject struct {
-	ID              string `xml:"AOID,attr"`
-	AoGuid          string `xml:"AOGUID,attr"`
-	ParentGuid      string `xml:"PARENTGUID,attr"`
-	FormalName      string `xml:"FORMALNAME,attr"`
-	ShortName       string `xml:"SHORTNAME,attr"`
-	AoLevel         int    `xml:"AOLEVEL,attr"`
-	OffName         string `xml:"OFFNAME,attr"`
-	Code            string `xml:"CODE,attr"`
-	RegionCode      string `xml:"REGIONCODE,attr"`
-	PostalCode      string `xml:"POSTALCODE,attr"`
-	Okato           string `xml:"OKATO,attr"`
-	Oktmo           string `xml:"OKTMO,attr"`
-	ActStatus       string `xml:"ACTSTATUS,attr"`
-	LiveStatus      string `xml:"LIVESTATUS,attr"`
-	CurrStatus      string `xml:"CURRSTATUS,attr"`
-	StartDate       string `xml:"STARTDATE,attr"`
-	EndDate         string `xml:"ENDDATE,attr"`
-	UpdateDate      string `xml:"UPDATEDATE,attr"`
-	FullName        string
-	RegionGuid      string
-	RegionKladr     string
-	Region          string
-	RegionType      string
-	RegionFull      string
-	AreaGuid        string
-	AreaKladr       string
-	Area            string
-	AreaType        string
-	AreaFull        string
-	CityGuid        string
-	CityKladr       string
-	City            string
-	CityType        string
-	CityFull        string
-	SettlementGuid  string
-	SettlementKladr string
-	Settlement      string
-	SettlementType  string
-	SettlementFull  string
-	StreetGuid      string
-	StreetKladr     string
-	Street          string
-	StreetType      string
-	StreetFull      string
-	AddressSuggest  string
-	FullAddress     string
-	Location        string
-	BazisUpdateDate string
+	ID                string `xml:"AOID,attr"`
+	AoGuid            string `xml:"AOGUID,attr"`
+	ParentGuid        string `xml:"PARENTGUID,attr"`
+	MunParentGuid     string
+	FormalName        string `xml:"FORMALNAME,attr"`
+	ShortName         string `xml:"SHORTNAME,attr"`
+	AoLevel           int    `xml:"AOLEVEL,attr"`
+	OffName           string `xml:"OFFNAME,attr"`
+	Code              string `xml:"CODE,attr"`
+	RegionCode        string `xml:"REGIONCODE,attr"`
+	PostalCode        string `xml:"POSTALCODE,attr"`
+	Okato             string `xml:"OKATO,attr"`
+	Oktmo             string `xml:"OKTMO,attr"`
+	ActStatus         string `xml:"ACTSTATUS,attr"`
+	LiveStatus        string `xml:"LIVESTATUS,attr"`
+	CurrStatus        string `xml:"CURRSTATUS,attr"`
+	StartDate         string `xml:"STARTDATE,attr"`
+	EndDate           string `xml:"ENDDATE,attr"`
+	UpdateDate        string `xml:"UPDATEDATE,attr"`
+	PrevId            string `xml:"PREVID,attr"`
+	NextId            string `xml:"NEXTID,attr"`
+	VersionId         string // Идентификатор версии записи ГАР, версии объекта ГАР имеют общий ID
+	CentStatus        int    `xml:"CENTSTATUS,attr"`
+	Population        int
+	FullName          string
+	RegionGuid        string
+	RegionKladr       string
+	Region            string
+	RegionType        string
+	RegionFull        string
+	AreaGuid          string
+	AreaKladr         string
+	Area              string
+	AreaType          string
+	AreaFull          string
+	CityGuid          string
+	CityKladr         string
+	City              string
+	CityType          string
+	CityFull          string
+	SettlementGuid    string
+	SettlementKladr   string
+	Settlement        string
+	SettlementType    string
+	SettlementFull    string
+	StreetGuid        string
+	StreetKladr       string
+	Street            string
+	StreetType        string
+	StreetFull        string
+	AddressSuggest    string
+	FullAddress       string
+	MunAddressSuggest string
+	MunFullAddress    string
+	Location          string
+	GeoPrecision      string // Точность координат, не хранится в БД
+	BazisUpdateDate   string
+}
+
+// Применить к объекту выбранную иерархию
+func (a *AddressObject) ApplyHierarchy(hierarchy string) {
+	if hierarchy != MunHierarchy {
+		return
+	}
+	if a.MunParentGuid != "" {
+		a.ParentGuid = a.MunParentGuid
+	}
+	if a.MunFullAddress != "" {
+		a.FullAddress = a.MunFullAddress
+	}
+	if a.MunAddressSuggest != "" {
+		a.AddressSuggest = a.MunAddressSuggest
+	}
+}
+
+// Получить точность собственных координат объекта по его уровню.
+// Уровень дома имеют только дома, преобразованные в адрес для ответа
+func (a AddressObject) GetLocationPrecision() string {
+	switch a.AoLevel {
+	case 8:
+		return PrecisionHouse
+	case 1, 2, 3, 4:
+		return PrecisionCity
+	case 35, 5, 6:
+		return PrecisionSettlement
+	}
+
+	return PrecisionStreet
+}
+
+// Получить GUID родительских объектов, координаты которых используются при отсутствии собственных, от ближайшего к дальнему
+func (a AddressObject) GetLocationParentGuids() []string {
+	var guids []string
+	for _, guid := range []string{a.StreetGuid, a.ParentGuid, a.SettlementGuid, a.CityGuid, a.RegionGuid} {
+		if guid != "" && guid != a.AoGuid {
+			guids = append(guids, guid)
+		}
+	}
+
+	return guids
+}
+
+// Получить идентификатор версии записи, на который ссылаются PrevId и NextId
+func (a AddressObject) GetVersionId() string {
+	if a.VersionId != "" {
+		return a.VersionId
+	}
+
+	return a.ID
+}
+
+// Проверить актуальность записи адреса
+func (a AddressObject) IsActive() bool {
+	return a.CurrStatus == "0" && a.ActStatus == "1" && a.LiveStatus == "1"
+}
+
+// Проверить, действовала ли запись адреса на указанную дату
+func (a AddressObject) IsActualAt(date time.Time) bool {
+	start, err := time.Parse("2006-01-02", a.StartDate)
+	if err != nil || start.After(date) {
+		return false
+	}
+	end, err := time.Parse("2006-01-02", a.EndDate)
+
+	return err == nil && end.After(date)
 }
 
 // Получить название файла импорта
@@ -57,7 +136,32 @@ func (a AddressObject) GetXmlFile() string {
 	return "AS_ADDROBJ_"
 }
 
+// Получить название файла импорта ГАР
+func (a AddressObject) GetGarXmlFile() string {
+	return "AS_ADDR_OBJ_[0-9]"
+}
+
+// Получить название файла параметров ГАР
+func (a AddressObject) GetGarParamsXmlFile() string {
+	return "AS_ADDR_OBJ_PARAMS_"
+}
+
 // Получить название таблицы в БД
 func (a AddressObject) TableName() string {
 	return "fias_address"
 }
+
+// Получить название таблицы истории в БД
+func (a AddressObject) HistoryTableName() string {
+	return "fias_address_history"
+}
+
+// Получить название таблицы версий со ссылками на предыдущие и следующие записи в БД
+func (a AddressObject) LinkTableName() string {
+	return "fias_address_link"
+}
+
+// Проверить наличие ссылок на предыдущую или следующую версию
+func (a AddressObject) HasLinks() bool {
+	return a.PrevId != "" || a.NextId != ""
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"testing"
+)
+
+// Точность координат определяется по уровню объекта
+func TestGetLocationPrecision(t *testing.T) {
+	tests := []struct {
+		level     int
+		precision string
+	}{
+		{1, PrecisionCity},
+		{2, PrecisionCity},
+		{3, PrecisionCity},
+		{35, PrecisionSettlement},
+		{4, PrecisionCity},
+		{5, PrecisionSettlement},
+		{6, PrecisionSettlement},
+		{65, PrecisionStreet},
+		{7, PrecisionStreet},
+		{75, PrecisionStreet},
+		{8, PrecisionHouse},
+		{90, PrecisionStreet},
+		{91, PrecisionStreet},
+	}
+	for _, test := range tests {
+		if precision := (AddressObject{AoLevel: test.level}).GetLocationPrecision(); precision != test.precision {
+			t.Errorf("level %d: got %q, want %q", test.level, precision, test.precision)
+		}
+	}
+}
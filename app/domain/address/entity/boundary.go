@@ -0,0 +1,99 @@
+package entity
+
+// Мультиполигон: список полигонов из внешнего контура и отверстий, точки в формате GeoJSON [долгота, широта]
+type MultiPolygon [][][][2]float64
+
+// Граница административного объекта из OSM
+type Boundary struct {
+	ID         string       // Идентификатор отношения OSM
+	AoGuid     string       // GUID объекта ФИАС
+	AoLevel    int          // Уровень объекта ФИАС
+	AdminLevel int          // Административный уровень OSM (admin_level)
+	Name       string       // Название границы в OSM
+	Polygons   MultiPolygon // Контуры границы
+}
+
+// Получить название таблицы границ в БД
+func (b Boundary) TableName() string {
+	return "fias_boundaries"
+}
+
+// Добавить отверстие в полигон, внешний контур которого содержит отверстие
+func (m MultiPolygon) AddHole(ring [][2]float64) bool {
+	if len(ring) == 0 {
+		return false
+	}
+	for i, polygon := range m {
+		if len(polygon) > 0 && ringContains(polygon[0], ring[0][1], ring[0][0]) {
+			m[i] = append(polygon, ring)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Проверить, что точка находится внутри мультиполигона и не попадает в отверстия
+func (m MultiPolygon) Contains(lat float64, lon float64) bool {
+	for _, polygon := range m {
+		if len(polygon) == 0 || !ringContains(polygon[0], lat, lon) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if ringContains(hole, lat, lon) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Получить прямоугольник, содержащий мультиполигон
+func (m MultiPolygon) Bound() (minLat float64, minLon float64, maxLat float64, maxLon float64) {
+	first := true
+	for _, polygon := range m {
+		if len(polygon) == 0 {
+			continue
+		}
+		for _, point := range polygon[0] {
+			if first {
+				minLon, minLat, maxLon, maxLat = point[0], point[1], point[0], point[1]
+				first = false
+				continue
+			}
+			if point[0] < minLon {
+				minLon = point[0]
+			}
+			if point[0] > maxLon {
+				maxLon = point[0]
+			}
+			if point[1] < minLat {
+				minLat = point[1]
+			}
+			if point[1] > maxLat {
+				maxLat = point[1]
+			}
+		}
+	}
+
+	return
+}
+
+// Проверить, что точка находится внутри контура, методом трассировки луча
+func ringContains(ring [][2]float64, lat float64, lon float64) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		if (ring[i][1] > lat) != (ring[j][1] > lat) &&
+			lon < (ring[j][0]-ring[i][0])*(lat-ring[i][1])/(ring[j][1]-ring[i][1])+ring[i][0] {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
@@ -0,0 +1,51 @@
+package entity
+
+import "testing"
+
+// Квадрат со стороной size и левым нижним углом в точке (lon, lat), точки в формате GeoJSON
+func testSquare(lon float64, lat float64, size float64) [][2]float64 {
+	return [][2]float64{{lon, lat}, {lon + size, lat}, {lon + size, lat + size}, {lon, lat + size}, {lon, lat}}
+}
+
+// Обратный порядок обхода контура
+func testReversed(ring [][2]float64) [][2]float64 {
+	reversed := make([][2]float64, len(ring))
+	for i, point := range ring {
+		reversed[len(ring)-1-i] = point
+	}
+
+	return reversed
+}
+
+func TestMultiPolygonContains(t *testing.T) {
+	holed := MultiPolygon{{testSquare(0, 0, 10)}}
+	if !holed.AddHole(testSquare(4, 4, 2)) {
+		t.Fatal("hole inside outer ring was not added")
+	}
+	if holed.AddHole(testSquare(20, 20, 2)) {
+		t.Error("hole outside outer ring was added")
+	}
+	tests := []struct {
+		name     string
+		polygons MultiPolygon
+		lat, lon float64
+		contains bool
+	}{
+		{"closed ring inside", MultiPolygon{{testSquare(0, 0, 10)}}, 5, 5, true},
+		{"closed ring outside", MultiPolygon{{testSquare(0, 0, 10)}}, 15, 5, false},
+		{"open ring inside", MultiPolygon{{testSquare(0, 0, 10)[:4]}}, 5, 5, true},
+		{"open ring outside", MultiPolygon{{testSquare(0, 0, 10)[:4]}}, 5, 15, false},
+		{"reversed ring inside", MultiPolygon{{testReversed(testSquare(0, 0, 10))}}, 5, 5, true},
+		{"reversed ring outside", MultiPolygon{{testReversed(testSquare(0, 0, 10))}}, -1, 5, false},
+		{"outside hole", holed, 2, 2, true},
+		{"inside hole", holed, 5, 5, false},
+		{"second polygon", MultiPolygon{{testSquare(0, 0, 1)}, {testSquare(10, 10, 1)}}, 10.5, 10.5, true},
+		{"between polygons", MultiPolygon{{testSquare(0, 0, 1)}, {testSquare(10, 10, 1)}}, 5, 5, false},
+		{"empty polygon", MultiPolygon{{}}, 0, 0, false},
+	}
+	for _, test := range tests {
+		if contains := test.polygons.Contains(test.lat, test.lon); contains != test.contains {
+			t.Errorf("%s: Contains(%v, %v) = %v, want %v", test.name, test.lat, test.lon, contains, test.contains)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package entity
+
+import "fmt"
+
+const (
+	ChangeInserted    = "inserted"    // Новый объект
+	ChangeUpdated     = "updated"     // Изменение существующего объекта
+	ChangeDeactivated = "deactivated" // Объект стал неактуальным
+	ChangeDeleted     = "deleted"     // Объект ликвидирован
+)
+
+// Типы изменений в порядке вывода
+var ChangeTypes = []string{ChangeInserted, ChangeUpdated, ChangeDeactivated, ChangeDeleted}
+
+// Пример измененного объекта
+type ChangeSample struct {
+	Guid string `json:"guid"`
+	Name string `json:"name"`
+}
+
+// Количество и примеры изменений одного типа
+type ChangeCounter struct {
+	Count   int            `json:"count"`
+	Samples []ChangeSample `json:"samples,omitempty"`
+}
+
+// Изменения адресов и домов региона по типам изменений
+type RegionChanges struct {
+	Region    string                    `json:"region"`
+	Addresses map[string]*ChangeCounter `json:"addresses"`
+	Houses    map[string]*ChangeCounter `json:"houses"`
+}
+
+// Отчет об изменениях импорта
+type ChangeReport struct {
+	Versions []int            `json:"versions"`
+	Regions  []*RegionChanges `json:"regions"`
+}
+
+const (
+	ChangeObjectAddress = "address" // Адресный объект
+	ChangeObjectHouse   = "house"   // Дом
+)
+
+// Запись журнала изменений
+type ChangeLogItem struct {
+	ID         string `json:"id"`          // Идентификатор записи, записи упорядочены по версии
+	VersionId  int    `json:"version_id"`  // Версия ФИАС, в которой изменен объект
+	ObjectType string `json:"object_type"` // Тип объекта
+	Guid       string `json:"guid"`        // GUID объекта
+	ChangeType string `json:"change_type"` // Тип изменения
+	RegionCode string `json:"region_code"` // Код региона
+}
+
+// Создать запись журнала изменений
+func NewChangeLogItem(versionId int, objectType string, guid string, changeType string, regionCode string) ChangeLogItem {
+	return ChangeLogItem{
+		ID:         fmt.Sprintf("%010d_%s_%s", versionId, objectType, guid),
+		VersionId:  versionId,
+		ObjectType: objectType,
+		Guid:       guid,
+		ChangeType: changeType,
+		RegionCode: regionCode,
+	}
+}
+
+// Получить название таблицы журнала изменений в БД
+func (c ChangeLogItem) TableName() string {
+	return "fias_changes"
+}
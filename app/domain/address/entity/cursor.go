@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// Курсор постраничной выборки адресов, указывает на последний адрес предыдущей выборки
+type Cursor struct {
+	Variant     int    `json:"v,omitempty"` // Номер варианта поискового запроса
+	Level       int    `json:"l,omitempty"` // Уровень адреса
+	FullAddress string `json:"a,omitempty"` // Полный адрес
+	ID          string `json:"i,omitempty"` // Идентификатор записи адреса
+	Offset      int64  `json:"o,omitempty"` // Смещение внутри ранжированной выборки
+}
+
+// Проверить, указывает ли курсор на адрес
+func (c *Cursor) HasPosition() bool {
+	return c != nil && c.ID != ""
+}
+
+// Получить токен страницы из курсора
+func (c *Cursor) Encode() string {
+	if c == nil {
+		return ""
+	}
+	data, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Получить курсор из токена страницы, пустой токен соответствует первой странице
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	cursor := Cursor{}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+
+	return &cursor, nil
+}
+
+// Создать курсор, указывающий на адрес
+func NewCursor(item *AddressObject, variant int, hierarchy string) *Cursor {
+	fullAddress := item.FullAddress
+	if hierarchy == MunHierarchy {
+		fullAddress = item.MunFullAddress
+	}
+
+	return &Cursor{
+		Variant:     variant,
+		Level:       item.AoLevel,
+		FullAddress: fullAddress,
+		ID:          item.ID,
+	}
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+// Курсор восстанавливается из токена без потерь
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []*Cursor{
+		{},
+		{ID: "1"},
+		{Variant: 2, Level: 7, FullAddress: "Новосибирская обл, г. Новосибирск, ул. Ленина", ID: "a3"},
+		{Offset: 50},
+		{Variant: 1, Level: 91, FullAddress: "\"quoted\" / & + =", ID: "id with spaces", Offset: 7},
+	}
+	for _, cursor := range tests {
+		token := cursor.Encode()
+		decoded, err := DecodeCursor(token)
+		if err != nil {
+			t.Errorf("%+v: got error %v", cursor, err)
+			continue
+		}
+		if decoded == nil || *decoded != *cursor {
+			t.Errorf("%+v: got %+v", cursor, decoded)
+		}
+		if decoded.HasPosition() != (cursor.ID != "") {
+			t.Errorf("%+v: got position %v", cursor, decoded.HasPosition())
+		}
+	}
+	// Пустой курсор соответствует первой странице
+	var empty *Cursor
+	if token := empty.Encode(); token != "" {
+		t.Errorf("got token %q for nil cursor", token)
+	}
+	if cursor, err := DecodeCursor(""); cursor != nil || err != nil {
+		t.Errorf("got %+v %v for empty token", cursor, err)
+	}
+	if empty.HasPosition() {
+		t.Error("nil cursor has position")
+	}
+}
+
+// Некорректный токен возвращает ошибку
+func TestDecodeInvalidCursor(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "!!!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"i":"12"}`))},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("cursor"))},
+		{"wrong types", base64.RawURLEncoding.EncodeToString([]byte(`{"v":"1","i":2}`))},
+		{"json array", base64.RawURLEncoding.EncodeToString([]byte(`[1]`))},
+	}
+	for _, test := range tests {
+		if cursor, err := DecodeCursor(test.token); err == nil {
+			t.Errorf("%s: got %+v without error", test.name, cursor)
+		}
+	}
+}
+
+// Курсор указывает на полный адрес в выбранной иерархии
+func TestNewCursor(t *testing.T) {
+	item := &AddressObject{ID: "a3", AoLevel: 7, FullAddress: "г. Новосибирск, ул. Ленина", MunFullAddress: "г.о. город Новосибирск, ул. Ленина"}
+	if cursor := NewCursor(item, 1, AdmHierarchy); cursor.FullAddress != item.FullAddress || cursor.Variant != 1 || cursor.Level != 7 || cursor.ID != "a3" {
+		t.Errorf("got administrative cursor %+v", cursor)
+	}
+	if cursor := NewCursor(item, 0, MunHierarchy); cursor.FullAddress != item.MunFullAddress {
+		t.Errorf("got municipal cursor %+v", cursor)
+	}
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Фильтр выгрузки объектов
+type ExportFilter struct {
+	Regions      []string  // Коды регионов
+	Levels       []int     // Уровни адресов
+	AddressGuids []string  // GUID адресов, к которым относятся дома
+	UpdatedSince time.Time // Минимальная дата обновления объекта в БД
+}
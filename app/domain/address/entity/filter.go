@@ -5,6 +5,7 @@ type FilterObject struct {
 	Level      NumberFilter
 	ParentGuid StringFilter
 	KladrId    StringFilter
+	Hierarchy  string
 }
 
 // Строковый фильтр
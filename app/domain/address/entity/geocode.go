@@ -0,0 +1,46 @@
+package entity
+
+// Точность результата обратного геокодирования
+const (
+	PrecisionHouse      = "house"      // Найден дом
+	PrecisionStreet     = "street"     // Найдена улица
+	PrecisionSettlement = "settlement" // Найден населенный пункт
+	PrecisionCity       = "city"       // Найден город или регион
+)
+
+// Уровни домов
+var HouseLevels = []int{8}
+
+// Уровни улиц, элементов планировочной структуры и дополнительных территорий
+var StreetLevels = []int{7, 65, 90, 91}
+
+// Получить максимальную точность обратного геокодирования для уровня, пустой уровень означает дом
+func GetGeocodePrecision(level int) string {
+	switch {
+	case level == 0 || containsLevel(HouseLevels, level):
+		return PrecisionHouse
+	case containsLevel(StreetLevels, level):
+		return PrecisionStreet
+	}
+
+	return PrecisionSettlement
+}
+
+// Проверить наличие уровня в списке
+func containsLevel(levels []int, level int) bool {
+	for _, item := range levels {
+		if item == level {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Результат обратного геокодирования
+type GeocodeResult struct {
+	Precision string         // Точность результата
+	Distance  float64        // Расстояние до объекта в метрах
+	Address   *AddressObject // Найденный адрес, для дома - адрес, к которому относится дом
+	House     *HouseObject   // Найденный дом
+}
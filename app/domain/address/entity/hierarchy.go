@@ -0,0 +1,31 @@
+package entity
+
+// Типы иерархии ГАР
+const (
+	AdmHierarchy = "adm" // Административное деление
+	MunHierarchy = "mun" // Муниципальное деление
+)
+
+// Объект иерархии ГАР
+type HierarchyObject struct {
+	ID          string `xml:"ID,attr"`
+	ObjectId    string `xml:"OBJECTID,attr"`
+	ParentObjId string `xml:"PARENTOBJID,attr"`
+	ParentGuid  string
+	RegionCode  string `xml:"REGIONCODE,attr"`
+	Oktmo       string `xml:"OKTMO,attr"`
+	IsActive    string `xml:"ISACTIVE,attr"`
+	StartDate   string `xml:"STARTDATE,attr"`
+	EndDate     string `xml:"ENDDATE,attr"`
+	UpdateDate  string `xml:"UPDATEDATE,attr"`
+	Type        string
+}
+
+// Получить название файла импорта
+func (h HierarchyObject) GetXmlFile() string {
+	if h.Type == MunHierarchy {
+		return "AS_MUN_HIERARCHY_"
+	}
+
+	return "AS_ADM_HIERARCHY_"
+}
@@ -1,5 +1,7 @@
 package entity
 
+import "time"
+
 // Объект дома
 type HouseObject struct {
 	ID              string `xml:"HOUSEID,attr"`
@@ -21,14 +23,32 @@ type HouseObject struct {
 	StructNum       string `xml:"STRUCNUM,attr"`
 	Counter         string `xml:"COUNTER,attr"`
 	CadNum          string `xml:"CADNUM,attr"`
+	GeoPrecision    string // Точность координат, не хранится в БД
 	BazisUpdateDate string
 }
 
+// Проверить актуальность записи дома
+func (o HouseObject) IsActive() bool {
+	end, err := time.Parse("2006-01-02", o.EndDate)
+
+	return err == nil && end.After(time.Now())
+}
+
 // Получить название файла импорта
 func (o HouseObject) GetXmlFile() string {
 	return "AS_HOUSE_"
 }
 
+// Получить название файла импорта ГАР
+func (o HouseObject) GetGarXmlFile() string {
+	return "AS_HOUSES_[0-9]"
+}
+
+// Получить название файла параметров ГАР
+func (o HouseObject) GetGarParamsXmlFile() string {
+	return "AS_HOUSES_PARAMS_"
+}
+
 // Получить название таблицы в БД
 func (o HouseObject) TableName() string {
 	return "fias_houses"
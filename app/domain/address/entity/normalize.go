@@ -0,0 +1,25 @@
+package entity
+
+// Компоненты адреса, выделенные из строки
+type ParsedAddress struct {
+	PostalCode string   // Почтовый индекс
+	Region     string   // Регион
+	City       string   // Город или населенный пункт
+	Street     string   // Улица
+	Names      []string // Слова названий адресных объектов
+	House      string   // Номер дома
+	Building   string   // Номер корпуса
+	Structure  string   // Номер строения
+	Apartment  string   // Номер квартиры или офиса
+	Remainder  []string // Нераспознанные части строки
+}
+
+// Результат нормализации строки адреса
+type NormalizedAddress struct {
+	Address    *AddressObject // Найденный адресный объект
+	House      *HouseObject   // Найденный дом
+	Room       *RoomObject    // Найденное помещение
+	Parsed     ParsedAddress  // Компоненты адреса из строки
+	Confidence float64        // Степень уверенности от 0 до 1
+	Remainder  string         // Часть строки, не использованная при поиске
+}
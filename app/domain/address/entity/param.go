@@ -0,0 +1,21 @@
+package entity
+
+// Типы параметров ГАР
+const (
+	ParamPostalCode = 5  // Почтовый индекс
+	ParamOkato      = 6  // ОКАТО
+	ParamOktmo      = 7  // ОКТМО
+	ParamCadNum     = 8  // Кадастровый номер
+	ParamKladr      = 10 // Код КЛАДР
+)
+
+// Объект параметра ГАР
+type ParamObject struct {
+	ID         string `xml:"ID,attr"`
+	ObjectId   string `xml:"OBJECTID,attr"`
+	TypeId     int    `xml:"TYPEID,attr"`
+	Value      string `xml:"VALUE,attr"`
+	StartDate  string `xml:"STARTDATE,attr"`
+	EndDate    string `xml:"ENDDATE,attr"`
+	UpdateDate string `xml:"UPDATEDATE,attr"`
+}
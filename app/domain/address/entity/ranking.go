@@ -0,0 +1,13 @@
+package entity
+
+// Параметры ранжирования результатов поиска
+type RankingOptions struct {
+	Level      float64 // Вес уровня адресного объекта
+	Center     float64 // Вес статуса центра района или региона
+	Population float64 // Вес численности населения
+}
+
+// Проверить, включено ли ранжирование
+func (o RankingOptions) IsEnabled() bool {
+	return o.Level > 0 || o.Center > 0 || o.Population > 0
+}
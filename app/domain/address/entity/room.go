@@ -0,0 +1,88 @@
+package entity
+
+import "time"
+
+// Сокращения типов квартир
+var flatTypes = map[string]string{
+	"1":  "пом.",
+	"2":  "кв.",
+	"3":  "офис",
+	"4":  "ком.",
+	"5":  "раб.уч.",
+	"6":  "скл.",
+	"7":  "торг.зал",
+	"8":  "цех",
+	"9":  "павил.",
+	"10": "подв.",
+	"11": "котел.",
+	"12": "погр.",
+	"13": "гараж",
+}
+
+// Сокращения типов комнат
+var roomTypes = map[string]string{
+	"1": "ком.",
+	"2": "пом.",
+}
+
+// Объект помещения
+type RoomObject struct {
+	ID              string `xml:"ROOMID,attr"`
+	RoomGuid        string `xml:"ROOMGUID,attr"`
+	HouseGuid       string `xml:"HOUSEGUID,attr"`
+	RegionCode      string `xml:"REGIONCODE,attr"`
+	FlatNumber      string `xml:"FLATNUMBER,attr"`
+	FlatType        string `xml:"FLATTYPE,attr"`
+	RoomNumber      string `xml:"ROOMNUMBER,attr"`
+	RoomType        string `xml:"ROOMTYPE,attr"`
+	RoomFullNum     string
+	FullAddress     string
+	PostalCode      string `xml:"POSTALCODE,attr"`
+	CadNum          string `xml:"CADNUM,attr"`
+	RoomCadNum      string `xml:"ROOMCADNUM,attr"`
+	StartDate       string `xml:"STARTDATE,attr"`
+	EndDate         string `xml:"ENDDATE,attr"`
+	UpdateDate      string `xml:"UPDATEDATE,attr"`
+	BazisUpdateDate string
+}
+
+// Проверить актуальность записи помещения
+func (o RoomObject) IsActive() bool {
+	end, err := time.Parse("2006-01-02", o.EndDate)
+
+	return err == nil && end.After(time.Now())
+}
+
+// Получить полный номер помещения
+func (o RoomObject) GetFullNum() string {
+	var fullNum string
+	if o.FlatNumber != "" {
+		flatType, ok := flatTypes[o.FlatType]
+		if !ok {
+			flatType = "пом."
+		}
+		fullNum = flatType + " " + o.FlatNumber
+	}
+	if o.RoomNumber != "" {
+		roomType, ok := roomTypes[o.RoomType]
+		if !ok {
+			roomType = "ком."
+		}
+		if fullNum != "" {
+			fullNum += ", "
+		}
+		fullNum += roomType + " " + o.RoomNumber
+	}
+
+	return fullNum
+}
+
+// Получить название файла импорта
+func (o RoomObject) GetXmlFile() string {
+	return "AS_ROOM_"
+}
+
+// Получить название таблицы в БД
+func (o RoomObject) TableName() string {
+	return "fias_rooms"
+}
@@ -0,0 +1,45 @@
+package entity
+
+import "time"
+
+// Объект земельного участка
+type SteadObject struct {
+	ID              string `xml:"STEADID,attr"`
+	SteadGuid       string `xml:"STEADGUID,attr"`
+	ParentGuid      string `xml:"PARENTGUID,attr"`
+	RegionCode      string `xml:"REGIONCODE,attr"`
+	Number          string `xml:"NUMBER,attr"`
+	SteadFullNum    string
+	FullAddress     string
+	PostalCode      string `xml:"POSTALCODE,attr"`
+	Okato           string `xml:"OKATO,attr"`
+	Oktmo           string `xml:"OKTMO,attr"`
+	CadNum          string `xml:"CADNUM,attr"`
+	DivType         string `xml:"DIVTYPE,attr"`
+	StartDate       string `xml:"STARTDATE,attr"`
+	EndDate         string `xml:"ENDDATE,attr"`
+	UpdateDate      string `xml:"UPDATEDATE,attr"`
+	BazisUpdateDate string
+}
+
+// Проверить актуальность записи участка
+func (o SteadObject) IsActive() bool {
+	end, err := time.Parse("2006-01-02", o.EndDate)
+
+	return err == nil && end.After(time.Now())
+}
+
+// Получить полный номер участка
+func (o SteadObject) GetFullNum() string {
+	return "уч. " + o.Number
+}
+
+// Получить название файла импорта
+func (o SteadObject) GetXmlFile() string {
+	return "AS_STEAD_"
+}
+
+// Получить название таблицы в БД
+func (o SteadObject) TableName() string {
+	return "fias_steads"
+}
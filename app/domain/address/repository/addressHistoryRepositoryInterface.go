@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"sync"
+)
+
+// Интерфейс репозитория истории адресов
+type AddressHistoryRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Получить все версии адреса по GUID, упорядоченные по дате начала действия
+	GetByGuid(guid string) ([]*entity.AddressObject, error)
+	// Получить версию адреса по идентификатору записи
+	GetById(id string) (*entity.AddressObject, error)
+	// Сохранить коллекцию версий адресов
+	InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool)
+	// Получить название таблицы в БД
+	GetIndexName() string
+}
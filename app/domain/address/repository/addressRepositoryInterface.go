@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
 	"sync"
 	"time"
 )
@@ -20,12 +21,20 @@ type AddressRepositoryInterface interface {
 	GetByGuid(guid string) (*entity.AddressObject, error)
 	// Найти адреса по GUID
 	GetAddressByGuidList(guids []string) ([]*entity.AddressObject, error)
+	// Найти адреса по идентификаторам объектов
+	GetAddressByIdList(ids []string) ([]*entity.AddressObject, error)
 	// Получить список всех городов
 	GetCities() ([]*entity.AddressObject, error)
+	// Получить страницу городов после курсора
+	GetCitiesAfter(size int64, after *entity.Cursor) ([]*entity.AddressObject, error)
+	// Получить страницу адресов по фильтру после курсора
+	GetListAfter(size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error)
 	// Найти города по подстроке
 	GetCitiesByTerm(term string, size int64, from int64) ([]*entity.AddressObject, error)
 	// Найти адрес по подстроке
 	GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error)
+	// Найти страницу адресов по последнему варианту подстроки после курсора, исключая адреса предыдущих вариантов
+	GetAddressByVariantAfter(variants []util.TermVariant, size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error)
 	// Найти адрес по почтовому индексу
 	GetAddressByPostal(term string, size int64, from int64) ([]*entity.AddressObject, error)
 	// Найти ближайший город по координатам
@@ -34,10 +43,16 @@ type AddressRepositoryInterface interface {
 	GetNearestAddress(lon float64, lat float64, term string) (*entity.AddressObject, error)
 	// Обновить коллекцию адресов
 	InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool)
+	// Обновить иерархию адресов
+	UpdateHierarchy(items []entity.HierarchyObject) error
+	// Обновить параметры адресов
+	UpdateParams(items []entity.ParamObject) error
 	// Получить название таблицы в БД
 	GetIndexName() string
 	// Подсчитать количество адресов в БД по фильтру
 	CountAllData(query interface{}) (int64, error)
-	// Индексация таблицы
-	Index(isFull bool, start time.Time, guids []string, indexChan chan<- entity.IndexObject) error
+	// Индексация таблицы, пустой список регионов - все регионы
+	Index(isFull bool, start time.Time, guids []string, regions []string, indexChan chan<- entity.IndexObject) error
+	// Выгрузить адреса по фильтру пачками
+	Export(filter entity.ExportFilter, handler func(items []*entity.AddressObject) error) error
 }
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Интерфейс репозитория границ административных объектов
+type BoundaryRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Сохранить границы
+	Save(items []entity.Boundary) error
+	// Получить границы, содержащие точку, без контуров
+	GetByPoint(lat float64, lon float64) ([]*entity.Boundary, error)
+	// Получить название таблицы в БД
+	GetIndexName() string
+}
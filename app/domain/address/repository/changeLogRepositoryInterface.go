@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Интерфейс репозитория журнала изменений
+type ChangeLogRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Сохранить записи журнала изменений
+	Save(items []entity.ChangeLogItem) error
+	// Получить записи журнала изменений версий после указанной, начиная с записи после afterId
+	GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, error)
+	// Получить название таблицы в БД
+	GetIndexName() string
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Интерфейс публикации изменений во внешние системы
+type ChangePublisherInterface interface {
+	// Опубликовать записи журнала изменений
+	Publish(items []entity.ChangeLogItem) error
+	// Получить идентификатор последней опубликованной записи, пустой при отсутствии записей
+	GetLastPublished() (string, error)
+	// Закрыть соединение
+	Close() error
+}
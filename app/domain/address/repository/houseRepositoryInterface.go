@@ -17,18 +17,30 @@ type HouseRepositoryInterface interface {
 	Clear() error
 	// Найти дом по GUID
 	GetByGuid(guid string) (*entity.HouseObject, error)
+	// Найти дома по списку GUID
+	GetByGuidList(guids []string) ([]*entity.HouseObject, error)
+	// Найти дома по идентификаторам объектов
+	GetByIdList(ids []string) ([]*entity.HouseObject, error)
 	// Найти дома по GUID адреса
 	GetByAddressGuid(guid string) ([]*entity.HouseObject, error)
 	// Получить GUID последних обновленных домов
 	GetLastUpdatedGuids(start time.Time) ([]string, error)
+	// Найти ближайший дом в радиусе в метрах
+	GetNearest(lon float64, lat float64, radius int) (*entity.HouseObject, error)
 	// Найти дома по подстроке
 	GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error)
 	// Обновить коллекцию домов
 	InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool)
+	// Обновить иерархию домов
+	UpdateHierarchy(items []entity.HierarchyObject) error
+	// Обновить параметры домов
+	UpdateParams(items []entity.ParamObject) error
 	// Получить название таблицы в БД
 	GetIndexName() string
 	// Подсчитать количество домов в БД по фильтру
 	CountAllData(query interface{}) (int64, error)
 	// Индексация таблицы домов
 	Index(start time.Time, indexChan <-chan entity.IndexObject, GetIndexObjects GetIndexObjects) error
+	// Выгрузить дома по фильтру пачками
+	Export(filter entity.ExportFilter, handler func(items []*entity.HouseObject) error) error
 }
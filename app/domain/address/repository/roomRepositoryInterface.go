@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"sync"
+	"time"
+)
+
+// Интерфейс репозитория помещений
+type RoomRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Найти помещение по GUID
+	GetByGuid(guid string) (*entity.RoomObject, error)
+	// Найти помещения по списку GUID
+	GetByGuidList(guids []string) ([]*entity.RoomObject, error)
+	// Найти помещения по GUID дома
+	GetByHouseGuid(guid string) ([]*entity.RoomObject, error)
+	// Обновить коллекцию помещений
+	InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool)
+	// Получить название таблицы в БД
+	GetIndexName() string
+	// Подсчитать количество помещений в БД по фильтру
+	CountAllData(query interface{}) (int64, error)
+	// Индексация помещений, обновленных после указанной даты
+	Index(start time.Time, GetIndexObjects GetIndexObjects) error
+}
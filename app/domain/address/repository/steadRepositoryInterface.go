@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"sync"
+	"time"
+)
+
+// Интерфейс репозитория земельных участков
+type SteadRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Найти участок по GUID
+	GetByGuid(guid string) (*entity.SteadObject, error)
+	// Найти участки по списку GUID
+	GetByGuidList(guids []string) ([]*entity.SteadObject, error)
+	// Найти участки по GUID адреса
+	GetByAddressGuid(guid string) ([]*entity.SteadObject, error)
+	// Обновить коллекцию участков
+	InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool)
+	// Получить название таблицы в БД
+	GetIndexName() string
+	// Подсчитать количество участков в БД по фильтру
+	CountAllData(query interface{}) (int64, error)
+	// Индексация участков, обновленных после указанной даты
+	Index(start time.Time, GetIndexObjects GetIndexObjects) error
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Максимальная глубина иерархии адресов
+	maxHistoryDepth = 10
+	// Максимальная длина цепочки замен адреса
+	maxChainLength = 100
+)
+
+// Сервис истории адресов
+type AddressHistoryService struct {
+	HistoryRepo repository.AddressHistoryRepositoryInterface // Репозиторий истории адресов
+	LinkRepo    repository.AddressHistoryRepositoryInterface // Репозиторий версий со ссылками на предыдущие и следующие записи
+	Enabled     bool                                         // Сохранять все версии адресов
+	logger      interfaces.LoggerInterface                   // Логгер
+}
+
+// Инициализация сервиса
+func NewAddressHistoryService(historyRepo repository.AddressHistoryRepositoryInterface, linkRepo repository.AddressHistoryRepositoryInterface, logger interfaces.LoggerInterface, config interfaces.ConfigInterface) *AddressHistoryService {
+	enabled := config.GetConfig().History.Enable
+	// Ссылки на версии сохраняются всегда для поиска актуального адреса по устаревшему
+	repos := []repository.AddressHistoryRepositoryInterface{linkRepo}
+	if enabled {
+		repos = append(repos, historyRepo)
+	}
+	for _, repo := range repos {
+		if err := repo.Init(); err != nil {
+			logger.Panic(err.Error())
+			os.Exit(1)
+		}
+	}
+
+	return &AddressHistoryService{
+		HistoryRepo: historyRepo,
+		LinkRepo:    linkRepo,
+		Enabled:     enabled,
+		logger:      logger,
+	}
+}
+
+// Получить все версии адреса по GUID
+func (h *AddressHistoryService) GetHistory(guid string) []*entity.AddressObject {
+	res, err := h.HistoryRepo.GetByGuid(guid)
+	h.checkError(err)
+
+	return sortVersions(res)
+}
+
+// Упорядочить версии адреса по дате начала действия и дате обновления
+func sortVersions(res []*entity.AddressObject) []*entity.AddressObject {
+	for _, item := range res {
+		item.FullName = util.PrepareFullName(item.ShortName, item.FormalName)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].StartDate != res[j].StartDate {
+			return res[i].StartDate < res[j].StartDate
+		}
+		return res[i].UpdateDate < res[j].UpdateDate
+	})
+
+	return res
+}
+
+// Получить версию адреса, действовавшую на указанную дату, с полным адресом на эту дату
+func (h *AddressHistoryService) GetByGuidAt(guid string, date time.Time) *entity.AddressObject {
+	result := h.getVersionAt(guid, date)
+	if result == nil {
+		return nil
+	}
+	// Формирует полный адрес по версиям родительских объектов на ту же дату
+	chain := []*entity.AddressObject{result}
+	parentGuid := result.ParentGuid
+	for i := 0; parentGuid != "" && i < maxHistoryDepth; i++ {
+		parent := h.getVersionAt(parentGuid, date)
+		if parent == nil {
+			break
+		}
+		chain = append(chain, parent)
+		parentGuid = parent.ParentGuid
+	}
+	hasCity := false
+	for _, item := range chain {
+		if item.AoLevel == 4 {
+			hasCity = true
+		}
+	}
+	var parts []string
+	for i := len(chain) - 1; i >= 0; i-- {
+		// Пропускает район в адресе города и его дочерних объектов
+		if hasCity && chain[i].AoLevel == 3 {
+			continue
+		}
+		parts = append(parts, chain[i].FullName)
+	}
+	result.FullAddress = strings.Join(parts, ", ")
+
+	return result
+}
+
+// Получить версию объекта, действовавшую на указанную дату
+func (h *AddressHistoryService) getVersionAt(guid string, date time.Time) *entity.AddressObject {
+	var result *entity.AddressObject
+	for _, item := range h.GetHistory(guid) {
+		if !item.IsActualAt(date) {
+			continue
+		}
+		// Из пересекающихся записей выбирается актуальная, затем последняя обновленная
+		if result == nil ||
+			(item.ActStatus == "1" && result.ActStatus != "1") ||
+			(item.ActStatus == result.ActStatus && item.UpdateDate >= result.UpdateDate) {
+			result = item
+		}
+	}
+
+	return result
+}
+
+// Получить цепочку замен адреса по идентификатору записи или GUID до последней версии
+func (h *AddressHistoryService) ResolveChain(id string) []*entity.AddressObject {
+	item, err := h.LinkRepo.GetById(id)
+	h.checkError(err)
+	// Начинает цепочку с последней версии объекта, если передан GUID
+	if item == nil {
+		versions, err := h.LinkRepo.GetByGuid(id)
+		h.checkError(err)
+		versions = sortVersions(versions)
+		if len(versions) == 0 {
+			return nil
+		}
+		item = versions[len(versions)-1]
+	}
+	item.FullName = util.PrepareFullName(item.ShortName, item.FormalName)
+	chain := []*entity.AddressObject{item}
+	visited := map[string]bool{item.ID: true}
+	// Переходит по ссылкам на следующие версии, пока они есть
+	for item.NextId != "" && len(chain) < maxChainLength {
+		next, err := h.LinkRepo.GetById(item.NextId)
+		h.checkError(err)
+		if next == nil || visited[next.ID] {
+			break
+		}
+		next.FullName = util.PrepareFullName(next.ShortName, next.FormalName)
+		visited[next.ID] = true
+		chain = append(chain, next)
+		item = next
+	}
+
+	return chain
+}
+
+// Получить обработчик сохранения, дополнительно записывающий версии адресов в историю и ссылки на версии
+func (h *AddressHistoryService) Saver(saver repository.InsertUpdateInterface, accept func(item entity.AddressObject) bool) repository.InsertUpdateInterface {
+	return &historyCollector{
+		history: h,
+		saver:   saver,
+		accept:  accept,
+	}
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (h *AddressHistoryService) checkError(err error) {
+	if err != nil {
+		h.logger.Error(err.Error())
+	}
+}
+
+// Обработчик, разделяющий поток адресов между основной таблицей, ссылками на версии и историей
+type historyCollector struct {
+	history *AddressHistoryService               // Сервис истории адресов
+	saver   repository.InsertUpdateInterface     // Основной обработчик сохранения
+	accept  func(item entity.AddressObject) bool // Проверка, сохраняется ли адрес в основную таблицу
+}
+
+// Обновить коллекцию адресов, ссылки на версии и историю
+func (c *historyCollector) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	savers := []repository.InsertUpdateInterface{c.saver, c.history.LinkRepo}
+	if c.history.Enabled {
+		savers = append(savers, c.history.HistoryRepo)
+	}
+	var saveWg sync.WaitGroup
+	saveWg.Add(len(savers))
+	channels := make([]chan interface{}, len(savers))
+	counts := make([]chan int, len(savers))
+	for i, saver := range savers {
+		channels[i] = make(chan interface{})
+		counts[i] = make(chan int, 1)
+		go saver.InsertUpdateCollection(&saveWg, channels[i], counts[i], isFull)
+	}
+	mainChannel, linkChannel := channels[0], channels[1]
+	var historyChannel chan interface{}
+	if c.history.Enabled {
+		historyChannel = channels[2]
+	}
+
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Контрольная точка фиксируется после сохранения элементов во все хранилища
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			var mu sync.Mutex
+			remaining := len(channels)
+			commit := func() {
+				mu.Lock()
+				defer mu.Unlock()
+				remaining--
+				if remaining == 0 {
+					checkpoint.Commit()
+				}
+			}
+			split := util.CheckpointObject{Offset: checkpoint.Offset, Commit: commit}
+			for _, ch := range channels {
+				ch <- split
+			}
+			continue
+		}
+		item, ok := d.(entity.AddressObject)
+		if !ok {
+			continue
+		}
+		// Версии хранятся по идентификатору версии записи
+		version := item
+		version.ID = item.GetVersionId()
+		if version.HasLinks() {
+			linkChannel <- version
+		}
+		if historyChannel != nil {
+			historyChannel <- version
+		}
+		if c.accept(item) {
+			mainChannel <- item
+		}
+	}
+	for _, ch := range channels {
+		close(ch)
+	}
+	saveWg.Wait()
+	for _, cnt := range counts[1:] {
+		<-cnt
+	}
+	count <- <-counts[0]
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Репозиторий истории адресов в памяти
+type testHistoryRepo struct {
+	items []entity.AddressObject
+}
+
+func (r *testHistoryRepo) Init() error  { return nil }
+func (r *testHistoryRepo) Clear() error { r.items = nil; return nil }
+func (r *testHistoryRepo) GetByGuid(guid string) ([]*entity.AddressObject, error) {
+	var res []*entity.AddressObject
+	for _, item := range r.items {
+		if item.AoGuid == guid {
+			copied := item
+			res = append(res, &copied)
+		}
+	}
+
+	return res, nil
+}
+func (r *testHistoryRepo) GetById(id string) (*entity.AddressObject, error) {
+	for _, item := range r.items {
+		if item.ID == id {
+			copied := item
+			return &copied, nil
+		}
+	}
+
+	return nil, nil
+}
+func (r *testHistoryRepo) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	cnt := 0
+	for d := range channel {
+		if item, ok := d.(entity.AddressObject); ok {
+			r.items = append(r.items, item)
+			cnt++
+		}
+	}
+	count <- cnt
+}
+func (r *testHistoryRepo) GetIndexName() string { return "test_history" }
+
+// Версия адреса для истории
+func testVersion(id string, guid string, parentGuid string, level int, shortName string, name string, act string, start string, end string, update string) entity.AddressObject {
+	return entity.AddressObject{
+		ID:         id,
+		AoGuid:     guid,
+		ParentGuid: parentGuid,
+		AoLevel:    level,
+		ShortName:  shortName,
+		FormalName: name,
+		ActStatus:  act,
+		StartDate:  start,
+		EndDate:    end,
+		UpdateDate: update,
+	}
+}
+
+// Из пересекающихся по датам версий выбирается актуальная, затем последняя обновленная
+func TestAddressHistoryGetVersionAt(t *testing.T) {
+	repo := &testHistoryRepo{items: []entity.AddressObject{
+		testVersion("1", "g", "", 7, "ул", "Первая", "0", "2000-01-01", "2010-01-01", "2000-01-01"),
+		testVersion("2", "g", "", 7, "ул", "Вторая", "0", "2005-01-01", "2015-01-01", "2005-01-01"),
+		testVersion("3", "g", "", 7, "ул", "Третья", "0", "2005-01-01", "2015-01-01", "2006-01-01"),
+		testVersion("4", "g", "", 7, "ул", "Четвертая", "1", "2012-01-01", "2079-06-06", "2012-01-01"),
+		testVersion("5", "g", "", 7, "ул", "Пятая", "0", "2012-01-01", "2079-06-06", "2020-01-01"),
+	}}
+	history := &AddressHistoryService{HistoryRepo: repo, Enabled: true, logger: testLogger{}}
+	tests := []struct {
+		date string
+		id   string
+	}{
+		{"1999-01-01", ""},
+		{"2001-01-01", "1"},
+		{"2007-01-01", "3"},
+		{"2013-01-01", "4"},
+		{"2020-01-01", "4"},
+	}
+	for _, test := range tests {
+		date, _ := time.Parse("2006-01-02", test.date)
+		item := history.getVersionAt("g", date)
+		id := ""
+		if item != nil {
+			id = item.ID
+		}
+		if id != test.id {
+			t.Errorf("%s: got version %q, want %q", test.date, id, test.id)
+		}
+	}
+}
+
+// Полный адрес на дату собирается из версий родительских объектов на ту же дату без района города
+func TestAddressHistoryGetByGuidAt(t *testing.T) {
+	repo := &testHistoryRepo{items: []entity.AddressObject{
+		testVersion("r", "region", "", 1, "обл", "Новосибирская", "1", "2000-01-01", "2079-06-06", "2000-01-01"),
+		testVersion("d", "district", "region", 3, "р-н", "Новосибирский", "1", "2000-01-01", "2079-06-06", "2000-01-01"),
+		testVersion("c", "city", "district", 4, "г", "Новосибирск", "1", "2000-01-01", "2079-06-06", "2000-01-01"),
+		testVersion("s1", "street", "city", 7, "ул", "Старая", "0", "2000-01-01", "2010-01-01", "2000-01-01"),
+		testVersion("s2", "street", "city", 7, "ул", "Ленина", "1", "2010-01-01", "2079-06-06", "2010-01-01"),
+		testVersion("v", "village", "district", 6, "с", "Каменка", "1", "2000-01-01", "2079-06-06", "2000-01-01"),
+	}}
+	history := &AddressHistoryService{HistoryRepo: repo, Enabled: true, logger: testLogger{}}
+	tests := []struct {
+		guid    string
+		date    string
+		id      string
+		address string
+	}{
+		{"street", "2005-01-01", "s1", "Новосибирская обл, г. Новосибирск, ул. Старая"},
+		{"street", "2015-01-01", "s2", "Новосибирская обл, г. Новосибирск, ул. Ленина"},
+		{"village", "2015-01-01", "v", "Новосибирская обл, Новосибирский р-н, село Каменка"},
+		{"street", "1990-01-01", "", ""},
+	}
+	for _, test := range tests {
+		date, _ := time.Parse("2006-01-02", test.date)
+		item := history.GetByGuidAt(test.guid, date)
+		if item == nil {
+			if test.id != "" {
+				t.Errorf("%s at %s: got nothing, want %q", test.guid, test.date, test.id)
+			}
+			continue
+		}
+		if item.ID != test.id || item.FullAddress != test.address {
+			t.Errorf("%s at %s: got %q %q, want %q %q", test.guid, test.date, item.ID, item.FullAddress, test.id, test.address)
+		}
+	}
+}
+
+// Исторические записи ГАР сохраняются по идентификатору версии, в основную таблицу попадают только принятые
+func TestAddressHistorySaver(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		history int
+	}{
+		{"history enabled", true, 3},
+		{"history disabled", false, 0},
+	}
+	for _, test := range tests {
+		repo := &testHistoryRepo{}
+		links := &testHistoryRepo{}
+		main := &testHistoryRepo{}
+		history := &AddressHistoryService{HistoryRepo: repo, LinkRepo: links, Enabled: test.enabled, logger: testLogger{}}
+		saver := history.Saver(main, func(item entity.AddressObject) bool {
+			return item.ActStatus == "1"
+		})
+		old := testVersion("2", "street", "city", 7, "ул", "Старая", "0", "2000-01-01", "2010-01-01", "2000-01-01")
+		old.VersionId = "20"
+		old.NextId = "21"
+		actual := testVersion("2", "street", "city", 7, "ул", "Ленина", "1", "2010-01-01", "2079-06-06", "2010-01-01")
+		actual.VersionId = "21"
+		actual.PrevId = "20"
+		city := testVersion("1", "city", "", 4, "г", "Новосибирск", "1", "2000-01-01", "2079-06-06", "2000-01-01")
+		city.VersionId = "10"
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		channel := make(chan interface{})
+		count := make(chan int, 1)
+		go saver.InsertUpdateCollection(&wg, channel, count, true)
+		channel <- old
+		channel <- actual
+		channel <- city
+		close(channel)
+		wg.Wait()
+
+		if cnt := <-count; cnt != 2 || len(main.items) != 2 || main.items[0].ID != "2" || main.items[0].FormalName != "Ленина" {
+			t.Errorf("%s: got %d main items %v, want actual items with object id", test.name, cnt, main.items)
+		}
+		if len(repo.items) != test.history {
+			t.Errorf("%s: got %d history items, want %d", test.name, len(repo.items), test.history)
+		}
+		// Ссылки сохраняются только для связанных версий независимо от истории
+		if len(links.items) != 2 || links.items[0].ID != "20" || links.items[1].ID != "21" {
+			t.Errorf("%s: got link items %v, want versions 20 and 21", test.name, links.items)
+		}
+	}
+}
+
+// Цепочка замен строится по ссылкам на версии при выключенной истории
+func TestAddressHistoryResolveChain(t *testing.T) {
+	first := testVersion("20", "old", "city", 7, "ул", "Первая", "0", "2000-01-01", "2005-01-01", "2000-01-01")
+	first.NextId = "21"
+	second := testVersion("21", "old", "city", 7, "ул", "Вторая", "0", "2005-01-01", "2010-01-01", "2005-01-01")
+	second.PrevId = "20"
+	second.NextId = "30"
+	current := testVersion("30", "new", "city", 7, "ул", "Ленина", "1", "2010-01-01", "2079-06-06", "2010-01-01")
+	current.PrevId = "21"
+	looped := testVersion("40", "loop", "city", 7, "ул", "Круговая", "0", "2000-01-01", "2005-01-01", "2000-01-01")
+	looped.NextId = "40"
+	links := &testHistoryRepo{items: []entity.AddressObject{first, second, current, looped}}
+	history := &AddressHistoryService{HistoryRepo: &testHistoryRepo{}, LinkRepo: links, logger: testLogger{}}
+	tests := []struct {
+		id    string
+		chain []string
+	}{
+		{"20", []string{"20", "21", "30"}},
+		{"21", []string{"21", "30"}},
+		{"old", []string{"21", "30"}},
+		{"40", []string{"40"}},
+		{"unknown", nil},
+	}
+	for _, test := range tests {
+		var ids []string
+		for _, item := range history.ResolveChain(test.id) {
+			ids = append(ids, item.ID)
+		}
+		if strings.Join(ids, ",") != strings.Join(test.chain, ",") {
+			t.Errorf("%s: got chain %v, want %v", test.id, ids, test.chain)
+		}
+	}
+}
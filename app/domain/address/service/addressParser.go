@@ -0,0 +1,236 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"regexp"
+	"strings"
+)
+
+// Распространенные сокращения названий городов
+var nameAliases = map[string]string{
+	"мск":   "москва",
+	"спб":   "санкт-петербург",
+	"питер": "санкт-петербург",
+	"екб":   "екатеринбург",
+	"нск":   "новосибирск",
+}
+
+// Части адреса, к которым относятся названия
+const (
+	addressPartRegion = "region" // Регион
+	addressPartArea   = "area"   // Район
+	addressPartCity   = "city"   // Город или населенный пункт
+	addressPartStreet = "street" // Улица
+)
+
+// Слова, обозначающие тип адресного объекта, и части адреса, к которым они относятся
+var typeWords = map[string]string{
+	"россия": "", "рф": "",
+	"республика": addressPartRegion, "респ": addressPartRegion, "край": addressPartRegion, "область": addressPartRegion, "обл": addressPartRegion, "ао": addressPartRegion,
+	"район": addressPartArea, "р-н": addressPartArea, "р-он": addressPartArea,
+	"город": addressPartCity, "гор": addressPartCity, "г": addressPartCity,
+	"поселок": addressPartCity, "пос": addressPartCity, "п": addressPartCity, "пгт": addressPartCity, "рп": addressPartCity,
+	"деревня": addressPartCity, "дер": addressPartCity, "д": addressPartCity, "село": addressPartCity, "с": addressPartCity,
+	"хутор": addressPartCity, "х": addressPartCity, "станица": addressPartCity, "ст-ца": addressPartCity,
+	"микрорайон": "", "мкр": "", "мкрн": "", "квартал": "", "кв-л": "",
+	"улица": addressPartStreet, "ул": addressPartStreet, "переулок": addressPartStreet, "пер": addressPartStreet,
+	"проспект": addressPartStreet, "пр-кт": addressPartStreet, "пр-т": addressPartStreet, "просп": addressPartStreet, "пр": addressPartStreet,
+	"шоссе": addressPartStreet, "ш": addressPartStreet, "бульвар": addressPartStreet, "б-р": addressPartStreet, "бул": addressPartStreet,
+	"площадь": addressPartStreet, "пл": addressPartStreet, "проезд": addressPartStreet, "пр-д": addressPartStreet,
+	"набережная": addressPartStreet, "наб": addressPartStreet, "тупик": addressPartStreet, "туп": addressPartStreet,
+	"аллея": addressPartStreet, "ал": addressPartStreet, "линия": addressPartStreet, "лн": addressPartStreet,
+	"тракт": addressPartStreet, "дорога": addressPartStreet, "дор": addressPartStreet,
+	"снт": "", "тер": "", "территория": "",
+}
+
+// Уровни адресных объектов, соответствующие частям адреса
+var addressPartLevels = map[string]map[int]bool{
+	addressPartRegion: {1: true, 2: true},
+	addressPartArea:   {3: true},
+	addressPartCity:   {35: true, 4: true, 6: true},
+	addressPartStreet: {7: true, 90: true, 91: true},
+}
+
+// Группа слов названия одного адресного объекта
+type addressGroup struct {
+	names []string // Слова названия
+	part  string   // Часть адреса по слову типа объекта, пустая если тип не указан
+}
+
+// Обозначения частей адреса после названий
+var (
+	houseMarkers     = map[string]bool{"д": true, "дом": true, "вл": true, "влд": true, "владение": true}
+	buildingMarkers  = map[string]bool{"к": true, "корп": true, "корпус": true}
+	structureMarkers = map[string]bool{"с": true, "стр": true, "строение": true}
+	apartmentMarkers = map[string]bool{"кв": true, "квартира": true, "оф": true, "офис": true, "пом": true, "помещение": true, "ком": true, "комната": true}
+	skipMarkers      = map[string]bool{"подъезд": true, "под": true, "этаж": true, "эт": true}
+)
+
+var (
+	// Почтовый индекс
+	postalRegexp = regexp.MustCompile(`^\d{6}$`)
+	// Номер дома, корпуса, строения или квартиры
+	numberRegexp = regexp.MustCompile(`^\d+[а-я]?(/\d+[а-я]?)?$`)
+	// Номер дома с корпусом и строением без пробелов, например 7к2с1
+	compactHouseRegexp = regexp.MustCompile(`^(\d+[а-я]?(?:/\d+[а-я]?)?)(?:(?:к|корп)(\d+[а-я]?))?(?:(?:с|стр)(\d+[а-я]?))?$`)
+	// Обозначение с номером без пробела, например кв5 или д7
+	markedNumberRegexp = regexp.MustCompile(`^([а-я]+)(\d+[а-я]?(?:/\d+[а-я]?)?)$`)
+	// Разделители слов
+	separatorRegexp = regexp.MustCompile(`[\s.,;:"'()«»]+`)
+)
+
+// Разобрать строку адреса на компоненты и группы слов названий адресных объектов
+func parseAddress(term string) (entity.ParsedAddress, []addressGroup) {
+	parsed := entity.ParsedAddress{}
+	var tokens []string
+	var segments []int
+	// Части строки, разделенные запятыми, относятся к разным адресным объектам
+	for i, segment := range strings.FieldsFunc(term, func(r rune) bool { return r == ',' || r == ';' }) {
+		for _, token := range splitAddress(segment) {
+			tokens = append(tokens, token)
+			segments = append(segments, i)
+		}
+	}
+	var groups []addressGroup
+	group := addressGroup{}
+	addGroup := func() {
+		if len(group.names) > 0 {
+			groups = append(groups, group)
+		}
+		group = addressGroup{}
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		next := ""
+		if i+1 < len(tokens) {
+			next = tokens[i+1]
+		}
+		if i > 0 && segments[i] != segments[i-1] {
+			addGroup()
+		}
+		part, isType := typeWords[token]
+		switch {
+		case isMarker(token) && numberRegexp.MatchString(next):
+			// Номер после обозначения части адреса
+			setAddressPart(&parsed, token, next)
+			i++
+		case postalRegexp.MatchString(token) && parsed.PostalCode == "":
+			parsed.PostalCode = token
+		case compactHouseRegexp.MatchString(token) && parsed.House == "" && !isNameWord(next):
+			match := compactHouseRegexp.FindStringSubmatch(token)
+			parsed.House, parsed.Building, parsed.Structure = match[1], match[2], match[3]
+		case numberRegexp.MatchString(token) && parsed.House != "" && parsed.Apartment == "":
+			parsed.Apartment = token
+		case len([]rune(token)) == 1 && parsed.House != "" && parsed.Building == "" && parsed.Structure == "" && parsed.Apartment == "":
+			// Литера дома, записанная через пробел
+			parsed.House += token
+		case isType:
+			// Тип объекта после названия с уже известным типом начинает новую группу
+			if part != "" && group.part != "" && len(group.names) > 0 {
+				addGroup()
+			}
+			if group.part == "" {
+				group.part = part
+			}
+		case parsed.House != "":
+			// Слова после номера дома не относятся к названиям
+			parsed.Remainder = append(parsed.Remainder, token)
+		default:
+			if alias, ok := nameAliases[token]; ok {
+				token = alias
+				if group.part == "" {
+					group.part = addressPartCity
+				}
+			}
+			parsed.Names = append(parsed.Names, token)
+			group.names = append(group.names, token)
+		}
+	}
+	addGroup()
+	// Название без типа перед номером дома считается улицей
+	if parsed.House != "" && len(groups) > 0 && groups[len(groups)-1].part == "" {
+		groups[len(groups)-1].part = addressPartStreet
+	}
+	for _, group := range groups {
+		setAddressName(&parsed, group.part, strings.Join(group.names, " "))
+	}
+
+	return parsed, groups
+}
+
+// Сохраняет название в соответствующую части адреса компоненту, первый регион и последние город и улицу
+func setAddressName(parsed *entity.ParsedAddress, part string, name string) {
+	switch part {
+	case addressPartRegion:
+		if parsed.Region == "" {
+			parsed.Region = name
+		}
+	case addressPartCity:
+		parsed.City = name
+	case addressPartStreet:
+		parsed.Street = name
+	}
+}
+
+// Получить часть адреса по уровню адресного объекта
+func addressPartByLevel(item *entity.AddressObject) string {
+	// Города федерального значения имеют уровень региона
+	if item.AoLevel == 1 && strings.Trim(item.ShortName, ".") == "г" {
+		return addressPartCity
+	}
+	for part, levels := range addressPartLevels {
+		if levels[item.AoLevel] {
+			return part
+		}
+	}
+
+	return ""
+}
+
+// Разбить строку адреса на слова, отделяя номера от обозначений
+func splitAddress(term string) []string {
+	term = strings.ReplaceAll(strings.ToLower(term), "ё", "е")
+	var tokens []string
+	for _, token := range separatorRegexp.Split(term, -1) {
+		token = strings.Trim(token, "-")
+		if token == "" {
+			continue
+		}
+		if match := markedNumberRegexp.FindStringSubmatch(token); match != nil && isMarker(match[1]) {
+			tokens = append(tokens, match[1], match[2])
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+// Проверяет, является ли слово обозначением части адреса
+func isMarker(token string) bool {
+	return houseMarkers[token] || buildingMarkers[token] || structureMarkers[token] || apartmentMarkers[token] || skipMarkers[token]
+}
+
+// Проверяет, является ли слово частью названия, например в "8 марта"
+func isNameWord(token string) bool {
+	_, isType := typeWords[token]
+
+	return len([]rune(token)) > 1 && !isMarker(token) && !isType && !numberRegexp.MatchString(token) && !postalRegexp.MatchString(token)
+}
+
+// Сохраняет номер в соответствующую обозначению часть адреса
+func setAddressPart(parsed *entity.ParsedAddress, marker string, value string) {
+	switch {
+	case houseMarkers[marker] && parsed.House == "":
+		parsed.House = value
+	case buildingMarkers[marker] && parsed.Building == "":
+		parsed.Building = value
+	case structureMarkers[marker] && parsed.Structure == "":
+		parsed.Structure = value
+	case apartmentMarkers[marker] && parsed.Apartment == "":
+		parsed.Apartment = value
+	default:
+		parsed.Remainder = append(parsed.Remainder, marker, value)
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"strings"
+	"testing"
+)
+
+// Разбор строки адреса на компоненты и группы названий
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		term      string
+		parsed    entity.ParsedAddress
+		groups    []string
+		remainder string
+	}{
+		{
+			"мск, тверская 7 кв 5",
+			entity.ParsedAddress{City: "москва", Street: "тверская", House: "7", Apartment: "5"},
+			[]string{"city:москва", "street:тверская"},
+			"",
+		},
+		{
+			"630000, Новосибирская обл, г Новосибирск, ул Ленина, д 7 к 2 стр 1, кв 15",
+			entity.ParsedAddress{PostalCode: "630000", Region: "новосибирская", City: "новосибирск", Street: "ленина", House: "7", Building: "2", Structure: "1", Apartment: "15"},
+			[]string{"region:новосибирская", "city:новосибирск", "street:ленина"},
+			"",
+		},
+		{
+			"г москва ул тверская 7к2с1",
+			entity.ParsedAddress{City: "москва", Street: "тверская", House: "7", Building: "2", Structure: "1"},
+			[]string{"city:москва", "street:тверская"},
+			"",
+		},
+		{
+			"Московская обл, Одинцовский р-н, д Жуковка",
+			entity.ParsedAddress{Region: "московская", City: "жуковка"},
+			[]string{"region:московская", "area:одинцовский", "city:жуковка"},
+			"",
+		},
+		{
+			"спб, пр-кт Невский, 28а, подъезд 3",
+			entity.ParsedAddress{City: "санкт-петербург", Street: "невский", House: "28а"},
+			[]string{"city:санкт-петербург", "street:невский"},
+			"подъезд 3",
+		},
+		{
+			"екатеринбург 8 марта 12",
+			entity.ParsedAddress{Street: "екатеринбург 8 марта", House: "12"},
+			[]string{"street:екатеринбург 8 марта"},
+			"",
+		},
+		{
+			"тверская",
+			entity.ParsedAddress{},
+			[]string{":тверская"},
+			"",
+		},
+	}
+	for _, test := range tests {
+		parsed, groups := parseAddress(test.term)
+		if parsed.PostalCode != test.parsed.PostalCode || parsed.Region != test.parsed.Region ||
+			parsed.City != test.parsed.City || parsed.Street != test.parsed.Street ||
+			parsed.House != test.parsed.House || parsed.Building != test.parsed.Building ||
+			parsed.Structure != test.parsed.Structure || parsed.Apartment != test.parsed.Apartment {
+			t.Errorf("%q: got %+v, want %+v", test.term, parsed, test.parsed)
+		}
+		var got []string
+		for _, group := range groups {
+			got = append(got, group.part+":"+strings.Join(group.names, " "))
+		}
+		if strings.Join(got, ",") != strings.Join(test.groups, ",") {
+			t.Errorf("%q: got groups %v, want %v", test.term, got, test.groups)
+		}
+		if remainder := strings.Join(parsed.Remainder, " "); remainder != test.remainder {
+			t.Errorf("%q: got remainder %q, want %q", test.term, remainder, test.remainder)
+		}
+	}
+}
+
+// Часть адреса определяется по уровню объекта, города федерального значения относятся к городам
+func TestAddressPartByLevel(t *testing.T) {
+	tests := []struct {
+		level     int
+		shortName string
+		part      string
+	}{
+		{1, "обл", addressPartRegion},
+		{1, "г", addressPartCity},
+		{3, "р-н", addressPartArea},
+		{4, "г", addressPartCity},
+		{35, "гп", addressPartCity},
+		{6, "с", addressPartCity},
+		{7, "ул", addressPartStreet},
+		{91, "ул", addressPartStreet},
+		{65, "мкр", ""},
+	}
+	for _, test := range tests {
+		if part := addressPartByLevel(&entity.AddressObject{AoLevel: test.level, ShortName: test.shortName}); part != test.part {
+			t.Errorf("level %d %s: got %q, want %q", test.level, test.shortName, part, test.part)
+		}
+	}
+}
@@ -4,18 +4,21 @@ import (
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
 	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
 )
 
 // Сервис получения данных об адресах
 type AddressService struct {
 	addressRepo repository.AddressRepositoryInterface // Репозиторий адресов
+	ranking     *RankingService                       // Сервис ранжирования результатов поиска
 	logger      interfaces.LoggerInterface            // Логгер
 }
 
 // Инициализация сервиса
-func NewAddressService(addressRepo repository.AddressRepositoryInterface, logger interfaces.LoggerInterface) *AddressService {
+func NewAddressService(addressRepo repository.AddressRepositoryInterface, ranking *RankingService, logger interfaces.LoggerInterface) *AddressService {
 	return &AddressService{
 		addressRepo: addressRepo,
+		ranking:     ranking,
 		logger:      logger,
 	}
 }
@@ -36,6 +39,38 @@ func (a *AddressService) GetCities() []*entity.AddressObject {
 	return cities
 }
 
+// Получить страницу городов после курсора и курсор следующей страницы
+func (a *AddressService) GetCitiesPage(size int64, cursor *entity.Cursor) ([]*entity.AddressObject, *entity.Cursor) {
+	if size == 0 {
+		size = 100
+	}
+	cities, err := a.addressRepo.GetCitiesAfter(size, cursor)
+	a.checkError(err)
+
+	return cities, a.getNextCursor(cities, size, 0, "")
+}
+
+// Получить страницу дочерних адресов объекта и курсор следующей страницы,
+// при пустом родителе возвращаются адреса верхнего уровня
+func (a *AddressService) GetChildren(parentGuid string, level int, hierarchy string, size int64, cursor *entity.Cursor) ([]*entity.AddressObject, *entity.Cursor) {
+	if size == 0 {
+		size = 100
+	}
+	filter := entity.FilterObject{Hierarchy: hierarchy}
+	if parentGuid != "" {
+		filter.ParentGuid.Values = []string{parentGuid}
+	} else if level == 0 {
+		level = 1
+	}
+	if level > 0 {
+		filter.Level.Values = []float32{float32(level)}
+	}
+	items, err := a.addressRepo.GetListAfter(size, cursor, filter)
+	a.checkError(err)
+
+	return items, a.getNextCursor(items, size, 0, hierarchy)
+}
+
 // Найти города по подстроке
 func (a *AddressService) GetCitiesByTerm(term string, size int64, from int64) []*entity.AddressObject {
 	cities, err := a.addressRepo.GetCitiesByTerm(term, size, from)
@@ -44,12 +79,98 @@ func (a *AddressService) GetCitiesByTerm(term string, size int64, from int64) []
 	return cities
 }
 
-// Найти адрес по подстроке
+// Найти адрес по подстроке с ранжированием по умолчанию
 func (a *AddressService) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) []*entity.AddressObject {
-	cities, err := a.addressRepo.GetAddressByTerm(term, size, from, filter...)
+	return a.GetRankedAddressByTerm(term, size, from, nil, filter...)
+}
+
+// Найти адрес по подстроке с ранжированием, при пустых параметрах используется ранжирование по умолчанию
+func (a *AddressService) GetRankedAddressByTerm(term string, size int64, from int64, ranking *entity.RankingOptions, filter ...entity.FilterObject) []*entity.AddressObject {
+	options := a.ranking.GetOptions(ranking)
+	// За пределами окна ранжирования результаты возвращаются в исходном порядке
+	if !options.IsEnabled() || from >= a.ranking.GetWindowSize() {
+		cities, err := a.addressRepo.GetAddressByTerm(term, size, from, filter...)
+		a.checkError(err)
+
+		return cities
+	}
+	if size == 0 {
+		size = 100
+	}
+	// Ранжирует окно первых результатов и возвращает запрошенную страницу,
+	// часть страницы за пределами окна остается в исходном порядке
+	cities, err := a.addressRepo.GetAddressByTerm(term, a.ranking.GetCandidatesSize(size, from), 0, filter...)
 	a.checkError(err)
+	a.ranking.RankAddresses(term, cities, options)
+	if int64(len(cities)) <= from {
+		return nil
+	}
+	if int64(len(cities)) > from+size {
+		cities = cities[:from+size]
+	}
 
-	return cities
+	return cities[from:]
+}
+
+// Найти страницу адресов по подстроке после курсора и курсор следующей страницы.
+// При включенном ранжировании первые страницы выбираются из ранжированного окна первых результатов,
+// курсор указывает на смещение внутри него. Следующие выборки возвращаются в исходном порядке
+func (a *AddressService) GetAddressByTermPage(term string, size int64, cursor *entity.Cursor, ranking *entity.RankingOptions, filter ...entity.FilterObject) ([]*entity.AddressObject, *entity.Cursor) {
+	if size == 0 {
+		size = 100
+	}
+	options := a.ranking.GetOptions(ranking)
+	// Ранжируется только первая выборка, курсор которой не указывает на адрес
+	isRanked := options.IsEnabled() && !cursor.HasPosition()
+	window := size
+	if isRanked {
+		window = a.ranking.GetCandidatesSize(size, 0)
+	}
+	start := entity.Cursor{}
+	if cursor != nil {
+		start = *cursor
+	}
+	offset := start.Offset
+	start.Offset = 0
+
+	// Окно набирается по вариантам подстроки, курсор применяется только к первому из них
+	variants := util.PrepareTermVariants(term)
+	after := &start
+	lastVariant := start.Variant
+	var items []*entity.AddressObject
+	for i := start.Variant; i < len(variants) && int64(len(items)) < window; i++ {
+		found, err := a.addressRepo.GetAddressByVariantAfter(variants[:i+1], window-int64(len(items)), after, filter...)
+		a.checkError(err)
+		if err != nil {
+			break
+		}
+		if len(found) > 0 {
+			lastVariant = i
+			items = append(items, found...)
+		}
+		after = nil
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	next := a.getNextCursor(items, window, lastVariant, a.getHierarchy(filter...))
+	if isRanked {
+		a.ranking.RankAddresses(term, items, options)
+	}
+
+	if offset+size < int64(len(items)) {
+		// Следующая страница находится в том же окне
+		start.Offset = offset + size
+		next = &start
+	}
+	if offset >= int64(len(items)) {
+		return nil, next
+	}
+	if int64(len(items)) > offset+size {
+		items = items[:offset+size]
+	}
+
+	return items[offset:], next
 }
 
 // Найти адрес по почтовому индексу
@@ -60,6 +181,132 @@ func (a *AddressService) GetAddressByPostal(term string, size int64, from int64)
 	return cities
 }
 
+// Получить курсор следующей страницы, если текущая страница заполнена полностью
+func (a *AddressService) getNextCursor(items []*entity.AddressObject, size int64, variant int, hierarchy string) *entity.Cursor {
+	if len(items) == 0 || int64(len(items)) < size {
+		return nil
+	}
+
+	return entity.NewCursor(items[len(items)-1], variant, hierarchy)
+}
+
+// Получить иерархию адресов из фильтра
+func (a *AddressService) getHierarchy(filters ...entity.FilterObject) string {
+	for _, filter := range filters {
+		if filter.Hierarchy != "" {
+			return filter.Hierarchy
+		}
+	}
+
+	return ""
+}
+
+// Заполнить координаты адресов, у адресов без координат используются координаты ближайшего родительского объекта
+func (a *AddressService) FillLocations(items ...*entity.AddressObject) {
+	var pending []*entity.AddressObject
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if item.Location != "" {
+			item.GeoPrecision = item.GetLocationPrecision()
+			continue
+		}
+		pending = append(pending, item)
+	}
+
+	// Загружает родительские объекты по уровням иерархии, пока не будут найдены координаты
+	parents := make(map[string]*entity.AddressObject)
+	for len(pending) > 0 {
+		var guids []string
+		var next []*entity.AddressObject
+		for _, item := range pending {
+			parent, missing := a.findLocationParent(item, parents)
+			if parent != nil {
+				item.Location = parent.Location
+				item.GeoPrecision = parent.GetLocationPrecision()
+			} else if len(missing) > 0 {
+				guids = append(guids, missing...)
+				next = append(next, item)
+			}
+		}
+		if len(guids) == 0 {
+			break
+		}
+		guids = util.UniqueStringSlice(guids)
+		list, err := a.addressRepo.GetAddressByGuidList(guids)
+		a.checkError(err)
+		// Отсутствующие в БД объекты помечаются, чтобы не запрашивать их повторно
+		for _, guid := range guids {
+			parents[guid] = nil
+		}
+		for _, parent := range list {
+			parents[parent.AoGuid] = parent
+		}
+		pending = next
+	}
+}
+
+// Заполнить координаты домов, у домов без координат используются координаты адреса дома или его родителей
+func (a *AddressService) FillHouseLocations(items ...*entity.HouseObject) {
+	var guids []string
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		if item.Location != "" {
+			item.GeoPrecision = entity.PrecisionHouse
+			continue
+		}
+		guids = append(guids, item.AoGuid)
+	}
+	if len(guids) == 0 {
+		return
+	}
+
+	list, err := a.addressRepo.GetAddressByGuidList(util.UniqueStringSlice(guids))
+	a.checkError(err)
+	a.FillLocations(list...)
+	addresses := make(map[string]*entity.AddressObject, len(list))
+	for _, address := range list {
+		addresses[address.AoGuid] = address
+	}
+	for _, item := range items {
+		if item == nil || item.Location != "" {
+			continue
+		}
+		if address, ok := addresses[item.AoGuid]; ok {
+			item.Location = address.Location
+			item.GeoPrecision = address.GeoPrecision
+		}
+	}
+}
+
+// Найти ближайший загруженный родительский объект с координатами, возвращает также GUID еще не загруженных родителей
+func (a *AddressService) findLocationParent(item *entity.AddressObject, parents map[string]*entity.AddressObject) (*entity.AddressObject, []string) {
+	var missing []string
+	for _, guid := range item.GetLocationParentGuids() {
+		parent, ok := parents[guid]
+		if !ok {
+			missing = append(missing, guid)
+			continue
+		}
+		if parent == nil || len(missing) > 0 {
+			continue
+		}
+		if parent.Location != "" {
+			return parent, nil
+		}
+		found, parentMissing := a.findLocationParent(parent, parents)
+		if found != nil {
+			return found, nil
+		}
+		missing = append(missing, parentMissing...)
+	}
+
+	return nil, missing
+}
+
 // Проверяет наличие ошибки и логирует ее
 func (a *AddressService) checkError(err error) {
 	if err != nil {
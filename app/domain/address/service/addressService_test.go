@@ -0,0 +1,76 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"testing"
+)
+
+// Адреса без координат получают координаты ближайшего родителя с координатами
+func TestFillLocations(t *testing.T) {
+	addressRepo := &testAddressRepo{items: []*entity.AddressObject{
+		{AoGuid: "region", AoLevel: 1, Location: "55.0,83.0"},
+		{AoGuid: "city", AoLevel: 4, ParentGuid: "region", RegionGuid: "region", Location: "55.03,82.92"},
+		{AoGuid: "district", AoLevel: 35, ParentGuid: "region", RegionGuid: "region", Location: "54.9,83.1"},
+		{AoGuid: "street", AoLevel: 7, ParentGuid: "city", CityGuid: "city", RegionGuid: "region"},
+		{AoGuid: "village", AoLevel: 6, ParentGuid: "unknown", RegionGuid: "region"},
+	}}
+	addressService := &AddressService{addressRepo: addressRepo, logger: testLogger{}}
+	items := []*entity.AddressObject{
+		{AoGuid: "own", AoLevel: 6, ParentGuid: "district", Location: "54.8,83.2"},
+		{AoGuid: "house", AoLevel: 8, ParentGuid: "street", Location: "55.04,82.93"},
+		{AoGuid: "street", AoLevel: 7, ParentGuid: "city", CityGuid: "city", RegionGuid: "region"},
+		{AoGuid: "lane", AoLevel: 7, ParentGuid: "street"},
+		{AoGuid: "village", AoLevel: 6, ParentGuid: "unknown", RegionGuid: "region"},
+		{AoGuid: "settlement", AoLevel: 6, ParentGuid: "district"},
+		{AoGuid: "lost", AoLevel: 7, ParentGuid: "unknown"},
+		nil,
+	}
+	addressService.FillLocations(items...)
+
+	tests := []struct {
+		location  string
+		precision string
+	}{
+		{"54.8,83.2", entity.PrecisionSettlement},
+		{"55.04,82.93", entity.PrecisionHouse},
+		{"55.03,82.92", entity.PrecisionCity},
+		{"55.03,82.92", entity.PrecisionCity},
+		{"55.0,83.0", entity.PrecisionCity},
+		{"54.9,83.1", entity.PrecisionSettlement},
+		{"", ""},
+	}
+	for i, test := range tests {
+		if items[i].Location != test.location || items[i].GeoPrecision != test.precision {
+			t.Errorf("%s: got %q %q, want %q %q", items[i].AoGuid, items[i].Location, items[i].GeoPrecision, test.location, test.precision)
+		}
+	}
+}
+
+// Дома без координат получают координаты своего адреса или его родителей
+func TestFillHouseLocations(t *testing.T) {
+	addressRepo := &testAddressRepo{items: []*entity.AddressObject{
+		{AoGuid: "city", AoLevel: 4, Location: "55.03,82.92"},
+		{AoGuid: "street", AoLevel: 7, ParentGuid: "city", CityGuid: "city"},
+	}}
+	addressService := &AddressService{addressRepo: addressRepo, logger: testLogger{}}
+	items := []*entity.HouseObject{
+		{HouseGuid: "h1", AoGuid: "street", Location: "55.04,82.93"},
+		{HouseGuid: "h2", AoGuid: "street"},
+		{HouseGuid: "h3", AoGuid: "unknown"},
+	}
+	addressService.FillHouseLocations(items...)
+
+	tests := []struct {
+		location  string
+		precision string
+	}{
+		{"55.04,82.93", entity.PrecisionHouse},
+		{"55.03,82.92", entity.PrecisionCity},
+		{"", ""},
+	}
+	for i, test := range tests {
+		if items[i].Location != test.location || items[i].GeoPrecision != test.precision {
+			t.Errorf("%s: got %q %q, want %q %q", items[i].HouseGuid, items[i].Location, items[i].GeoPrecision, test.location, test.precision)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package service
 import (
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/GarinAG/gofias/util"
 	xmlparser "github.com/tamerh/xml-stream-parser"
@@ -17,10 +19,15 @@ type AddressImportService struct {
 	AddressRepo repository.AddressRepositoryInterface // Репозиторий адресов
 	IsFull      bool                                  `default:"false"` // Полный импорт
 	logger      interfaces.LoggerInterface            // Логгер
+	journal     *journalService.JournalService        // Журнал импорта
+	regions     *RegionFilter                         // Фильтр импорта по регионам
+	Report      *ChangeReportService                  // Отчет об изменениях, если импорт выполняется без записи в БД
+	history     *AddressHistoryService                // Сервис истории адресов
+	Changes     *ChangeLogService                     // Журнал изменений, если изменения отслеживаются при импорте
 }
 
 // Инициализация сервиса
-func NewAddressImportService(addressRepo repository.AddressRepositoryInterface, logger interfaces.LoggerInterface) *AddressImportService {
+func NewAddressImportService(addressRepo repository.AddressRepositoryInterface, logger interfaces.LoggerInterface, journal *journalService.JournalService, regions *RegionFilter, history *AddressHistoryService) *AddressImportService {
 	err := addressRepo.Init()
 	if err != nil {
 		logger.Panic(err.Error())
@@ -30,33 +37,62 @@ func NewAddressImportService(addressRepo repository.AddressRepositoryInterface,
 	return &AddressImportService{
 		AddressRepo: addressRepo,
 		logger:      logger,
+		journal:     journal,
+		regions:     regions,
+		history:     history,
 	}
 }
 
 // Импорт адресов
-func (a *AddressImportService) Import(filePath string, wg *sync.WaitGroup, cnt chan int) {
+func (a *AddressImportService) Import(file directoryEntity.File, wg *sync.WaitGroup, cnt chan int) {
 	defer wg.Done()
+	// Открывает файл или файл в архиве для потокового чтения
+	reader, err := file.Open()
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "error": err}).Fatal("Error opening file")
+	}
+	defer reader.Close()
 	var importWg sync.WaitGroup
 	importWg.Add(2)
 	addressChannel := make(chan interface{})
 	// Чтение файла импорта и парсинг элементов
-	go util.ParseFile(&importWg, filePath, addressChannel, a.logger, a.ParseElement, "Object", -1)
+	go util.ParseFile(&importWg, reader, file.Path, addressChannel, a.logger, a.ParseElement, "Object", -1, a.journal.GetFileOffset(file.Path), a.journal.GetCheckpoint(file.Path))
 	// Сохраняет элементы в БД
-	go a.AddressRepo.InsertUpdateCollection(&importWg, addressChannel, cnt, a.IsFull)
+	go a.getSaver().InsertUpdateCollection(&importWg, addressChannel, cnt, a.IsFull)
 	importWg.Wait()
+	a.journal.FinishFile(file.Path)
+}
+
+// Получить обработчик сохранения адресов
+func (a *AddressImportService) getSaver() repository.InsertUpdateInterface {
+	if a.Report != nil {
+		return a.Report.Addresses()
+	}
+	var saver repository.InsertUpdateInterface = a.AddressRepo
+	if a.Changes != nil {
+		saver = a.Changes.AddressSaver(saver)
+	}
+	// При полном импорте в основную таблицу попадают только актуальные версии, все версии сохраняются в историю и ссылки на версии
+	if a.keepVersions() {
+		return a.history.Saver(saver, func(item entity.AddressObject) bool {
+			return !a.IsFull || item.IsActive()
+		})
+	}
+
+	return saver
 }
 
 // Индексация таблицы адресов
 func (a *AddressImportService) Index(isFull bool, start time.Time, guids []string, wg *sync.WaitGroup, indexChan chan<- entity.IndexObject) {
 	defer wg.Done()
-	err := a.AddressRepo.Index(isFull, start, guids, indexChan)
+	err := a.AddressRepo.Index(isFull, start, guids, a.regions.GetRegions(), indexChan)
 	a.checkError(err)
 }
 
 // Разбор объекта из xml
 func (a *AddressImportService) ParseElement(element *xmlparser.XMLElement) (interface{}, error) {
-	// Пропускает неактивные элементы при полном импорте
-	if a.IsFull {
+	// Пропускает неактивные элементы при полном импорте, если не сохраняются версии
+	if a.IsFull && !a.keepVersions() {
 		if element.Attrs["CURRSTATUS"] != "0" ||
 			element.Attrs["ACTSTATUS"] != "1" ||
 			element.Attrs["LIVESTATUS"] != "1" {
@@ -64,7 +100,13 @@ func (a *AddressImportService) ParseElement(element *xmlparser.XMLElement) (inte
 			return nil, nil
 		}
 	}
+	// Пропускает элементы невыбранных регионов
+	if !a.regions.HasRegion(element.Attrs["REGIONCODE"]) {
+		return nil, nil
+	}
+	a.regions.AddAddress(element.Attrs["AOGUID"])
 	level, _ := strconv.Atoi(element.Attrs["AOLEVEL"])
+	centStatus, _ := strconv.Atoi(element.Attrs["CENTSTATUS"])
 
 	result := entity.AddressObject{
 		ID:         element.Attrs["AOID"],
@@ -85,11 +127,19 @@ func (a *AddressImportService) ParseElement(element *xmlparser.XMLElement) (inte
 		StartDate:  element.Attrs["STARTDATE"],
 		EndDate:    element.Attrs["ENDDATE"],
 		UpdateDate: element.Attrs["UPDATEDATE"],
+		PrevId:     element.Attrs["PREVID"],
+		NextId:     element.Attrs["NEXTID"],
+		CentStatus: centStatus,
 	}
 
 	return result, nil
 }
 
+// Проверяет, сохраняются ли все версии адресов в историю и ссылки на версии
+func (a *AddressImportService) keepVersions() bool {
+	return a.Report == nil && a.history != nil
+}
+
 // Подсчет общего количества адресов
 func (a *AddressImportService) CountAllData() int64 {
 	res, err := a.AddressRepo.CountAllData(nil)
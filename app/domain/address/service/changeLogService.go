@@ -0,0 +1,177 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"os"
+	"sync"
+)
+
+// Сервис журнала изменений адресов и домов
+type ChangeLogService struct {
+	ChangeLogRepo repository.ChangeLogRepositoryInterface // Репозиторий журнала изменений
+	Enabled       bool                                    // Сохранять журнал изменений при импорте
+	publisher     repository.ChangePublisherInterface     // Публикация изменений, может отсутствовать
+	changes       *ChangeReportService                    // Сравнение загружаемых объектов с БД
+	versionId     int                                     // Загружаемая версия
+	batchSize     int                                     // Размер пачки для сохранения
+	logger        interfaces.LoggerInterface              // Логгер
+	mu            sync.Mutex                              // Блокировка сохранения изменений
+}
+
+// Инициализация сервиса
+func NewChangeLogService(changeLogRepo repository.ChangeLogRepositoryInterface, publisher repository.ChangePublisherInterface, changes *ChangeReportService, logger interfaces.LoggerInterface, config interfaces.ConfigInterface) *ChangeLogService {
+	enabled := config.GetConfig().Changes.Enable
+	if enabled {
+		if err := changeLogRepo.Init(); err != nil {
+			logger.Panic(err.Error())
+			os.Exit(1)
+		}
+	}
+	batchSize := config.GetConfig().BatchSize
+	if batchSize == 0 {
+		batchSize = 5000
+	}
+
+	return &ChangeLogService{
+		ChangeLogRepo: changeLogRepo,
+		Enabled:       enabled,
+		publisher:     publisher,
+		changes:       changes,
+		batchSize:     batchSize,
+		logger:        logger,
+	}
+}
+
+// Начать журнал изменений версии. Изменения, сохраненные прерванным импортом этой версии,
+// восстанавливаются, чтобы повторно загружаемые объекты сохранили исходный тип изменения
+func (c *ChangeLogService) Begin(versionId int) {
+	c.versionId = versionId
+	c.changes.Reset()
+	restoredCount := 0
+	afterId := ""
+	for {
+		items, err := c.ChangeLogRepo.GetChanges(versionId-1, afterId, int64(c.batchSize))
+		if err != nil {
+			c.logger.Fatal(err.Error())
+		}
+		var restored []*entity.ChangeLogItem
+		for _, item := range items {
+			if item.VersionId == versionId {
+				restored = append(restored, item)
+			}
+		}
+		c.changes.RestoreChanges(restored)
+		restoredCount += len(restored)
+		// Записи упорядочены по версии, записи следующих версий завершают выборку
+		if len(restored) < len(items) || len(items) < c.batchSize {
+			break
+		}
+		afterId = items[len(items)-1].ID
+	}
+	if restoredCount > 0 {
+		c.logger.WithFields(interfaces.LoggerFields{"version": versionId, "count": restoredCount}).Info("Change log restored")
+	}
+}
+
+// Получить обработчик сохранения адресов, записывающий изменения в журнал
+func (c *ChangeLogService) AddressSaver(saver repository.InsertUpdateInterface) repository.InsertUpdateInterface {
+	return c.changes.AddressSaver(saver, c.save)
+}
+
+// Получить обработчик сохранения домов, записывающий изменения в журнал
+func (c *ChangeLogService) HouseSaver(saver repository.InsertUpdateInterface, regionCode string) repository.InsertUpdateInterface {
+	return c.changes.HouseSaver(saver, regionCode, c.save)
+}
+
+// Сохранить в журнал изменения объектов, сравненных с БД после предыдущего сохранения.
+// Вызывается до сохранения объектов, поэтому изменения не теряются при прерывании импорта
+func (c *ChangeLogService) save() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	items := c.changes.TakeDirtyChanges(c.versionId)
+	for start := 0; start < len(items); start += c.batchSize {
+		end := start + c.batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		if err := c.ChangeLogRepo.Save(items[start:end]); err != nil {
+			c.logger.Fatal(err.Error())
+		}
+	}
+}
+
+// Завершить журнал изменений загруженной версии
+func (c *ChangeLogService) Commit(versionId int) {
+	c.save()
+	count := c.changes.CountChanges()
+	c.changes.Reset()
+	c.logger.WithFields(interfaces.LoggerFields{"version": versionId, "count": count}).Info("Change log saved")
+}
+
+// Опубликовать сохраненные изменения после последней опубликованной записи
+func (c *ChangeLogService) Publish() {
+	if c.publisher == nil {
+		return
+	}
+	afterId, err := c.publisher.GetLastPublished()
+	if err != nil {
+		c.logger.WithFields(interfaces.LoggerFields{"error": err}).Error("Change log publishing failed")
+		return
+	}
+	count := 0
+	// Получает записи журнала пачками по возрастанию идентификатора
+	for {
+		items, err := c.ChangeLogRepo.GetChanges(0, afterId, int64(c.batchSize))
+		if err != nil {
+			c.logger.WithFields(interfaces.LoggerFields{"error": err}).Error("Change log publishing failed")
+			return
+		}
+		if len(items) == 0 {
+			break
+		}
+		list := make([]entity.ChangeLogItem, 0, len(items))
+		for _, item := range items {
+			list = append(list, *item)
+		}
+		if err := c.publisher.Publish(list); err != nil {
+			c.logger.WithFields(interfaces.LoggerFields{"error": err}).Error("Change log publishing failed")
+			return
+		}
+		count += len(items)
+		if len(items) < c.batchSize {
+			break
+		}
+		afterId = items[len(items)-1].ID
+	}
+	if count > 0 {
+		c.logger.WithFields(interfaces.LoggerFields{"count": count}).Info("Change log published")
+	}
+}
+
+// Получить страницу записей журнала изменений версий после указанной и идентификатор последней записи страницы
+func (c *ChangeLogService) GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, string) {
+	if size == 0 {
+		size = 1000
+	}
+	items, err := c.ChangeLogRepo.GetChanges(sinceVersion, afterId, size)
+	if err != nil {
+		c.logger.Error(err.Error())
+	}
+	if int64(len(items)) < size {
+		return items, ""
+	}
+
+	return items, items[len(items)-1].ID
+}
+
+// Закрыть соединение публикации изменений
+func (c *ChangeLogService) Close() {
+	if c.publisher != nil {
+		if err := c.publisher.Close(); err != nil {
+			c.logger.Error(err.Error())
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// Репозиторий журнала изменений в памяти
+type testChangeLogRepo struct {
+	items map[string]entity.ChangeLogItem
+}
+
+func (r *testChangeLogRepo) Init() error  { return nil }
+func (r *testChangeLogRepo) Clear() error { r.items = nil; return nil }
+func (r *testChangeLogRepo) Save(items []entity.ChangeLogItem) error {
+	if r.items == nil {
+		r.items = make(map[string]entity.ChangeLogItem)
+	}
+	for _, item := range items {
+		r.items[item.ID] = item
+	}
+
+	return nil
+}
+func (r *testChangeLogRepo) GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, error) {
+	var ids []string
+	for id, item := range r.items {
+		if item.VersionId > sinceVersion && id > afterId {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	var res []*entity.ChangeLogItem
+	for _, id := range ids {
+		if int64(len(res)) == size {
+			break
+		}
+		item := r.items[id]
+		res = append(res, &item)
+	}
+
+	return res, nil
+}
+func (r *testChangeLogRepo) GetIndexName() string { return "test_changes" }
+
+// Публикация изменений в память
+type testChangePublisher struct {
+	items []entity.ChangeLogItem
+}
+
+func (p *testChangePublisher) Publish(items []entity.ChangeLogItem) error {
+	p.items = append(p.items, items...)
+	return nil
+}
+func (p *testChangePublisher) GetLastPublished() (string, error) {
+	if len(p.items) == 0 {
+		return "", nil
+	}
+
+	return p.items[len(p.items)-1].ID, nil
+}
+func (p *testChangePublisher) Close() error { return nil }
+
+// Репозиторий адресов, содержащий только указанные адреса
+type testAddressRepo struct {
+	repository.AddressRepositoryInterface
+	items []*entity.AddressObject
+}
+
+func (r *testAddressRepo) GetAddressByGuidList(guids []string) ([]*entity.AddressObject, error) {
+	var res []*entity.AddressObject
+	for _, item := range r.items {
+		for _, guid := range guids {
+			if item.AoGuid == guid {
+				res = append(res, item)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// Изменения прерванного импорта версии восстанавливаются, повторно загружаемые объекты сохраняют тип изменения
+func TestChangeLogBeginRestore(t *testing.T) {
+	repo := &testChangeLogRepo{}
+	repo.Save([]entity.ChangeLogItem{
+		entity.NewChangeLogItem(4, entity.ChangeObjectAddress, "a0", entity.ChangeUpdated, "54"),
+		entity.NewChangeLogItem(5, entity.ChangeObjectAddress, "a1", entity.ChangeInserted, "54"),
+		entity.NewChangeLogItem(5, entity.ChangeObjectAddress, "a2", entity.ChangeUpdated, "54"),
+		entity.NewChangeLogItem(5, entity.ChangeObjectAddress, "a3", entity.ChangeDeactivated, "54"),
+		entity.NewChangeLogItem(5, entity.ChangeObjectHouse, "h1", entity.ChangeInserted, "54"),
+		entity.NewChangeLogItem(6, entity.ChangeObjectAddress, "a6", entity.ChangeInserted, "54"),
+	})
+	// Адрес a1 сохранен в БД до прерывания импорта
+	addressRepo := &testAddressRepo{items: []*entity.AddressObject{{ID: "1", AoGuid: "a1"}, {ID: "2", AoGuid: "a2"}}}
+	changeLog := &ChangeLogService{
+		ChangeLogRepo: repo,
+		Enabled:       true,
+		changes:       NewChangeReportService(addressRepo, nil, testLogger{}, 2),
+		batchSize:     2,
+		logger:        testLogger{},
+	}
+
+	changeLog.Begin(5)
+	if count := changeLog.changes.CountChanges(); count != 4 {
+		t.Errorf("got %d restored changes, want 4", count)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	channel := make(chan interface{})
+	count := make(chan int, 1)
+	main := &testHistoryRepo{}
+	go changeLog.AddressSaver(main).InsertUpdateCollection(&wg, channel, count, false)
+	channel <- entity.AddressObject{ID: "1", AoGuid: "a1", FormalName: "Ленина", ShortName: "ул", OffName: "Ленина", RegionCode: "54", ActStatus: "1", LiveStatus: "1", CurrStatus: "0"}
+	channel <- entity.AddressObject{ID: "4", AoGuid: "a4", FormalName: "Мира", ShortName: "ул", OffName: "Мира", RegionCode: "54", ActStatus: "1", LiveStatus: "1", CurrStatus: "0"}
+	close(channel)
+	wg.Wait()
+	<-count
+	changeLog.Commit(5)
+
+	want := map[string]string{
+		"0000000004_address_a0": entity.ChangeUpdated,
+		"0000000005_address_a1": entity.ChangeInserted,
+		"0000000005_address_a2": entity.ChangeUpdated,
+		"0000000005_address_a3": entity.ChangeDeactivated,
+		"0000000005_address_a4": entity.ChangeInserted,
+		"0000000005_house_h1":   entity.ChangeInserted,
+		"0000000006_address_a6": entity.ChangeInserted,
+	}
+	if len(repo.items) != len(want) {
+		t.Errorf("got %d change log items, want %d", len(repo.items), len(want))
+	}
+	for id, changeType := range want {
+		if item, ok := repo.items[id]; !ok || item.ChangeType != changeType {
+			t.Errorf("%s: got %q, want %q", id, item.ChangeType, changeType)
+		}
+	}
+}
+
+// Публикация продолжается после последней опубликованной записи
+func TestChangeLogPublish(t *testing.T) {
+	repo := &testChangeLogRepo{}
+	for _, guid := range []string{"a1", "a2", "a3"} {
+		repo.Save([]entity.ChangeLogItem{entity.NewChangeLogItem(1, entity.ChangeObjectAddress, guid, entity.ChangeInserted, "54")})
+	}
+	publisher := &testChangePublisher{}
+	changeLog := &ChangeLogService{ChangeLogRepo: repo, publisher: publisher, batchSize: 2, logger: testLogger{}}
+
+	changeLog.Publish()
+	if len(publisher.items) != 3 {
+		t.Fatalf("got %d published items, want 3", len(publisher.items))
+	}
+	repo.Save([]entity.ChangeLogItem{
+		entity.NewChangeLogItem(2, entity.ChangeObjectAddress, "a1", entity.ChangeUpdated, "54"),
+		entity.NewChangeLogItem(2, entity.ChangeObjectHouse, "h1", entity.ChangeInserted, "54"),
+	})
+	changeLog.Publish()
+	changeLog.Publish()
+
+	want := []string{
+		"0000000001_address_a1",
+		"0000000001_address_a2",
+		"0000000001_address_a3",
+		"0000000002_address_a1",
+		"0000000002_house_h1",
+	}
+	if len(publisher.items) != len(want) {
+		t.Fatalf("got %d published items, want %d", len(publisher.items), len(want))
+	}
+	for i, id := range want {
+		if publisher.items[i].ID != id {
+			t.Errorf("item %d: got %s, want %s", i, publisher.items[i].ID, id)
+		}
+	}
+}
@@ -0,0 +1,498 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"sort"
+	"sync"
+)
+
+// Состояние объекта при сравнении с данными в БД
+type objectChange struct {
+	region  string // Код региона
+	name    string // Название объекта
+	exists  bool   // Объект есть в БД
+	active  bool   // Загружается актуальная запись объекта
+	removed bool   // Запись объекта в БД становится неактуальной
+	dead    bool   // Объект ликвидирован
+	dirty   bool   // Изменение еще не передано в журнал
+}
+
+// Набор изменений объектов по GUID в порядке появления
+type changeSet struct {
+	items map[string]*objectChange // Изменения по GUID
+	order []string                 // Порядок появления GUID
+	dirty []string                 // GUID объектов, изменения которых еще не переданы в журнал
+}
+
+// Получить состояние объекта, создает его при отсутствии
+func (c *changeSet) get(guid string) (*objectChange, bool) {
+	item, ok := c.items[guid]
+	if !ok {
+		item = &objectChange{}
+		c.items[guid] = item
+		c.order = append(c.order, guid)
+	}
+
+	return item, !ok
+}
+
+// Отметить объект измененным после последней передачи изменений в журнал
+func (c *changeSet) touch(guid string, item *objectChange) {
+	if !item.dirty {
+		item.dirty = true
+		c.dirty = append(c.dirty, guid)
+	}
+}
+
+// Получить тип изменения объекта, пустой тип означает отсутствие изменений
+func (c *objectChange) changeType() string {
+	switch {
+	case c.active && c.exists:
+		return entity.ChangeUpdated
+	case c.active:
+		return entity.ChangeInserted
+	case c.removed && c.dead:
+		return entity.ChangeDeleted
+	case c.removed:
+		return entity.ChangeDeactivated
+	}
+
+	// Неактуальная запись, отсутствующая в БД, ничего не меняет
+	return ""
+}
+
+// Сервис построения отчета об изменениях импорта без записи в БД
+type ChangeReportService struct {
+	addressRepo    repository.AddressRepositoryInterface // Репозиторий адресов
+	houseRepo      repository.HouseRepositoryInterface   // Репозиторий домов
+	logger         interfaces.LoggerInterface            // Логгер
+	batchSize      int                                   // Размер пачки для сравнения с БД
+	Samples        int                                   // Количество примеров объектов для каждого типа изменений
+	versions       []int                                 // Проверенные версии
+	addresses      *changeSet                            // Изменения адресов
+	houses         *changeSet                            // Изменения домов
+	addressRegions map[string]string                     // Коды регионов адресов по GUID
+	mu             sync.Mutex                            // Блокировка изменения отчета
+}
+
+// Инициализация сервиса
+func NewChangeReportService(addressRepo repository.AddressRepositoryInterface, houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface, batchSize int) *ChangeReportService {
+	if batchSize == 0 {
+		batchSize = 5000
+	}
+
+	return &ChangeReportService{
+		addressRepo:    addressRepo,
+		houseRepo:      houseRepo,
+		logger:         logger,
+		batchSize:      batchSize,
+		Samples:        5,
+		addresses:      &changeSet{items: make(map[string]*objectChange)},
+		houses:         &changeSet{items: make(map[string]*objectChange)},
+		addressRegions: make(map[string]string),
+	}
+}
+
+// Добавить проверенную версию
+func (r *ChangeReportService) AddVersion(versionId int) {
+	r.versions = append(r.versions, versionId)
+}
+
+// Получить обработчик адресов, заменяющий сохранение в БД
+func (r *ChangeReportService) Addresses() repository.InsertUpdateInterface {
+	return &addressChangeCollector{report: r}
+}
+
+// Получить обработчик домов, заменяющий сохранение в БД, пустой код региона определяется по адресу дома
+func (r *ChangeReportService) Houses(regionCode string) repository.InsertUpdateInterface {
+	return &houseChangeCollector{report: r, regionCode: regionCode}
+}
+
+// Получить обработчик, сравнивающий адреса с БД перед передачей основному обработчику сохранения.
+// Функция commit вызывается после сравнения каждой пачки, до сохранения ее объектов
+func (r *ChangeReportService) AddressSaver(saver repository.InsertUpdateInterface, commit func()) repository.InsertUpdateInterface {
+	return &addressChangeCollector{report: r, saver: saver, commit: commit}
+}
+
+// Получить обработчик, сравнивающий дома с БД перед передачей основному обработчику сохранения.
+// Функция commit вызывается после сравнения каждой пачки, до сохранения ее объектов
+func (r *ChangeReportService) HouseSaver(saver repository.InsertUpdateInterface, regionCode string, commit func()) repository.InsertUpdateInterface {
+	return &houseChangeCollector{report: r, regionCode: regionCode, saver: saver, commit: commit}
+}
+
+// Получить наборы изменений по типам объектов журнала
+func (r *ChangeReportService) changeSets() map[string]*changeSet {
+	return map[string]*changeSet{
+		entity.ChangeObjectAddress: r.addresses,
+		entity.ChangeObjectHouse:   r.houses,
+	}
+}
+
+// Получить изменения адресов и домов, сравненных после предыдущего вызова.
+// Признаки состояния объекта только устанавливаются, поэтому записанное изменение не может стать пустым
+func (r *ChangeReportService) TakeDirtyChanges(versionId int) []entity.ChangeLogItem {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var items []entity.ChangeLogItem
+	sets := r.changeSets()
+	for _, objectType := range []string{entity.ChangeObjectAddress, entity.ChangeObjectHouse} {
+		set := sets[objectType]
+		for _, guid := range set.dirty {
+			change := set.items[guid]
+			change.dirty = false
+			if changeType := change.changeType(); changeType != "" {
+				items = append(items, entity.NewChangeLogItem(versionId, objectType, guid, changeType, change.region))
+			}
+		}
+		set.dirty = nil
+	}
+
+	return items
+}
+
+// Восстановить изменения версии, сохраненные в журнал до прерывания импорта
+func (r *ChangeReportService) RestoreChanges(items []*entity.ChangeLogItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sets := r.changeSets()
+	for _, item := range items {
+		set, ok := sets[item.ObjectType]
+		if !ok {
+			continue
+		}
+		change, _ := set.get(item.Guid)
+		change.region = item.RegionCode
+		// Состояние объекта до первого сравнения определяется по типу изменения
+		switch item.ChangeType {
+		case entity.ChangeInserted:
+			change.active = true
+		case entity.ChangeUpdated:
+			change.exists, change.active = true, true
+		case entity.ChangeDeactivated:
+			change.exists, change.removed = true, true
+		case entity.ChangeDeleted:
+			change.exists, change.removed, change.dead = true, true, true
+		}
+	}
+}
+
+// Получить количество измененных адресов и домов
+func (r *ChangeReportService) CountChanges() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+	for _, set := range r.changeSets() {
+		for _, change := range set.items {
+			if change.changeType() != "" {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// Очистить собранные изменения
+func (r *ChangeReportService) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.addresses = &changeSet{items: make(map[string]*objectChange)}
+	r.houses = &changeSet{items: make(map[string]*objectChange)}
+	r.addressRegions = make(map[string]string)
+}
+
+// Сравнить пачку адресов с данными в БД
+func (r *ChangeReportService) compareAddresses(items []entity.AddressObject) {
+	var guids []string
+	for _, item := range items {
+		guids = append(guids, item.AoGuid)
+	}
+	// Получает записи адресов из БД
+	exists, err := r.addressRepo.GetAddressByGuidList(util.UniqueStringSlice(guids))
+	r.checkFatalError(err)
+	ids := make(map[string]string)
+	for _, item := range exists {
+		ids[item.AoGuid] = item.ID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, item := range items {
+		change, created := r.addresses.get(item.AoGuid)
+		r.addresses.touch(item.AoGuid, change)
+		storedId, ok := ids[item.AoGuid]
+		change.region = item.RegionCode
+		// Наличие в БД определяется при первом появлении объекта, до сохранения его записей
+		if created {
+			change.exists = ok
+		}
+		if item.IsActive() {
+			change.active = true
+			change.name = util.PrepareFullName(item.ShortName, item.OffName)
+		} else if ok && storedId == item.ID {
+			// Неактуальная запись заменяет запись в БД
+			change.removed = true
+			change.dead = item.LiveStatus == "0"
+		}
+		if change.name == "" {
+			change.name = util.PrepareFullName(item.ShortName, item.OffName)
+		}
+		r.addressRegions[item.AoGuid] = item.RegionCode
+	}
+}
+
+// Сравнить пачку домов с данными в БД
+func (r *ChangeReportService) compareHouses(items []entity.HouseObject, regionCode string) {
+	var guids []string
+	for _, item := range items {
+		guids = append(guids, item.HouseGuid)
+	}
+	// Получает записи домов из БД
+	exists, err := r.houseRepo.GetByGuidList(util.UniqueStringSlice(guids))
+	r.checkFatalError(err)
+	ids := make(map[string]string)
+	for _, item := range exists {
+		ids[item.HouseGuid] = item.ID
+	}
+	regions := r.getAddressRegions(items)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, item := range items {
+		change, created := r.houses.get(item.HouseGuid)
+		r.houses.touch(item.HouseGuid, change)
+		storedId, ok := ids[item.HouseGuid]
+		change.region = regionCode
+		if change.region == "" {
+			change.region = regions[item.AoGuid]
+		}
+		if created {
+			change.exists = ok
+		}
+		change.name = r.houseName(item)
+		if item.IsActive() {
+			change.active = true
+		} else if ok && storedId == item.ID {
+			// Неактуальная запись заменяет запись в БД
+			change.removed = true
+		}
+	}
+}
+
+// Получить коды регионов домов по GUID адресов
+func (r *ChangeReportService) getAddressRegions(items []entity.HouseObject) map[string]string {
+	regions := make(map[string]string)
+	var guids []string
+	r.mu.Lock()
+	for _, item := range items {
+		if region, ok := r.addressRegions[item.AoGuid]; ok {
+			regions[item.AoGuid] = region
+		} else if item.AoGuid != "" {
+			guids = append(guids, item.AoGuid)
+		}
+	}
+	r.mu.Unlock()
+	if len(guids) == 0 {
+		return regions
+	}
+
+	// Определяет регион адресов, отсутствующих в загружаемых файлах, по данным в БД
+	addresses, err := r.addressRepo.GetAddressByGuidList(util.UniqueStringSlice(guids))
+	r.checkFatalError(err)
+	r.mu.Lock()
+	for _, address := range addresses {
+		regions[address.AoGuid] = address.RegionCode
+		r.addressRegions[address.AoGuid] = address.RegionCode
+	}
+	r.mu.Unlock()
+
+	return regions
+}
+
+// Форматировать номер дома
+func (r *ChangeReportService) houseName(item entity.HouseObject) string {
+	name := "д. " + item.HouseNum
+	if item.StructNum != "" {
+		name += ", стр. " + item.StructNum
+	}
+	if item.BuildNum != "" {
+		name += ", кор. " + item.BuildNum
+	}
+
+	return name
+}
+
+// Получить отчет об изменениях по регионам
+func (r *ChangeReportService) GetReport() *entity.ChangeReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	regions := make(map[string]*entity.RegionChanges)
+	getRegion := func(code string) *entity.RegionChanges {
+		region, ok := regions[code]
+		if !ok {
+			region = &entity.RegionChanges{
+				Region:    code,
+				Addresses: r.newCounters(),
+				Houses:    r.newCounters(),
+			}
+			regions[code] = region
+		}
+		return region
+	}
+	r.fillCounters(r.addresses, func(code string) map[string]*entity.ChangeCounter {
+		return getRegion(code).Addresses
+	})
+	r.fillCounters(r.houses, func(code string) map[string]*entity.ChangeCounter {
+		return getRegion(code).Houses
+	})
+
+	report := &entity.ChangeReport{Versions: r.versions}
+	for _, region := range regions {
+		report.Regions = append(report.Regions, region)
+	}
+	sort.Slice(report.Regions, func(i, j int) bool {
+		return report.Regions[i].Region < report.Regions[j].Region
+	})
+
+	return report
+}
+
+// Создать пустые счетчики изменений
+func (r *ChangeReportService) newCounters() map[string]*entity.ChangeCounter {
+	counters := make(map[string]*entity.ChangeCounter)
+	for _, changeType := range entity.ChangeTypes {
+		counters[changeType] = &entity.ChangeCounter{}
+	}
+
+	return counters
+}
+
+// Распределить изменения объектов по регионам и типам изменений
+func (r *ChangeReportService) fillCounters(set *changeSet, counters func(code string) map[string]*entity.ChangeCounter) {
+	for _, guid := range set.order {
+		change := set.items[guid]
+		changeType := change.changeType()
+		if changeType == "" {
+			continue
+		}
+		counter := counters(change.region)[changeType]
+		counter.Count++
+		if len(counter.Samples) < r.Samples {
+			counter.Samples = append(counter.Samples, entity.ChangeSample{Guid: guid, Name: change.name})
+		}
+	}
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (r *ChangeReportService) checkFatalError(err error) {
+	if err != nil {
+		r.logger.Fatal(err.Error())
+	}
+}
+
+// Обработчик адресов для отчета об изменениях
+type addressChangeCollector struct {
+	report *ChangeReportService             // Сервис отчета
+	saver  repository.InsertUpdateInterface // Основной обработчик сохранения, без него адреса только сравниваются с БД
+	commit func()                           // Сохранение изменений сравненной пачки, может отсутствовать
+}
+
+// Сравнить коллекцию адресов с БД и передать ее основному обработчику сохранения
+func (c *addressChangeCollector) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	var items []entity.AddressObject
+	collectChanges(wg, c.saver, channel, count, isFull, c.report.batchSize, func(d interface{}) {
+		items = append(items, d.(entity.AddressObject))
+	}, func() {
+		if len(items) > 0 {
+			c.report.compareAddresses(items)
+			items = nil
+		}
+	}, c.commit)
+}
+
+// Обработчик домов для отчета об изменениях
+type houseChangeCollector struct {
+	report     *ChangeReportService             // Сервис отчета
+	regionCode string                           // Код региона файла
+	saver      repository.InsertUpdateInterface // Основной обработчик сохранения, без него дома только сравниваются с БД
+	commit     func()                           // Сохранение изменений сравненной пачки, может отсутствовать
+}
+
+// Сравнить коллекцию домов с БД и передать ее основному обработчику сохранения
+func (c *houseChangeCollector) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	var items []entity.HouseObject
+	collectChanges(wg, c.saver, channel, count, isFull, c.report.batchSize, func(d interface{}) {
+		items = append(items, d.(entity.HouseObject))
+	}, func() {
+		if len(items) > 0 {
+			c.report.compareHouses(items, c.regionCode)
+			items = nil
+		}
+	}, c.commit)
+}
+
+// Прочитать коллекцию объектов пачками: каждая пачка сравнивается с БД и затем передается основному обработчику
+func collectChanges(wg *sync.WaitGroup, saver repository.InsertUpdateInterface, channel <-chan interface{}, count chan<- int, isFull bool, batchSize int, add func(d interface{}), compare func(), commit func()) {
+	defer wg.Done()
+	if saver == nil {
+		total := 0
+		for d := range channel {
+			// Контрольные точки не сохраняются, журнал импорта не изменяется
+			if _, ok := d.(util.CheckpointObject); ok {
+				continue
+			}
+			total++
+			add(d)
+			if total%batchSize == 0 {
+				compare()
+			}
+		}
+		compare()
+		count <- total
+		return
+	}
+
+	var saveWg sync.WaitGroup
+	saveWg.Add(1)
+	saveChannel := make(chan interface{})
+	go saver.InsertUpdateCollection(&saveWg, saveChannel, count, isFull)
+	var pending []interface{}
+	// Объекты передаются на сохранение только после сравнения с БД и сохранения изменений,
+	// поэтому контрольная точка журнала импорта фиксируется после записи изменений предшествующих объектов
+	flush := func() {
+		compare()
+		if commit != nil {
+			commit()
+		}
+		for _, d := range pending {
+			saveChannel <- d
+		}
+		pending = nil
+	}
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		pending = append(pending, d)
+		// Контрольная точка фиксируется основным обработчиком после сохранения предшествующих объектов
+		if _, ok := d.(util.CheckpointObject); ok {
+			flush()
+			continue
+		}
+		add(d)
+		if len(pending) >= batchSize {
+			flush()
+		}
+	}
+	flush()
+	close(saveChannel)
+	saveWg.Wait()
+}
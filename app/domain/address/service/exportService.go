@@ -0,0 +1,48 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+)
+
+// Сервис выгрузки адресов и домов
+type ExportService struct {
+	addressRepo repository.AddressRepositoryInterface // Репозиторий адресов
+	houseRepo   repository.HouseRepositoryInterface   // Репозиторий домов
+	logger      interfaces.LoggerInterface            // Логгер
+}
+
+// Инициализация сервиса
+func NewExportService(addressRepo repository.AddressRepositoryInterface, houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface) *ExportService {
+	return &ExportService{
+		addressRepo: addressRepo,
+		houseRepo:   houseRepo,
+		logger:      logger,
+	}
+}
+
+// Выгрузить адреса по фильтру, каждая пачка передается в обработчик
+func (e *ExportService) ExportAddresses(filter entity.ExportFilter, handler func(items []*entity.AddressObject) error) error {
+	return e.addressRepo.Export(filter, handler)
+}
+
+// Выгрузить дома по фильтру, каждая пачка передается в обработчик
+func (e *ExportService) ExportHouses(filter entity.ExportFilter, handler func(items []*entity.HouseObject) error) error {
+	if len(filter.Regions) == 0 {
+		return e.houseRepo.Export(filter, handler)
+	}
+	// Дома не содержат кода региона, поэтому выбираются по адресам регионов
+	return e.addressRepo.Export(entity.ExportFilter{Regions: filter.Regions}, func(addresses []*entity.AddressObject) error {
+		var guids []string
+		for _, address := range addresses {
+			guids = append(guids, address.AoGuid)
+		}
+
+		return e.houseRepo.Export(entity.ExportFilter{
+			AddressGuids: util.UniqueStringSlice(guids),
+			UpdatedSince: filter.UpdatedSince,
+		}, handler)
+	})
+}
@@ -0,0 +1,546 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	xmlparser "github.com/tamerh/xml-stream-parser"
+	"io"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Соответствие уровней адресных объектов ГАР уровням ФИАС
+var garLevels = map[int]int{
+	1:  1,
+	2:  3,
+	3:  3,
+	4:  35,
+	5:  4,
+	6:  6,
+	7:  65,
+	8:  7,
+	13: 2,
+	14: 5,
+	15: 90,
+	16: 91,
+}
+
+// Сервис импорта данных в формате ГАР
+type GarImportService struct {
+	addressRepo repository.AddressRepositoryInterface // Репозиторий адресов
+	houseRepo   repository.HouseRepositoryInterface   // Репозиторий домов
+	logger      interfaces.LoggerInterface            // Логгер
+	batchSize   int                                   // Размер пачки для обновления
+	IsFull      bool                                  `default:"false"` // Полный импорт
+	SkipHouses  bool                                  `default:"false"` // Пропускать импорт домов
+	currentTime int64                                 // Время начала импорта
+	journal     *journalService.JournalService        // Журнал импорта
+	history     *AddressHistoryService                // Сервис истории адресов
+	Report      *ChangeReportService                  // Отчет об изменениях, если импорт выполняется без записи в БД
+	Changes     *ChangeLogService                     // Журнал изменений, если изменения отслеживаются при импорте
+}
+
+// Инициализация сервиса
+func NewGarImportService(addressRepo repository.AddressRepositoryInterface, houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface, batchSize int, journal *journalService.JournalService, history *AddressHistoryService) *GarImportService {
+	if batchSize == 0 {
+		batchSize = 5000
+	}
+
+	return &GarImportService{
+		addressRepo: addressRepo,
+		houseRepo:   houseRepo,
+		logger:      logger,
+		batchSize:   batchSize,
+		currentTime: time.Now().Unix(),
+		journal:     journal,
+		history:     history,
+	}
+}
+
+// Импорт файлов региона
+func (g *GarImportService) ImportRegion(regionCode string, files []directoryEntity.File) (int, int) {
+	g.logger.WithFields(interfaces.LoggerFields{"region": regionCode}).Info("Start region import")
+	var wg sync.WaitGroup
+	// Канал подсчета количества адресов
+	cha := make(chan int)
+	// Канал подсчета количества домов
+	chb := make(chan int)
+	cntAddrFiles := 0
+	cntHouseFiles := 0
+	cntAddr := 0
+	cntHouse := 0
+
+	// Пропускает файлы, полностью обработанные при прошлом запуске
+	var pending []directoryEntity.File
+	for _, file := range files {
+		if !g.journal.IsFileFinished(file.Path) {
+			pending = append(pending, file)
+		}
+	}
+	files = pending
+
+	// Импортирует объекты, иерархия и параметры ссылаются на них
+	for _, file := range files {
+		if g.match(entity.AddressObject{}.GetGarXmlFile(), file.Path) {
+			cntAddrFiles++
+			wg.Add(1)
+			go g.ImportAddresses(file, regionCode, &wg, cha)
+		}
+		if !g.SkipHouses && g.match(entity.HouseObject{}.GetGarXmlFile(), file.Path) {
+			cntHouseFiles++
+			wg.Add(1)
+			go g.ImportHouses(file, regionCode, &wg, chb)
+		}
+	}
+	for cntAddrFiles > 0 || cntHouseFiles > 0 {
+		select {
+		case cnt := <-cha:
+			cntAddr += cnt
+			cntAddrFiles--
+		case cnt := <-chb:
+			cntHouse += cnt
+			cntHouseFiles--
+		}
+	}
+	wg.Wait()
+	// Иерархия и параметры не влияют на отчет об изменениях
+	if g.Report != nil {
+		return cntAddr, cntHouse
+	}
+
+	// Обновляет административную и муниципальную иерархии объектов
+	for _, hierarchyType := range []string{entity.AdmHierarchy, entity.MunHierarchy} {
+		for _, file := range files {
+			if g.match(entity.HierarchyObject{Type: hierarchyType}.GetXmlFile(), file.Path) {
+				g.ImportHierarchy(file, hierarchyType)
+			}
+		}
+	}
+
+	// Обновляет параметры объектов
+	for _, file := range files {
+		if g.match(entity.AddressObject{}.GetGarParamsXmlFile(), file.Path) {
+			g.ImportAddressParams(file)
+		}
+		if !g.SkipHouses && g.match(entity.HouseObject{}.GetGarParamsXmlFile(), file.Path) {
+			g.ImportHouseParams(file)
+		}
+	}
+
+	return cntAddr, cntHouse
+}
+
+// Импорт адресов
+func (g *GarImportService) ImportAddresses(file directoryEntity.File, regionCode string, wg *sync.WaitGroup, cnt chan int) {
+	defer wg.Done()
+	reader := g.openFile(file)
+	defer reader.Close()
+	var importWg sync.WaitGroup
+	importWg.Add(2)
+	addressChannel := make(chan interface{})
+	parseElement := func(element *xmlparser.XMLElement) (interface{}, error) {
+		return g.ParseAddressElement(element, regionCode)
+	}
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&importWg, reader, file.Path, addressChannel, g.logger, parseElement, "OBJECT", -1, g.journal.GetFileOffset(file.Path), g.journal.GetCheckpoint(file.Path))
+	// Сохраняет элементы в БД
+	go g.getAddressSaver().InsertUpdateCollection(&importWg, addressChannel, cnt, g.IsFull)
+	importWg.Wait()
+	g.journal.FinishFile(file.Path)
+}
+
+// Импорт домов
+func (g *GarImportService) ImportHouses(file directoryEntity.File, regionCode string, wg *sync.WaitGroup, cnt chan int) {
+	defer wg.Done()
+	reader := g.openFile(file)
+	defer reader.Close()
+	var importWg sync.WaitGroup
+	importWg.Add(2)
+	houseChannel := make(chan interface{})
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&importWg, reader, file.Path, houseChannel, g.logger, g.ParseHouseElement, "HOUSE", -1, g.journal.GetFileOffset(file.Path), g.journal.GetCheckpoint(file.Path))
+	// Сохраняет элементы в БД
+	go g.getHouseSaver(regionCode).InsertUpdateCollection(&importWg, houseChannel, cnt, g.IsFull)
+	importWg.Wait()
+	g.journal.FinishFile(file.Path)
+}
+
+// Получить обработчик сохранения адресов
+func (g *GarImportService) getAddressSaver() repository.InsertUpdateInterface {
+	if g.Report != nil {
+		return g.Report.Addresses()
+	}
+	var saver repository.InsertUpdateInterface = g.addressRepo
+	if g.Changes != nil {
+		saver = g.Changes.AddressSaver(saver)
+	}
+	// В основную таблицу попадают только актуальные записи, при полном импорте - только активные, все версии сохраняются в историю и ссылки на версии
+	if g.keepVersions() {
+		return g.history.Saver(saver, func(item entity.AddressObject) bool {
+			return item.ActStatus == "1" && (!g.IsFull || item.IsActive())
+		})
+	}
+
+	return saver
+}
+
+// Проверяет, сохраняются ли все версии адресов в историю и ссылки на версии
+func (g *GarImportService) keepVersions() bool {
+	return g.Report == nil && g.history != nil
+}
+
+// Получить обработчик сохранения домов
+func (g *GarImportService) getHouseSaver(regionCode string) repository.InsertUpdateInterface {
+	if g.Report != nil {
+		return g.Report.Houses(regionCode)
+	}
+	if g.Changes != nil {
+		return g.Changes.HouseSaver(g.houseRepo, regionCode)
+	}
+
+	return g.houseRepo
+}
+
+// Импорт иерархии
+func (g *GarImportService) ImportHierarchy(file directoryEntity.File, hierarchyType string) {
+	reader := g.openFile(file)
+	defer reader.Close()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	channel := make(chan interface{})
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&wg, reader, file.Path, channel, g.logger, g.ParseHierarchyElement, "ITEM", -1, g.journal.GetFileOffset(file.Path), g.journal.GetCheckpoint(file.Path))
+
+	var items []entity.HierarchyObject
+	for d := range channel {
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			g.saveHierarchy(items)
+			items = nil
+			checkpoint.Commit()
+			continue
+		}
+		item := d.(entity.HierarchyObject)
+		item.Type = hierarchyType
+		items = append(items, item)
+		if len(items) >= g.batchSize {
+			g.saveHierarchy(items)
+			items = nil
+		}
+	}
+	g.saveHierarchy(items)
+	wg.Wait()
+	g.journal.FinishFile(file.Path)
+}
+
+// Импорт параметров адресов
+func (g *GarImportService) ImportAddressParams(file directoryEntity.File) {
+	g.importParams(file, g.getAddressIds, g.addressRepo.UpdateParams)
+}
+
+// Импорт параметров домов
+func (g *GarImportService) ImportHouseParams(file directoryEntity.File) {
+	g.importParams(file, g.getHouseIds, g.houseRepo.UpdateParams)
+}
+
+// Импорт параметров объектов
+func (g *GarImportService) importParams(file directoryEntity.File, getIds func(ids []string) map[string]bool, update func(items []entity.ParamObject) error) {
+	reader := g.openFile(file)
+	defer reader.Close()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	channel := make(chan interface{})
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&wg, reader, file.Path, channel, g.logger, g.ParseParamElement, "PARAM", -1, g.journal.GetFileOffset(file.Path), g.journal.GetCheckpoint(file.Path))
+
+	// Обновляет параметры только загруженных объектов
+	save := func(items []entity.ParamObject) error {
+		var ids []string
+		for _, item := range items {
+			ids = append(ids, item.ObjectId)
+		}
+		loaded := getIds(util.UniqueStringSlice(ids))
+		var params []entity.ParamObject
+		for _, item := range items {
+			if loaded[item.ObjectId] {
+				params = append(params, item)
+			}
+		}
+		if len(params) == 0 {
+			return nil
+		}
+
+		return update(params)
+	}
+
+	var items []entity.ParamObject
+	for d := range channel {
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(items) > 0 {
+				g.checkError(save(items))
+				items = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		items = append(items, d.(entity.ParamObject))
+		if len(items) >= g.batchSize {
+			g.checkError(save(items))
+			items = nil
+		}
+	}
+	if len(items) > 0 {
+		g.checkError(save(items))
+	}
+	wg.Wait()
+	g.journal.FinishFile(file.Path)
+}
+
+// Сохраняет пачку элементов иерархии
+func (g *GarImportService) saveHierarchy(items []entity.HierarchyObject) {
+	if len(items) == 0 {
+		return
+	}
+	var ids []string
+	for _, item := range items {
+		ids = append(ids, item.ObjectId, item.ParentObjId)
+	}
+	// Получает GUID адресов по идентификаторам объектов
+	addresses, err := g.addressRepo.GetAddressByIdList(util.UniqueStringSlice(ids))
+	g.checkError(err)
+	guids := make(map[string]string)
+	for _, address := range addresses {
+		guids[address.ID] = address.AoGuid
+	}
+
+	var addressItems []entity.HierarchyObject
+	var houseItems []entity.HierarchyObject
+	var houseIds []string
+	for _, item := range items {
+		if item.ParentObjId != "0" {
+			parentGuid, ok := guids[item.ParentObjId]
+			// Пропускает элементы, родитель которых не загружен
+			if !ok {
+				continue
+			}
+			item.ParentGuid = parentGuid
+		}
+		if _, ok := guids[item.ObjectId]; ok {
+			addressItems = append(addressItems, item)
+		} else if item.ParentGuid != "" && item.Type == entity.AdmHierarchy {
+			houseItems = append(houseItems, item)
+			houseIds = append(houseIds, item.ObjectId)
+		}
+	}
+
+	g.checkError(g.addressRepo.UpdateHierarchy(addressItems))
+	// Дома привязываются к адресам только по административной иерархии
+	if g.SkipHouses || len(houseItems) == 0 {
+		return
+	}
+	// Пропускает земельные участки, помещения и машино-места, которые также входят в иерархию
+	houses := g.getHouseIds(util.UniqueStringSlice(houseIds))
+	var updated []entity.HierarchyObject
+	for _, item := range houseItems {
+		if houses[item.ObjectId] {
+			updated = append(updated, item)
+		}
+	}
+	g.checkError(g.houseRepo.UpdateHierarchy(updated))
+}
+
+// Получить идентификаторы загруженных адресов из списка
+func (g *GarImportService) getAddressIds(ids []string) map[string]bool {
+	addresses, err := g.addressRepo.GetAddressByIdList(ids)
+	g.checkError(err)
+	loaded := make(map[string]bool)
+	for _, address := range addresses {
+		loaded[address.ID] = true
+	}
+
+	return loaded
+}
+
+// Получить идентификаторы загруженных домов из списка
+func (g *GarImportService) getHouseIds(ids []string) map[string]bool {
+	houses, err := g.houseRepo.GetByIdList(ids)
+	g.checkError(err)
+	loaded := make(map[string]bool)
+	for _, house := range houses {
+		loaded[house.ID] = true
+	}
+
+	return loaded
+}
+
+// Разбор адреса из xml
+func (g *GarImportService) ParseAddressElement(element *xmlparser.XMLElement, regionCode string) (interface{}, error) {
+	// Пропускает исторические и неактивные при полном импорте записи, если не сохраняются версии
+	if !g.keepVersions() {
+		if element.Attrs["ISACTUAL"] != "1" || (g.IsFull && element.Attrs["ISACTIVE"] != "1") {
+			return nil, nil
+		}
+	}
+	level, _ := strconv.Atoi(element.Attrs["LEVEL"])
+	// Пропускает уровни, отсутствующие в ФИАС
+	aoLevel, ok := garLevels[level]
+	if !ok {
+		return nil, nil
+	}
+
+	result := entity.AddressObject{
+		ID:         element.Attrs["OBJECTID"],
+		AoGuid:     element.Attrs["OBJECTGUID"],
+		FormalName: element.Attrs["NAME"],
+		ShortName:  element.Attrs["TYPENAME"],
+		AoLevel:    aoLevel,
+		OffName:    element.Attrs["NAME"],
+		RegionCode: regionCode,
+		ActStatus:  element.Attrs["ISACTUAL"],
+		LiveStatus: element.Attrs["ISACTIVE"],
+		CurrStatus: "0",
+		StartDate:  element.Attrs["STARTDATE"],
+		EndDate:    element.Attrs["ENDDATE"],
+		UpdateDate: element.Attrs["UPDATEDATE"],
+		PrevId:     garLink(element.Attrs["PREVID"]),
+		NextId:     garLink(element.Attrs["NEXTID"]),
+		VersionId:  element.Attrs["ID"],
+	}
+
+	return result, nil
+}
+
+// Получить ссылку на версию записи ГАР, 0 означает отсутствие ссылки
+func garLink(id string) string {
+	if id == "0" {
+		return ""
+	}
+
+	return id
+}
+
+// Разбор дома из xml
+func (g *GarImportService) ParseHouseElement(element *xmlparser.XMLElement) (interface{}, error) {
+	// Пропускает исторические записи
+	if element.Attrs["ISACTUAL"] != "1" {
+		return nil, nil
+	}
+	isActive := element.Attrs["ISACTIVE"] == "1"
+	// Пропускает неактивные элементы при полном импорте
+	if g.IsFull {
+		end, err := time.Parse("2006-01-02", element.Attrs["ENDDATE"])
+
+		if !isActive || err != nil || end.Unix() <= g.currentTime {
+			return nil, nil
+		}
+	}
+
+	result := entity.HouseObject{
+		ID:         element.Attrs["OBJECTID"],
+		HouseGuid:  element.Attrs["OBJECTGUID"],
+		HouseNum:   element.Attrs["HOUSENUM"],
+		StartDate:  element.Attrs["STARTDATE"],
+		EndDate:    element.Attrs["ENDDATE"],
+		UpdateDate: element.Attrs["UPDATEDATE"],
+	}
+	// Неактивный дом удаляется из БД
+	if !isActive {
+		result.EndDate = result.UpdateDate
+	}
+	// Дополнительные номера дома: 1 - корпус, остальные - строение, сооружение, литера
+	for _, i := range []string{"1", "2"} {
+		num := element.Attrs["ADDNUM"+i]
+		if num == "" {
+			continue
+		}
+		if element.Attrs["ADDTYPE"+i] == "1" {
+			result.BuildNum = num
+		} else {
+			result.StructNum = num
+		}
+	}
+
+	return result, nil
+}
+
+// Разбор элемента иерархии из xml
+func (g *GarImportService) ParseHierarchyElement(element *xmlparser.XMLElement) (interface{}, error) {
+	if element.Attrs["ISACTIVE"] != "1" {
+		return nil, nil
+	}
+
+	result := entity.HierarchyObject{
+		ID:          element.Attrs["ID"],
+		ObjectId:    element.Attrs["OBJECTID"],
+		ParentObjId: element.Attrs["PARENTOBJID"],
+		RegionCode:  element.Attrs["REGIONCODE"],
+		Oktmo:       element.Attrs["OKTMO"],
+		IsActive:    element.Attrs["ISACTIVE"],
+		StartDate:   element.Attrs["STARTDATE"],
+		EndDate:     element.Attrs["ENDDATE"],
+		UpdateDate:  element.Attrs["UPDATEDATE"],
+	}
+	if result.ParentObjId == "" {
+		result.ParentObjId = "0"
+	}
+
+	return result, nil
+}
+
+// Разбор параметра из xml
+func (g *GarImportService) ParseParamElement(element *xmlparser.XMLElement) (interface{}, error) {
+	typeId, _ := strconv.Atoi(element.Attrs["TYPEID"])
+	switch typeId {
+	case entity.ParamPostalCode, entity.ParamOkato, entity.ParamOktmo, entity.ParamCadNum, entity.ParamKladr:
+	default:
+		return nil, nil
+	}
+	// Пропускает устаревшие значения
+	end, err := time.Parse("2006-01-02", element.Attrs["ENDDATE"])
+	if err != nil || end.Unix() <= g.currentTime {
+		return nil, nil
+	}
+
+	result := entity.ParamObject{
+		ID:         element.Attrs["ID"],
+		ObjectId:   element.Attrs["OBJECTID"],
+		TypeId:     typeId,
+		Value:      element.Attrs["VALUE"],
+		StartDate:  element.Attrs["STARTDATE"],
+		EndDate:    element.Attrs["ENDDATE"],
+		UpdateDate: element.Attrs["UPDATEDATE"],
+	}
+
+	return result, nil
+}
+
+// Проверяет соответствие пути файла шаблону
+func (g *GarImportService) match(pattern string, filePath string) bool {
+	r, err := regexp.MatchString(pattern, filePath)
+
+	return err == nil && r
+}
+
+// Открывает файл или файл в архиве для потокового чтения
+func (g *GarImportService) openFile(file directoryEntity.File) io.ReadCloser {
+	reader, err := file.Open()
+	if err != nil {
+		g.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "error": err}).Fatal("Error opening file")
+	}
+
+	return reader
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (g *GarImportService) checkError(err error) {
+	if err != nil {
+		g.logger.Error(err.Error())
+	}
+}
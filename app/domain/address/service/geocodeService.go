@@ -0,0 +1,167 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"os"
+	"sort"
+)
+
+// Радиус поиска по умолчанию в метрах
+const defaultGeocodeRadius = 1000
+
+// Сервис обратного геокодирования
+type GeocodeService struct {
+	BoundariesEnabled bool                                   // Границы административных объектов загружаются из OSM
+	addressRepo       repository.AddressRepositoryInterface  // Репозиторий адресов
+	houseRepo         repository.HouseRepositoryInterface    // Репозиторий домов
+	boundaryRepo      repository.BoundaryRepositoryInterface // Репозиторий границ административных объектов
+	logger            interfaces.LoggerInterface             // Логгер
+}
+
+// Инициализация сервиса
+func NewGeocodeService(addressRepo repository.AddressRepositoryInterface, houseRepo repository.HouseRepositoryInterface, boundaryRepo repository.BoundaryRepositoryInterface, logger interfaces.LoggerInterface, config interfaces.ConfigInterface) *GeocodeService {
+	enabled := config.GetConfig().Osm.Boundaries
+	if enabled {
+		if err := boundaryRepo.Init(); err != nil {
+			logger.Panic(err.Error())
+			os.Exit(1)
+		}
+	}
+
+	return &GeocodeService{
+		BoundariesEnabled: enabled,
+		addressRepo:       addressRepo,
+		houseRepo:         houseRepo,
+		boundaryRepo:      boundaryRepo,
+		logger:            logger,
+	}
+}
+
+// Найти административные объекты, границы которых содержат точку, от региона до самого детального.
+// Уровни без загруженных границ дополняются родителями самого детального найденного объекта
+func (g *GeocodeService) GetByPoint(lat float64, lon float64) []*entity.AddressObject {
+	boundaries, err := g.boundaryRepo.GetByPoint(lat, lon)
+	g.checkError(err)
+	var guids []string
+	for _, boundary := range boundaries {
+		if boundary.AoGuid != "" {
+			guids = append(guids, boundary.AoGuid)
+		}
+	}
+	if len(guids) == 0 {
+		return nil
+	}
+	items, err := g.addressRepo.GetAddressByGuidList(util.UniqueStringSlice(guids))
+	g.checkError(err)
+	items = g.addParents(items)
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].AoLevel < items[j].AoLevel
+	})
+
+	return items
+}
+
+// Добавить к объектам недостающих родителей самого детального объекта
+func (g *GeocodeService) addParents(items []*entity.AddressObject) []*entity.AddressObject {
+	var detailed *entity.AddressObject
+	objects := make(map[string]*entity.AddressObject)
+	for _, item := range items {
+		objects[item.AoGuid] = item
+		if detailed == nil || item.AoLevel > detailed.AoLevel {
+			detailed = item
+		}
+	}
+	if detailed == nil {
+		return items
+	}
+
+	// Проходит по цепочке родителей до региона, загружая объекты без границ
+	visited := make(map[string]bool)
+	for guid := detailed.ParentGuid; guid != "" && !visited[guid]; {
+		visited[guid] = true
+		parent, ok := objects[guid]
+		if !ok {
+			var err error
+			parent, err = g.addressRepo.GetByGuid(guid)
+			g.checkError(err)
+			if parent == nil {
+				break
+			}
+			items = append(items, parent)
+		}
+		guid = parent.ParentGuid
+	}
+
+	return items
+}
+
+// Найти ближайший к точке дом, улицу или населенный пункт.
+// Уровень ограничивает детализацию результата: 8 - дом, 7, 65, 90 и 91 - улица, остальные - населенный пункт.
+// Радиус ограничивает поиск домов и улиц, населенные пункты ищутся в радиусе 20 км
+func (g *GeocodeService) ReverseGeocode(lat float64, lon float64, radius int, level int) *entity.GeocodeResult {
+	if radius <= 0 {
+		radius = defaultGeocodeRadius
+	}
+	precision := entity.GetGeocodePrecision(level)
+	if precision != entity.PrecisionSettlement {
+		// Координаты улиц не загружаются, поэтому улица определяется по ближайшему дому с координатами
+		house, err := g.houseRepo.GetNearest(lon, lat, radius)
+		g.checkError(err)
+		if house != nil {
+			address, err := g.addressRepo.GetByGuid(house.AoGuid)
+			g.checkError(err)
+			if precision == entity.PrecisionHouse {
+				return &entity.GeocodeResult{
+					Precision: entity.PrecisionHouse,
+					Distance:  g.getDistance(lat, lon, house.Location),
+					Address:   address,
+					House:     house,
+				}
+			}
+			if address != nil && entity.GetGeocodePrecision(address.AoLevel) == entity.PrecisionStreet {
+				return &entity.GeocodeResult{
+					Precision: entity.PrecisionStreet,
+					Distance:  g.getDistance(lat, lon, house.Location),
+					Address:   address,
+				}
+			}
+		}
+	}
+	// Координаты населенных пунктов указывают на центр, поэтому они ищутся в большем радиусе
+	address, err := g.addressRepo.GetNearestCity(lon, lat)
+	g.checkError(err)
+	if address != nil {
+		return g.prepareAddressResult(lat, lon, address, entity.PrecisionSettlement)
+	}
+
+	return nil
+}
+
+// Подготовить результат геокодирования по найденному адресу
+func (g *GeocodeService) prepareAddressResult(lat float64, lon float64, address *entity.AddressObject, precision string) *entity.GeocodeResult {
+	return &entity.GeocodeResult{
+		Precision: precision,
+		Distance:  g.getDistance(lat, lon, address.Location),
+		Address:   address,
+	}
+}
+
+// Получить расстояние от точки до координат объекта
+func (g *GeocodeService) getDistance(lat float64, lon float64, location string) float64 {
+	objectLat, objectLon, ok := util.ParseLocation(location)
+	if !ok {
+		return 0
+	}
+
+	return util.GeoDistance(lat, lon, objectLat, objectLon)
+}
+
+// Проверить наличие ошибки и записать ее в лог
+func (g *GeocodeService) checkError(err error) {
+	if err != nil {
+		g.logger.Error(err.Error())
+	}
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"testing"
+)
+
+func (r *testAddressRepo) GetByGuid(guid string) (*entity.AddressObject, error) {
+	for _, item := range r.items {
+		if item.AoGuid == guid {
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+func (r *testAddressRepo) GetNearestCity(lon float64, lat float64) (*entity.AddressObject, error) {
+	for _, item := range r.items {
+		if item.AoLevel <= 6 && item.Location != "" {
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Репозиторий домов, возвращающий указанный ближайший дом
+type testHouseRepo struct {
+	repository.HouseRepositoryInterface
+	nearest *entity.HouseObject
+}
+
+func (r *testHouseRepo) GetNearest(lon float64, lat float64, radius int) (*entity.HouseObject, error) {
+	return r.nearest, nil
+}
+
+// Репозиторий границ, учитывающий инициализацию
+type testBoundaryRepo struct {
+	repository.BoundaryRepositoryInterface
+	initialized bool
+}
+
+func (r *testBoundaryRepo) Init() error {
+	r.initialized = true
+	return nil
+}
+
+// Конфигурация с настройкой загрузки границ
+type testBoundaryConfig struct {
+	boundaries bool
+}
+
+func (c testBoundaryConfig) Init() error                                   { return nil }
+func (c testBoundaryConfig) GetString(key string, def ...string) string    { return "" }
+func (c testBoundaryConfig) GetBool(key string) bool                       { return false }
+func (c testBoundaryConfig) GetInt(key string, def ...int) int             { return 0 }
+func (c testBoundaryConfig) GetFloat64(key string, def ...float64) float64 { return 0 }
+func (c testBoundaryConfig) GetConfig() interfaces.BaseConfig {
+	return interfaces.BaseConfig{Osm: interfaces.OsmConfig{Boundaries: c.boundaries}}
+}
+
+// Таблица границ создается только при включенной загрузке границ
+func TestNewGeocodeServiceBoundaries(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		boundaryRepo := &testBoundaryRepo{}
+		geocode := NewGeocodeService(&testAddressRepo{}, &testHouseRepo{}, boundaryRepo, testLogger{}, testBoundaryConfig{boundaries: enabled})
+		if boundaryRepo.initialized != enabled || geocode.BoundariesEnabled != enabled {
+			t.Errorf("boundaries %v: got initialized %v, enabled %v", enabled, boundaryRepo.initialized, geocode.BoundariesEnabled)
+		}
+	}
+}
+
+// Улица определяется по ближайшему дому, дом на населенном пункте и уровни без улиц возвращают населенный пункт
+func TestReverseGeocode(t *testing.T) {
+	addressRepo := &testAddressRepo{items: []*entity.AddressObject{
+		{AoGuid: "city", AoLevel: 4, Location: "55.0,82.9"},
+		{AoGuid: "street", ParentGuid: "city", AoLevel: 7},
+		{AoGuid: "territory", ParentGuid: "city", AoLevel: 90},
+	}}
+	tests := []struct {
+		name      string
+		house     *entity.HouseObject
+		level     int
+		precision string
+		guid      string
+	}{
+		{"house", &entity.HouseObject{HouseGuid: "h1", AoGuid: "street", Location: "55.001,82.9"}, 0, entity.PrecisionHouse, "street"},
+		{"house level", &entity.HouseObject{HouseGuid: "h1", AoGuid: "street", Location: "55.001,82.9"}, 8, entity.PrecisionHouse, "street"},
+		{"street of nearest house", &entity.HouseObject{HouseGuid: "h1", AoGuid: "street", Location: "55.001,82.9"}, 7, entity.PrecisionStreet, "street"},
+		{"territory of nearest house", &entity.HouseObject{HouseGuid: "h2", AoGuid: "territory", Location: "55.001,82.9"}, 91, entity.PrecisionStreet, "territory"},
+		{"planning structure level", &entity.HouseObject{HouseGuid: "h2", AoGuid: "territory", Location: "55.001,82.9"}, 65, entity.PrecisionStreet, "territory"},
+		{"house of settlement", &entity.HouseObject{HouseGuid: "h3", AoGuid: "city", Location: "55.001,82.9"}, 7, entity.PrecisionSettlement, "city"},
+		{"no house in radius", nil, 0, entity.PrecisionSettlement, "city"},
+		{"settlement level", &entity.HouseObject{HouseGuid: "h1", AoGuid: "street", Location: "55.001,82.9"}, 6, entity.PrecisionSettlement, "city"},
+		{"land plot level", &entity.HouseObject{HouseGuid: "h1", AoGuid: "street", Location: "55.001,82.9"}, 75, entity.PrecisionSettlement, "city"},
+	}
+	for _, test := range tests {
+		geocode := &GeocodeService{addressRepo: addressRepo, houseRepo: &testHouseRepo{nearest: test.house}, logger: testLogger{}}
+		result := geocode.ReverseGeocode(55.0, 82.9, 0, test.level)
+		if result == nil || result.Precision != test.precision || result.Address == nil || result.Address.AoGuid != test.guid {
+			t.Errorf("%s: got %+v, want %s %s", test.name, result, test.precision, test.guid)
+			continue
+		}
+		if (result.House != nil) != (test.precision == entity.PrecisionHouse) {
+			t.Errorf("%s: got house %+v", test.name, result.House)
+		}
+		// Расстояние до дома и улицы считается до ближайшего дома в 111 м от точки, населенный пункт находится в точке
+		if distance := result.Distance; (test.precision == entity.PrecisionSettlement) != (distance < 1) || distance > 112 {
+			t.Errorf("%s: got distance %v", test.name, distance)
+		}
+	}
+}
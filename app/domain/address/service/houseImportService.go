@@ -3,6 +3,8 @@ package service
 import (
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/GarinAG/gofias/util"
 	xmlparser "github.com/tamerh/xml-stream-parser"
@@ -17,10 +19,14 @@ type HouseImportService struct {
 	IsFull      bool                                `default:"false"` // Полный импорт
 	logger      interfaces.LoggerInterface          // Логгер
 	currentTime int64                               // Время начала импорта
+	journal     *journalService.JournalService      // Журнал импорта
+	regions     *RegionFilter                       // Фильтр импорта по регионам
+	Report      *ChangeReportService                // Отчет об изменениях, если импорт выполняется без записи в БД
+	Changes     *ChangeLogService                   // Журнал изменений, если изменения отслеживаются при импорте
 }
 
 // Инициализация сервиса
-func NewHouseImportService(houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface) *HouseImportService {
+func NewHouseImportService(houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface, journal *journalService.JournalService, regions *RegionFilter) *HouseImportService {
 	err := houseRepo.Init()
 	if err != nil {
 		logger.Panic(err.Error())
@@ -31,20 +37,41 @@ func NewHouseImportService(houseRepo repository.HouseRepositoryInterface, logger
 		HouseRepo:   houseRepo,
 		logger:      logger,
 		currentTime: time.Now().Unix(),
+		journal:     journal,
+		regions:     regions,
 	}
 }
 
 // Импорт домов
-func (h *HouseImportService) Import(filePath string, wg *sync.WaitGroup, cnt chan int) {
+func (h *HouseImportService) Import(file directoryEntity.File, wg *sync.WaitGroup, cnt chan int) {
 	defer wg.Done()
+	// Открывает файл или файл в архиве для потокового чтения
+	reader, err := file.Open()
+	if err != nil {
+		h.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "error": err}).Fatal("Error opening file")
+	}
+	defer reader.Close()
 	var importWg sync.WaitGroup
 	importWg.Add(2)
 	addressChannel := make(chan interface{})
 	// Чтение файла импорта и парсинг элементов
-	go util.ParseFile(&importWg, filePath, addressChannel, h.logger, h.ParseElement, "House", -1)
+	go util.ParseFile(&importWg, reader, file.Path, addressChannel, h.logger, h.ParseElement, "House", -1, h.journal.GetFileOffset(file.Path), h.journal.GetCheckpoint(file.Path))
 	// Сохраняет элементы в БД
-	go h.HouseRepo.InsertUpdateCollection(&importWg, addressChannel, cnt, h.IsFull)
+	go h.getSaver().InsertUpdateCollection(&importWg, addressChannel, cnt, h.IsFull)
 	importWg.Wait()
+	h.journal.FinishFile(file.Path)
+}
+
+// Получить обработчик сохранения домов
+func (h *HouseImportService) getSaver() repository.InsertUpdateInterface {
+	if h.Report != nil {
+		return h.Report.Houses("")
+	}
+	if h.Changes != nil {
+		return h.Changes.HouseSaver(h.HouseRepo, "")
+	}
+
+	return h.HouseRepo
 }
 
 // Разбор объекта из xml
@@ -57,6 +84,10 @@ func (h *HouseImportService) ParseElement(element *xmlparser.XMLElement) (interf
 			return nil, nil
 		}
 	}
+	// Пропускает дома, адрес которых не относится к выбранным регионам
+	if !h.regions.HasAddress(element.Attrs["AOGUID"]) {
+		return nil, nil
+	}
 
 	result := entity.HouseObject{
 		ID:         element.Attrs["HOUSEID"],
@@ -86,6 +117,14 @@ func (h *HouseImportService) GetByAddressGuid(giud string) []*entity.HouseObject
 	return res
 }
 
+// Получить дома по списку GUID
+func (h *HouseImportService) GetByGuidList(guids []string) []*entity.HouseObject {
+	res, err := h.HouseRepo.GetByGuidList(util.UniqueStringSlice(guids))
+	h.checkError(err)
+
+	return res
+}
+
 // Получить последние обновленные дома
 func (h *HouseImportService) GetLastUpdatedGuids(start time.Time) []string {
 	res, err := h.HouseRepo.GetLastUpdatedGuids(start)
@@ -10,11 +10,12 @@ import (
 // Сервис получения данных о домах
 type HouseService struct {
 	HouseRepo repository.HouseRepositoryInterface // Репозиторий домов
+	ranking   *RankingService                     // Сервис ранжирования результатов поиска
 	logger    interfaces.LoggerInterface          // Логгер
 }
 
 // Инициализация сервиса
-func NewHouseService(houseRepo repository.HouseRepositoryInterface, logger interfaces.LoggerInterface) *HouseService {
+func NewHouseService(houseRepo repository.HouseRepositoryInterface, ranking *RankingService, logger interfaces.LoggerInterface) *HouseService {
 	err := houseRepo.Init()
 	if err != nil {
 		logger.Panic(err.Error())
@@ -23,6 +24,7 @@ func NewHouseService(houseRepo repository.HouseRepositoryInterface, logger inter
 
 	return &HouseService{
 		HouseRepo: houseRepo,
+		ranking:   ranking,
 		logger:    logger,
 	}
 }
@@ -35,12 +37,37 @@ func (h *HouseService) GetByAddressGuid(giud string) []*entity.HouseObject {
 	return res
 }
 
-// Найти дома по подстроке
+// Найти дома по подстроке с ранжированием по умолчанию
 func (h *HouseService) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) []*entity.HouseObject {
-	houses, err := h.HouseRepo.GetAddressByTerm(term, size, from, filter...)
+	return h.GetRankedAddressByTerm(term, size, from, nil, filter...)
+}
+
+// Найти дома по подстроке с ранжированием, при пустых параметрах используется ранжирование по умолчанию
+func (h *HouseService) GetRankedAddressByTerm(term string, size int64, from int64, ranking *entity.RankingOptions, filter ...entity.FilterObject) []*entity.HouseObject {
+	options := h.ranking.GetOptions(ranking)
+	// За пределами окна ранжирования результаты возвращаются в исходном порядке
+	if !options.IsEnabled() || from >= h.ranking.GetWindowSize() {
+		houses, err := h.HouseRepo.GetAddressByTerm(term, size, from, filter...)
+		h.checkError(err)
+
+		return houses
+	}
+	if size == 0 {
+		size = 100
+	}
+	// Ранжирует окно первых результатов и возвращает запрошенную страницу,
+	// часть страницы за пределами окна остается в исходном порядке
+	houses, err := h.HouseRepo.GetAddressByTerm(term, h.ranking.GetCandidatesSize(size, from), 0, filter...)
 	h.checkError(err)
+	h.ranking.RankHouses(term, houses, options)
+	if int64(len(houses)) <= from {
+		return nil
+	}
+	if int64(len(houses)) > from+size {
+		houses = houses[:from+size]
+	}
 
-	return houses
+	return houses[from:]
 }
 
 // Проверяет наличие ошибки и логирует ее
@@ -1,43 +1,74 @@
 package service
 
 import (
+	"fmt"
 	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
 	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
 	"github.com/GarinAG/gofias/domain/directory/service"
 	"github.com/GarinAG/gofias/domain/fiasApi/entity"
 	fiasApiService "github.com/GarinAG/gofias/domain/fiasApi/service"
+	journalEntity "github.com/GarinAG/gofias/domain/journal/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
 	versionEntity "github.com/GarinAG/gofias/domain/version/entity"
 	versionService "github.com/GarinAG/gofias/domain/version/service"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/GarinAG/gofias/util"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
 
 // Общий сервис импорта
 type ImportService struct {
-	addressImportService *AddressImportService      // Сервис импорта адресов
-	houseImportService   *HouseImportService        // Сервис импорта домов
-	logger               interfaces.LoggerInterface // Логгер
-	directoryService     *service.DirectoryService  // Сервис работы с файлами
-	config               interfaces.ConfigInterface // Конфигурация
-	IsFull               bool                       `default:"false"` // Полный импорт
-	SkipHouses           bool                       `default:"false"` // Пропускать импорт домов
-	SkipClear            bool                       `default:"false"` // Не удалять скачанные файлы после импорта
-	SkipOsm              bool                       `default:"false"` // Не удалять скачанные файлы после импорта
-	Begin                time.Time                  // Время начала импорта
+	addressImportService *AddressImportService          // Сервис импорта адресов
+	houseImportService   *HouseImportService            // Сервис импорта домов
+	roomImportService    *RoomImportService             // Сервис импорта помещений
+	steadImportService   *SteadImportService            // Сервис импорта земельных участков
+	garImportService     *GarImportService              // Сервис импорта данных в формате ГАР
+	journalService       *journalService.JournalService // Сервис журнала импорта
+	logger               interfaces.LoggerInterface     // Логгер
+	directoryService     *service.DirectoryService      // Сервис работы с файлами
+	config               interfaces.ConfigInterface     // Конфигурация
+	regionFilter         *RegionFilter                  // Фильтр импорта по регионам
+	changeReport         *ChangeReportService           // Сервис отчета об изменениях
+	changeLog            *ChangeLogService              // Сервис журнала изменений
+	IsFull               bool                           `default:"false"` // Полный импорт
+	SkipHouses           bool                           `default:"false"` // Пропускать импорт домов
+	SkipRooms            bool                           `default:"false"` // Пропускать импорт помещений
+	SkipSteads           bool                           `default:"false"` // Пропускать импорт земельных участков
+	SkipClear            bool                           `default:"false"` // Не удалять скачанные файлы после импорта
+	SkipOsm              bool                           `default:"false"` // Не удалять скачанные файлы после импорта
+	IsGar                bool                           `default:"false"` // Импорт данных в формате ГАР
+	Resume               bool                           `default:"false"` // Продолжить прерванный импорт
+	DryRun               bool                           `default:"false"` // Проверить изменения без записи в БД
+	Regions              []string                       // Коды регионов для импорта и индексации
+	FromFile             string                         // Путь к локальному архиву для импорта
+	FromDir              string                         // Путь к директории с распакованными файлами для импорта
+	VersionId            int                            // Номер версии локальных файлов
+	VersionDate          time.Time                      // Дата версии локальных файлов
+	Begin                time.Time                      // Время начала импорта
+	resumed              bool                           // Продолжается прерванный импорт
 }
 
 // Инициализация сервиса
-func NewImportService(logger interfaces.LoggerInterface, ds *service.DirectoryService, addressImportService *AddressImportService, houseImportService *HouseImportService, config interfaces.ConfigInterface) *ImportService {
+func NewImportService(logger interfaces.LoggerInterface, ds *service.DirectoryService, addressImportService *AddressImportService, houseImportService *HouseImportService, roomImportService *RoomImportService, steadImportService *SteadImportService, garImportService *GarImportService, journalService *journalService.JournalService, regionFilter *RegionFilter, changeReport *ChangeReportService, changeLog *ChangeLogService, config interfaces.ConfigInterface) *ImportService {
 	return &ImportService{
 		addressImportService: addressImportService,
 		houseImportService:   houseImportService,
+		roomImportService:    roomImportService,
+		steadImportService:   steadImportService,
+		garImportService:     garImportService,
+		journalService:       journalService,
 		logger:               logger,
 		directoryService:     ds,
 		config:               config,
+		regionFilter:         regionFilter,
+		changeReport:         changeReport,
+		changeLog:            changeLog,
 		IsFull:               false,
 		Begin:                time.Now(),
 	}
@@ -45,14 +76,111 @@ func NewImportService(logger interfaces.LoggerInterface, ds *service.DirectorySe
 
 // Получить список названий файлов импорта
 func (is *ImportService) getParts() []string {
+	if is.IsGar {
+		return is.getGarParts()
+	}
 	parts := []string{addressEntity.AddressObject{}.GetXmlFile()}
 	if !is.SkipHouses {
 		parts = append(parts, addressEntity.HouseObject{}.GetXmlFile())
 	}
+	// Помещения и участки не входят в отчет об изменениях и не загружаются при проверке
+	if !is.SkipRooms && !is.DryRun {
+		parts = append(parts, addressEntity.RoomObject{}.GetXmlFile())
+	}
+	if !is.SkipSteads && !is.DryRun {
+		parts = append(parts, addressEntity.SteadObject{}.GetXmlFile())
+	}
 
 	return parts
 }
 
+// Получить список названий файлов импорта ГАР
+func (is *ImportService) getGarParts() []string {
+	parts := []string{
+		addressEntity.AddressObject{}.GetGarXmlFile(),
+		addressEntity.AddressObject{}.GetGarParamsXmlFile(),
+		addressEntity.HierarchyObject{Type: addressEntity.AdmHierarchy}.GetXmlFile(),
+		addressEntity.HierarchyObject{Type: addressEntity.MunHierarchy}.GetXmlFile(),
+	}
+	if !is.SkipHouses {
+		parts = append(parts,
+			addressEntity.HouseObject{}.GetGarXmlFile(),
+			addressEntity.HouseObject{}.GetGarParamsXmlFile())
+	}
+
+	return parts
+}
+
+// Получить ссылку на файл дельты
+func (is *ImportService) getDeltaUrl(info entity.DownloadFileInfo) (string, string) {
+	if is.IsGar {
+		return info.GarXmlDeltaUrl, "gar_delta_xml.zip"
+	}
+
+	return info.FiasDeltaXmlUrl, "fias_delta_xml.zip"
+}
+
+// Получить ссылку на файл полного импорта
+func (is *ImportService) getFullUrl(info entity.DownloadFileInfo) (string, string) {
+	if is.IsGar {
+		return info.GarXmlFullUrl, "gar_xml.zip"
+	}
+
+	return info.FiasCompleteXmlUrl, "fias_xml.zip"
+}
+
+// Подготовить список регионов импорта с учетом загруженной версии
+func (is *ImportService) PrepareRegions(version *versionEntity.Version) error {
+	regions := is.Regions
+	if version != nil {
+		// Дельты загружаются только для регионов, загруженных при полном импорте
+		if len(regions) == 0 {
+			regions = version.Regions
+		} else if strings.Join(regions, ",") != strings.Join(version.Regions, ",") {
+			return fmt.Errorf("regions %v differ from imported regions %v, run full import to change them", regions, version.Regions)
+		}
+	}
+	is.regionFilter.SetRegions(regions)
+	// При загрузке дельт регион дома определяется по адресу в БД
+	is.regionFilter.Lookup = version != nil || is.Resume
+	if is.regionFilter.IsEnabled() {
+		is.logger.WithFields(interfaces.LoggerFields{"regions": regions}).Info("Import is limited by regions")
+	}
+
+	return nil
+}
+
+// Подготовить список регионов индексации
+func (is *ImportService) PrepareIndexRegions(version *versionEntity.Version) {
+	regions := is.Regions
+	if len(regions) == 0 && version != nil {
+		regions = version.Regions
+	}
+	is.regionFilter.SetRegions(regions)
+}
+
+// Загрузить журнал прерванного импорта
+func (is *ImportService) LoadJournal() {
+	journal := is.journalService.Load()
+	if journal == nil {
+		is.logger.Info("Nothing to resume")
+		return
+	}
+	// Восстанавливает параметры прерванного импорта
+	if begin, err := time.Parse(util.TimeFormat, journal.Begin); err == nil {
+		is.Begin = begin
+	}
+	is.IsFull = journal.IsFull
+	is.resumed = true
+}
+
+// Завершает работу, если последняя версия уже загружена. Прерванный импорт продолжается индексацией
+func (is *ImportService) exitIfUploaded() {
+	if !is.resumed {
+		os.Exit(1)
+	}
+}
+
 // Загрузка дельт
 func (is *ImportService) StartDeltaImport(api *fiasApiService.FiasApiService, versionService *versionService.VersionService, version *versionEntity.Version) {
 	// Получение полного списка версий ФИАС
@@ -68,13 +196,16 @@ func (is *ImportService) StartDeltaImport(api *fiasApiService.FiasApiService, ve
 	// Получает список названий файлов импорта
 	parts := is.getParts()
 
-	// Очищает директорию от ранее скачанных файлов
-	is.clearDirectory(false)
+	// Очищает директорию от ранее скачанных файлов, при продолжении импорта файлы используются повторно
+	if !is.Resume {
+		is.clearDirectory(false)
+	}
 
 	// Завершаем импорт, если скачана последняя версия
 	if len(needVersionList) == 0 {
 		is.logger.Info("Last version is uploaded")
-		os.Exit(1)
+		is.exitIfUploaded()
+		return
 	}
 	// Идем от более ранней версии к более новой
 	for i := len(needVersionList) - 1; i >= 0; i-- {
@@ -85,18 +216,20 @@ func (is *ImportService) StartDeltaImport(api *fiasApiService.FiasApiService, ve
 		is.logger.WithFields(interfaces.LoggerFields{
 			"version": uploadedVersion,
 		}).Debug("Uploaded version info")
+		is.startJournal(uploadedVersion.VersionId)
 
 		// Проверяет, есть ли ссылка на файл дельты
-		if uploadedVersion.FiasDeltaXmlUrl != "" {
-			// Загружает файл и распаковывает
-			xmlFiles := is.directoryService.DownloadAndExtractFile(uploadedVersion.FiasDeltaXmlUrl, "fias_delta_xml.zip", parts...)
+		if url, fileName := is.getDeltaUrl(uploadedVersion); url != "" {
+			// Загружает архив и получает список файлов в нем
+			xmlFiles := is.directoryService.DownloadArchive(url, fileName, parts...)
 			// Читает xml-файлы и импортирует элементы
+			is.journalService.SetPhase(journalEntity.PhaseParse)
 			cntAddr, cntHouses = is.ParseFiles(xmlFiles)
 		}
 		// Очищает директорию от ранее скачанных файлов
 		is.clearDirectory(true)
 		// Обновляет версию ФИАС в БД
-		versionService.UpdateVersion(is.convertDownloadInfoToVersion(uploadedVersion, cntAddr, cntHouses))
+		is.updateVersion(versionService, is.convertDownloadInfoToVersion(uploadedVersion, cntAddr, cntHouses))
 	}
 
 	is.logger.Info("Import finished")
@@ -104,29 +237,138 @@ func (is *ImportService) StartDeltaImport(api *fiasApiService.FiasApiService, ve
 
 // Загрузка полного импорта
 func (is *ImportService) StartFullImport(api *fiasApiService.FiasApiService, versionService *versionService.VersionService) {
-	is.IsFull = true
-	is.addressImportService.IsFull = true
-	is.houseImportService.IsFull = true
+	is.setFull()
 
 	// Получает ифнормацию о последней доступной версии ФИАС
 	fileResult := api.GetLastDownloadFileInfo()
 	// Проверяет, есть ли ссылка на файл импорта
-	if len(fileResult.FiasCompleteXmlUrl) > 0 {
-		// Очищает директорию от ранее скачанных файлов
+	url, fileName := is.getFullUrl(fileResult)
+	if len(url) == 0 {
+		is.logger.Info("Full import file not found")
+		return
+	}
+	// Очищает директорию от ранее скачанных файлов, при продолжении импорта файлы используются повторно
+	if !is.Resume {
 		is.clearDirectory(false)
-		// Получает список названий файлов импорта
-		parts := is.getParts()
-		// Загружает файл и распаковывает
-		xmlFiles := is.directoryService.DownloadAndExtractFile(fileResult.FiasCompleteXmlUrl, "fias_xml.zip", parts...)
-		// Читает xml-файлы и импортирует элементы
-		cntAddr, cntHouses := is.ParseFiles(xmlFiles)
-		// Обновляет версию ФИАС в БД
-		versionService.UpdateVersion(is.convertDownloadInfoToVersion(fileResult, cntAddr, cntHouses))
 	}
+	is.startJournal(fileResult.VersionId)
+	// Получает список названий файлов импорта
+	parts := is.getParts()
+	// Загружает архив и получает список файлов в нем
+	xmlFiles := is.directoryService.DownloadArchive(url, fileName, parts...)
+	// Читает xml-файлы и импортирует элементы
+	is.journalService.SetPhase(journalEntity.PhaseParse)
+	cntAddr, cntHouses := is.ParseFiles(xmlFiles)
+	// Обновляет версию ФИАС в БД
+	is.updateVersion(versionService, is.convertDownloadInfoToVersion(fileResult, cntAddr, cntHouses))
+
+	is.logger.Info("Import finished")
+}
+
+// Импорт из локального архива или директории
+func (is *ImportService) StartLocalImport(versionService *versionService.VersionService, version *versionEntity.Version) {
+	// Завершаем импорт, если версия уже загружена
+	if version != nil && version.ID >= is.VersionId {
+		is.logger.WithFields(interfaces.LoggerFields{
+			"version":      version.ID,
+			"localVersion": is.VersionId,
+		}).Info("Last version is uploaded")
+		is.exitIfUploaded()
+		return
+	}
+	// Файлы импортируются как полная выгрузка, если в БД нет ни одной версии
+	if version == nil {
+		is.setFull()
+	}
+	is.startJournal(is.VersionId)
+	// Получает список названий файлов импорта
+	parts := is.getParts()
+
+	var xmlFiles *[]directoryEntity.File
+	if is.FromFile != "" {
+		// Файлы читаются из локального архива без распаковки
+		xmlFiles = is.directoryService.OpenArchive(is.FromFile, parts...)
+	} else {
+		// Использует ранее распакованные файлы без копирования
+		xmlFiles = is.directoryService.FindFiles(is.FromDir, parts...)
+	}
+	if len(*xmlFiles) == 0 {
+		is.logger.WithFields(interfaces.LoggerFields{"file": is.FromFile, "dir": is.FromDir}).Warn("Import files not found")
+	}
+	// Читает xml-файлы и импортирует элементы
+	is.journalService.SetPhase(journalEntity.PhaseParse)
+	cntAddr, cntHouses := is.ParseFiles(xmlFiles)
+	// Обновляет версию ФИАС в БД
+	is.updateVersion(versionService, is.convertDownloadInfoToVersion(entity.DownloadFileInfo{
+		VersionId:   is.VersionId,
+		TextVersion: "БД ФИАС от " + is.VersionDate.Format("02.01.2006"),
+	}, cntAddr, cntHouses))
 
 	is.logger.Info("Import finished")
 }
 
+// Проверяет, используется ли импорт из локальных файлов
+func (is *ImportService) IsLocalImport() bool {
+	return is.FromFile != "" || is.FromDir != ""
+}
+
+// Включить проверку изменений без записи в БД
+func (is *ImportService) EnableDryRun(samples int) {
+	is.DryRun = true
+	is.changeReport.Samples = samples
+	is.addressImportService.Report = is.changeReport
+	is.houseImportService.Report = is.changeReport
+	is.garImportService.Report = is.changeReport
+}
+
+// Получить отчет об изменениях
+func (is *ImportService) GetChangeReport() *addressEntity.ChangeReport {
+	return is.changeReport.GetReport()
+}
+
+// Начать журнал импорта версии, при проверке изменений журнал не ведется
+func (is *ImportService) startJournal(versionId int) {
+	if !is.DryRun {
+		is.journalService.Start(versionId, is.IsFull, is.Begin)
+	}
+	// Изменения отслеживаются только при загрузке обновлений
+	var changes *ChangeLogService
+	if is.trackChanges() {
+		changes = is.changeLog
+		changes.Begin(versionId)
+	}
+	is.addressImportService.Changes = changes
+	is.houseImportService.Changes = changes
+	is.garImportService.Changes = changes
+}
+
+// Проверяет, нужно ли сохранять изменения загружаемой версии в журнал
+func (is *ImportService) trackChanges() bool {
+	return is.changeLog.Enabled && !is.IsFull && !is.DryRun
+}
+
+// Сохранить загруженную версию, при проверке изменений версия только добавляется в отчет
+func (is *ImportService) updateVersion(versionService *versionService.VersionService, version *versionEntity.Version) {
+	if is.DryRun {
+		is.changeReport.AddVersion(version.ID)
+		return
+	}
+	if is.trackChanges() {
+		is.changeLog.Commit(version.ID)
+	}
+	versionService.UpdateVersion(version)
+}
+
+// Включает режим полного импорта
+func (is *ImportService) setFull() {
+	is.IsFull = true
+	is.addressImportService.IsFull = true
+	is.houseImportService.IsFull = true
+	is.roomImportService.IsFull = true
+	is.steadImportService.IsFull = true
+	is.garImportService.IsFull = true
+}
+
 // Конвертирует объект файла в объект версии
 func (is *ImportService) convertDownloadInfoToVersion(info entity.DownloadFileInfo, cntAddr int, cntHouses int) *versionEntity.Version {
 	versionDateSlice := info.TextVersion[len(info.TextVersion)-10 : len(info.TextVersion)]
@@ -139,6 +381,7 @@ func (is *ImportService) convertDownloadInfoToVersion(info entity.DownloadFileIn
 		UpdateDate:       versionDate,
 		RecUpdateAddress: cntAddr,
 		RecUpdateHouses:  cntHouses,
+		Regions:          is.regionFilter.GetRegions(),
 	}
 }
 
@@ -151,6 +394,9 @@ func (is *ImportService) clearDirectory(force bool) {
 
 // Парсинг файлов и импорт элементов
 func (is *ImportService) ParseFiles(files *[]directoryEntity.File) (int, int) {
+	if is.IsGar {
+		return is.parseGarFiles(files)
+	}
 	var wg sync.WaitGroup
 	// Канал подсчета количества адресов
 	cha := make(chan int)
@@ -158,36 +404,102 @@ func (is *ImportService) ParseFiles(files *[]directoryEntity.File) (int, int) {
 	chb := make(chan int)
 	hasAddress := false
 	hasHouse := false
+	// Канал подсчета количества помещений и участков
+	chr := make(chan int)
 	cntAddr := 0
 	cntHouse := 0
+	cntRooms := 0
+	cntSteads := 0
+	var houseFiles []directoryEntity.File
 
 	for _, file := range *files {
+		// Пропускает файлы, полностью обработанные при прошлом запуске
+		if is.journalService.IsFileFinished(file.Path) {
+			continue
+		}
 		// Проверяет наличие файла с адресами
 		if r, err := regexp.MatchString(addressEntity.AddressObject{}.GetXmlFile(), file.Path); err == nil && r {
 			hasAddress = true
 			wg.Add(1)
 			// Выполняет импорт адресов
-			go is.addressImportService.Import(file.Path, &wg, cha)
+			go is.addressImportService.Import(file, &wg, cha)
 		}
 		// Проверяет наличие файла с домами
 		if r, err := regexp.MatchString(addressEntity.HouseObject{}.GetXmlFile(), file.Path); err == nil && r {
-			hasHouse = true
+			houseFiles = append(houseFiles, file)
+		}
+		// Проверяет наличие файла с помещениями
+		if r, err := regexp.MatchString(addressEntity.RoomObject{}.GetXmlFile(), file.Path); err == nil && r {
+			cntRooms++
+			wg.Add(1)
+			// Выполняет импорт помещений
+			go is.roomImportService.Import(file, &wg, chr)
+		}
+		// Проверяет наличие файла с участками
+		if r, err := regexp.MatchString(addressEntity.SteadObject{}.GetXmlFile(), file.Path); err == nil && r {
+			cntSteads++
 			wg.Add(1)
-			// Выполняет импорт домов
-			go is.houseImportService.Import(file.Path, &wg, chb)
+			// Выполняет импорт участков
+			go is.steadImportService.Import(file, &wg, chr)
 		}
 	}
+	// При импорте по регионам, проверке изменений и ведении журнала изменений дома загружаются после адресов, регион дома определяется по адресу
+	if hasAddress && (is.regionFilter.IsEnabled() || is.DryRun || is.trackChanges()) {
+		cntAddr = <-cha
+		hasAddress = false
+	}
+	for _, file := range houseFiles {
+		hasHouse = true
+		wg.Add(1)
+		// Выполняет импорт домов
+		go is.houseImportService.Import(file, &wg, chb)
+	}
 	if hasAddress {
 		cntAddr = <-cha
 	}
 	if hasHouse {
 		cntHouse = <-chb
 	}
+	// Ожидает завершения импорта помещений и участков
+	for i := 0; i < cntRooms+cntSteads; i++ {
+		<-chr
+	}
 	wg.Wait()
 
 	return cntAddr, cntHouse
 }
 
+// Парсинг файлов ГАР и импорт элементов по регионам
+func (is *ImportService) parseGarFiles(files *[]directoryEntity.File) (int, int) {
+	is.garImportService.SkipHouses = is.SkipHouses
+	regions := make(map[string][]directoryEntity.File)
+	var regionCodes []string
+	cntAddr := 0
+	cntHouse := 0
+
+	// Группирует файлы по директориям регионов
+	for _, file := range *files {
+		regionCode := filepath.Base(filepath.Dir(file.Path))
+		// Пропускает файлы невыбранных регионов
+		if !is.regionFilter.HasRegion(regionCode) {
+			continue
+		}
+		if _, ok := regions[regionCode]; !ok {
+			regionCodes = append(regionCodes, regionCode)
+		}
+		regions[regionCode] = append(regions[regionCode], file)
+	}
+	sort.Strings(regionCodes)
+
+	for _, regionCode := range regionCodes {
+		addr, houses := is.garImportService.ImportRegion(regionCode, regions[regionCode])
+		cntAddr += addr
+		cntHouse += houses
+	}
+
+	return cntAddr, cntHouse
+}
+
 // Получить список адресов по GUID для индексации домов
 func (is *ImportService) GetIndexObjects(guids []string) map[string]addressEntity.IndexObject {
 	indexList := make(map[string]addressEntity.IndexObject)
@@ -208,14 +520,38 @@ func (is *ImportService) GetIndexObjects(guids []string) map[string]addressEntit
 	return indexList
 }
 
+// Получить список домов по GUID для индексации помещений
+func (is *ImportService) GetHouseIndexObjects(guids []string) map[string]addressEntity.IndexObject {
+	indexList := make(map[string]addressEntity.IndexObject)
+	if len(guids) > 0 {
+		for _, item := range is.houseImportService.GetByGuidList(guids) {
+			indexList[item.HouseGuid] = addressEntity.IndexObject{
+				AoGuid:         item.HouseGuid,
+				FullAddress:    item.FullAddress,
+				AddressSuggest: item.AddressSuggest,
+			}
+		}
+	}
+
+	return indexList
+}
+
 // Индексация таблиц БД
 func (is *ImportService) Index() {
+	is.journalService.SetPhase(journalEntity.PhaseIndex)
 	// Базовая индексация элементов БД
 	is.BaseIndex()
 	// Индексация домов по временной метке
 	if !is.IsFull {
 		is.IndexHouses()
 	}
+	// Индексация помещений и участков после обновления адресов и домов
+	is.IndexRooms()
+	is.IndexSteads()
+	is.journalService.Finish()
+	// Публикует изменения после индексации, когда данные доступны для поиска.
+	// Публикация продолжается после последней опубликованной записи, поэтому прерванная публикация повторяется при следующем запуске
+	is.changeLog.Publish()
 }
 
 // Базовая индексация элементов БД
@@ -292,3 +628,21 @@ func (is *ImportService) IndexHouses() {
 		}
 	}
 }
+
+// Индексация помещений, загруженных после начала импорта
+func (is *ImportService) IndexRooms() {
+	if is.roomImportService.CountAllData() == 0 {
+		return
+	}
+	is.logger.Info("Start rooms indexing")
+	is.roomImportService.Index(is.Begin, is.GetHouseIndexObjects)
+}
+
+// Индексация участков, загруженных после начала импорта
+func (is *ImportService) IndexSteads() {
+	if is.steadImportService.CountAllData() == 0 {
+		return
+	}
+	is.logger.Info("Start steads indexing")
+	is.steadImportService.Index(is.Begin, is.GetIndexObjects)
+}
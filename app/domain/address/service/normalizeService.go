@@ -0,0 +1,320 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"math"
+	"sort"
+	"strings"
+)
+
+const (
+	// Количество кандидатов, загружаемых при поиске адреса
+	normalizeCandidatesSize = 50
+	// Максимальное количество слов в названиях, для которых перебираются варианты без одного слова
+	normalizeMaxNames = 8
+	// Вес совпадения адресного объекта в оценке, если в строке указан дом
+	normalizeAddressWeight = 0.7
+	// Множитель оценки при несовпадении почтового индекса
+	normalizePostalPenalty = 0.9
+)
+
+// Сервис нормализации строк адресов
+type NormalizeService struct {
+	addressService *AddressService            // Сервис адресов
+	houseService   *HouseService              // Сервис домов
+	roomService    *RoomService               // Сервис помещений
+	logger         interfaces.LoggerInterface // Логгер
+}
+
+// Инициализация сервиса
+func NewNormalizeService(addressService *AddressService, houseService *HouseService, roomService *RoomService, logger interfaces.LoggerInterface) *NormalizeService {
+	return &NormalizeService{
+		addressService: addressService,
+		houseService:   houseService,
+		roomService:    roomService,
+		logger:         logger,
+	}
+}
+
+// Нормализовать строку адреса: разобрать на компоненты и найти соответствующие объекты ФИАС
+func (n *NormalizeService) Normalize(term string) *entity.NormalizedAddress {
+	parsed, groups := parseAddress(term)
+	result := &entity.NormalizedAddress{Parsed: parsed}
+	remainder := append([]string{}, parsed.Remainder...)
+
+	parents := make(map[string]*entity.AddressObject)
+	var address *entity.AddressObject
+	var scores []float64
+	if len(groups) > 1 {
+		address, scores = n.matchGroups(groups, parents)
+	}
+	if address == nil {
+		address, scores = n.findAddress(parsed.Names)
+	}
+	if address == nil {
+		remainder = append(append([]string{}, parsed.Names...), remainder...)
+		if parsed.House != "" {
+			remainder = append(remainder, parsed.House)
+		}
+		result.Remainder = strings.Join(remainder, " ")
+		return result
+	}
+	result.Address = address
+	n.classifyNames(&result.Parsed, address, parents)
+	// Слова, не совпавшие с найденным адресом, попадают в остаток
+	score := 0.0
+	for i, name := range parsed.Names {
+		score += scores[i]
+		if scores[i] == 0 {
+			remainder = append(remainder, name)
+		}
+	}
+	score /= float64(len(parsed.Names))
+
+	postalCode := address.PostalCode
+	if parsed.House != "" {
+		houseScore := 0.0
+		result.House, houseScore = n.findHouse(address.AoGuid, parsed)
+		score = score*normalizeAddressWeight + houseScore*(1-normalizeAddressWeight)
+		if result.House == nil {
+			remainder = append(remainder, parsed.House)
+		} else {
+			if result.House.PostalCode != "" {
+				postalCode = result.House.PostalCode
+			}
+			if parsed.Apartment != "" {
+				result.Room = n.findRoom(result.House.HouseGuid, parsed.Apartment)
+			}
+		}
+	}
+	if parsed.PostalCode != "" && postalCode != "" && parsed.PostalCode != postalCode {
+		score *= normalizePostalPenalty
+	}
+	result.Confidence = math.Round(score*100) / 100
+	result.Remainder = strings.Join(remainder, " ")
+
+	return result
+}
+
+// Найти адресный объект, лучше всего совпадающий с названиями, и оценки совпадения каждого слова
+func (n *NormalizeService) findAddress(names []string) (*entity.AddressObject, []float64) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	// Варианты запроса: все слова, затем без одного из слов, начиная с последнего
+	attempts := [][]string{names}
+	if len(names) > 1 && len(names) <= normalizeMaxNames {
+		for i := len(names) - 1; i >= 0; i-- {
+			attempt := append(append([]string{}, names[:i]...), names[i+1:]...)
+			attempts = append(attempts, attempt)
+		}
+	}
+
+	for _, attempt := range attempts {
+		candidates := n.addressService.GetAddressByTerm(strings.Join(attempt, " "), normalizeCandidatesSize, 0)
+		if len(candidates) == 0 {
+			continue
+		}
+		var best *entity.AddressObject
+		var bestScores []float64
+		bestScore := -1.0
+		bestExtra := 0
+		for _, candidate := range candidates {
+			scores, score, extra := matchNames(names, candidate.FullAddress)
+			// Из равных по совпадению кандидатов выбирается адрес с меньшим количеством лишних слов, затем более детальный
+			if score > bestScore ||
+				(score == bestScore && (extra < bestExtra ||
+					(extra == bestExtra && candidate.AoLevel > best.AoLevel))) {
+				best, bestScores, bestScore, bestExtra = candidate, scores, score, extra
+			}
+		}
+
+		return best, bestScores
+	}
+
+	return nil, nil
+}
+
+// Найти адресный объект по группам слов последовательно от верхнего уровня,
+// каждый следующий объект ищется среди потомков найденного на предыдущем шаге
+func (n *NormalizeService) matchGroups(groups []addressGroup, parents map[string]*entity.AddressObject) (*entity.AddressObject, []float64) {
+	var parent *entity.AddressObject
+	var scores []float64
+	var context []string
+	for _, group := range groups {
+		groupScores := make([]float64, len(group.names))
+		// Названия найденных объектов уточняют поиск следующего уровня, затем поиск выполняется только по названию группы
+		queries := [][]string{append(append([]string{}, context...), group.names...)}
+		if len(context) > 0 {
+			queries = append(queries, group.names)
+		}
+		var best *entity.AddressObject
+		for _, query := range queries {
+			bestScore := 0.0
+			bestExtra := 0
+			for _, candidate := range n.addressService.GetAddressByTerm(strings.Join(query, " "), normalizeCandidatesSize, 0) {
+				if group.part != "" && !addressPartLevels[group.part][candidate.AoLevel] && addressPartByLevel(candidate) != group.part {
+					continue
+				}
+				if parent != nil && !n.isDescendant(candidate, parent.AoGuid, parents) {
+					continue
+				}
+				candidateScores, score, extra := matchNames(group.names, candidate.FullName)
+				if score > bestScore || (score == bestScore && score > 0 && extra < bestExtra) {
+					best, groupScores, bestScore, bestExtra = candidate, candidateScores, score, extra
+				}
+			}
+			if best != nil {
+				break
+			}
+		}
+		if best != nil {
+			parent = best
+			context = append(context, group.names...)
+		}
+		scores = append(scores, groupScores...)
+	}
+
+	return parent, scores
+}
+
+// Получить родительские объекты адреса от ближайшего к верхнему
+func (n *NormalizeService) getParents(item *entity.AddressObject, parents map[string]*entity.AddressObject) []*entity.AddressObject {
+	var list []*entity.AddressObject
+	guid := item.ParentGuid
+	for i := 0; guid != "" && i < maxHistoryDepth; i++ {
+		parent, ok := parents[guid]
+		if !ok {
+			parent = n.addressService.GetByGuid(guid)
+			parents[guid] = parent
+		}
+		if parent == nil {
+			break
+		}
+		list = append(list, parent)
+		guid = parent.ParentGuid
+	}
+
+	return list
+}
+
+// Проверяет, является ли адрес потомком объекта с указанным GUID
+func (n *NormalizeService) isDescendant(item *entity.AddressObject, guid string, parents map[string]*entity.AddressObject) bool {
+	for _, parent := range n.getParents(item, parents) {
+		if parent.AoGuid == guid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Распределить слова названий по региону, городу и улице по уровням найденного адреса и его родителей
+func (n *NormalizeService) classifyNames(parsed *entity.ParsedAddress, address *entity.AddressObject, parents map[string]*entity.AddressObject) {
+	names := make(map[string][]string)
+	chain := append([]*entity.AddressObject{address}, n.getParents(address, parents)...)
+	for _, name := range parsed.Names {
+		// Слово относится к объекту с лучшим совпадением названия, точное совпадение важнее частичного
+		var best *entity.AddressObject
+		bestScore := 0.0
+		for _, item := range chain {
+			if _, score, _ := matchNames([]string{name}, item.FormalName); score > bestScore {
+				best, bestScore = item, score
+			}
+		}
+		if best != nil {
+			part := addressPartByLevel(best)
+			names[part] = append(names[part], name)
+		}
+	}
+	if len(names[addressPartRegion]) > 0 {
+		parsed.Region = strings.Join(names[addressPartRegion], " ")
+	}
+	if len(names[addressPartCity]) > 0 {
+		parsed.City = strings.Join(names[addressPartCity], " ")
+	}
+	if len(names[addressPartStreet]) > 0 {
+		parsed.Street = strings.Join(names[addressPartStreet], " ")
+	}
+}
+
+// Оценить совпадение слов названий с полным адресом объекта,
+// возвращает оценки слов, общую оценку и количество слов адреса, отсутствующих в названиях
+func matchNames(names []string, fullAddress string) ([]float64, float64, int) {
+	words := splitAddress(fullAddress)
+	scores := make([]float64, len(names))
+	matched := make([]bool, len(words))
+	total := 0.0
+	for i, name := range names {
+		for j, word := range words {
+			if word == name {
+				scores[i] = 1
+				matched[j] = true
+				break
+			}
+			// Частичное совпадение, например сокращенное название
+			if len([]rune(name)) >= 3 && (strings.HasPrefix(word, name) || strings.HasPrefix(name, word) && len([]rune(word)) >= 3) {
+				scores[i] = 0.7
+				matched[j] = true
+			}
+		}
+		total += scores[i]
+	}
+	extra := 0
+	for j, word := range words {
+		if _, isType := typeWords[word]; !matched[j] && !isType {
+			extra++
+		}
+	}
+
+	return scores, total, extra
+}
+
+// Найти дом адресного объекта по номеру дома, корпуса и строения
+func (n *NormalizeService) findHouse(guid string, parsed entity.ParsedAddress) (*entity.HouseObject, float64) {
+	houses := n.houseService.GetByAddressGuid(guid)
+	sort.SliceStable(houses, func(i, j int) bool {
+		return houses[i].HouseFullNum < houses[j].HouseFullNum
+	})
+	var best *entity.HouseObject
+	bestScore := 0.0
+	for _, house := range houses {
+		if normalizeNumber(house.HouseNum) != parsed.House {
+			continue
+		}
+		score := 0.7
+		if normalizeNumber(house.BuildNum) == parsed.Building {
+			score += 0.15
+		}
+		if normalizeNumber(house.StructNum) == parsed.Structure {
+			score += 0.15
+		}
+		if score > bestScore {
+			best, bestScore = house, score
+		}
+	}
+
+	return best, bestScore
+}
+
+// Найти помещение дома по номеру квартиры
+func (n *NormalizeService) findRoom(houseGuid string, apartment string) *entity.RoomObject {
+	var result *entity.RoomObject
+	for _, room := range n.roomService.GetByHouseGuid(houseGuid) {
+		if normalizeNumber(room.FlatNumber) != apartment {
+			continue
+		}
+		// Предпочитает квартиру целиком, а не комнату в ней
+		if result == nil || room.RoomNumber == "" {
+			result = room
+		}
+	}
+
+	return result
+}
+
+// Приводит номер дома или квартиры к виду, используемому при разборе строки
+func normalizeNumber(number string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(strings.ToLower(number), " ", ""), "ё", "е")
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Количество первых результатов поиска, среди которых выполняется ранжирование
+const rankingWindowSize = 50
+
+// Сервис ранжирования результатов поиска
+type RankingService struct {
+	addressRepo repository.AddressRepositoryInterface // Репозиторий адресов
+	Options     entity.RankingOptions                 // Параметры ранжирования по умолчанию
+	logger      interfaces.LoggerInterface            // Логгер
+}
+
+// Инициализация сервиса
+func NewRankingService(addressRepo repository.AddressRepositoryInterface, logger interfaces.LoggerInterface, config interfaces.ConfigInterface) *RankingService {
+	ranking := config.GetConfig().Ranking
+	service := &RankingService{
+		addressRepo: addressRepo,
+		logger:      logger,
+	}
+	if ranking.Enable {
+		service.Options = entity.RankingOptions{
+			Level:      ranking.Level,
+			Center:     ranking.Center,
+			Population: ranking.Population,
+		}
+	}
+
+	return service
+}
+
+// Получить параметры ранжирования запроса, при их отсутствии используются параметры по умолчанию
+func (r *RankingService) GetOptions(options *entity.RankingOptions) entity.RankingOptions {
+	if options == nil {
+		return r.Options
+	}
+
+	return *options
+}
+
+// Получить размер окна ранжирования. Ранжируются только первые результаты поиска,
+// поэтому все страницы выбираются из одного окна, а результаты за его пределами остаются в исходном порядке
+func (r *RankingService) GetWindowSize() int64 {
+	return rankingWindowSize
+}
+
+// Получить количество результатов поиска, которое нужно выбрать для страницы с учетом окна ранжирования
+func (r *RankingService) GetCandidatesSize(size int64, from int64) int64 {
+	if from+size > rankingWindowSize {
+		return from + size
+	}
+
+	return rankingWindowSize
+}
+
+// Получить количество первых элементов выборки, попадающих в окно ранжирования
+func (r *RankingService) getWindowLength(length int) int {
+	if length > rankingWindowSize {
+		return rankingWindowSize
+	}
+
+	return length
+}
+
+// Отсортировать адреса окна ранжирования по совпадению с подстрокой и рангу
+func (r *RankingService) RankAddresses(term string, items []*entity.AddressObject, options entity.RankingOptions) {
+	items = items[:r.getWindowLength(len(items))]
+	settlements := r.getSettlements(items)
+	termWords := prepareRankingWords(term)
+	matched := make([]bool, len(items))
+	scores := make([]float64, len(items))
+	for i, item := range items {
+		// Подстрока сравнивается с адресом в обеих иерархиях
+		matched[i] = isTermMatched(termWords, item.AddressSuggest+" "+item.MunAddressSuggest)
+		scores[i] = r.getScore(item.AoLevel, settlements[r.getSettlementGuid(item)], options)
+	}
+
+	sorted := make([]*entity.AddressObject, len(items))
+	for i, index := range getRankOrder(matched, scores) {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+}
+
+// Отсортировать дома окна ранжирования по совпадению с подстрокой и рангу населенного пункта
+func (r *RankingService) RankHouses(term string, items []*entity.HouseObject, options entity.RankingOptions) {
+	items = items[:r.getWindowLength(len(items))]
+	var guids []string
+	for _, item := range items {
+		guids = append(guids, item.AoGuid)
+	}
+	parents := make(map[string]*entity.AddressObject)
+	for _, parent := range r.getAddresses(guids) {
+		parents[parent.AoGuid] = parent
+	}
+	var parentList []*entity.AddressObject
+	for _, parent := range parents {
+		parentList = append(parentList, parent)
+	}
+	settlements := r.getSettlements(parentList)
+
+	termWords := prepareRankingWords(term)
+	matched := make([]bool, len(items))
+	scores := make([]float64, len(items))
+	for i, item := range items {
+		matched[i] = isTermMatched(termWords, item.AddressSuggest)
+		var settlement *entity.AddressObject
+		if parent, ok := parents[item.AoGuid]; ok {
+			settlement = settlements[r.getSettlementGuid(parent)]
+		}
+		scores[i] = r.getScore(8, settlement, options)
+	}
+
+	sorted := make([]*entity.HouseObject, len(items))
+	for i, index := range getRankOrder(matched, scores) {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+}
+
+// Получить ранг объекта по уровню и данным его населенного пункта
+func (r *RankingService) getScore(level int, settlement *entity.AddressObject, options entity.RankingOptions) float64 {
+	score := options.Level * getLevelScore(level)
+	if settlement != nil {
+		score += options.Center * getCenterScore(settlement.CentStatus)
+		score += options.Population * getPopulationScore(settlement.Population)
+	}
+
+	return score
+}
+
+// Получить GUID населенного пункта, к которому относится адрес
+func (r *RankingService) getSettlementGuid(item *entity.AddressObject) string {
+	switch item.AoLevel {
+	case 1, 3, 4, 6:
+		// Регионы, районы, города и населенные пункты ранжируются по собственным данным
+		return item.AoGuid
+	}
+	for _, guid := range []string{item.SettlementGuid, item.CityGuid, item.RegionGuid} {
+		if guid != "" {
+			return guid
+		}
+	}
+
+	return item.AoGuid
+}
+
+// Получить населенные пункты адресов
+func (r *RankingService) getSettlements(items []*entity.AddressObject) map[string]*entity.AddressObject {
+	settlements := make(map[string]*entity.AddressObject)
+	for _, item := range items {
+		settlements[item.AoGuid] = item
+	}
+	var guids []string
+	for _, item := range items {
+		guid := r.getSettlementGuid(item)
+		if _, ok := settlements[guid]; !ok {
+			guids = append(guids, guid)
+		}
+	}
+	for _, settlement := range r.getAddresses(guids) {
+		settlements[settlement.AoGuid] = settlement
+	}
+
+	return settlements
+}
+
+// Получить адреса по списку GUID
+func (r *RankingService) getAddresses(guids []string) []*entity.AddressObject {
+	if len(guids) == 0 {
+		return nil
+	}
+	items, err := r.addressRepo.GetAddressByGuidList(guids)
+	if err != nil {
+		r.logger.Error(err.Error())
+	}
+
+	return items
+}
+
+// Получить порядок элементов: сначала совпадающие с подстрокой, затем по убыванию ранга
+func getRankOrder(matched []bool, scores []float64) []int {
+	order := make([]int, len(scores))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		left, right := order[i], order[j]
+		if matched[left] != matched[right] {
+			return matched[left]
+		}
+
+		return scores[left] > scores[right]
+	})
+
+	return order
+}
+
+// Получить ранг уровня адресного объекта от 0 до 1, чем выше уровень, тем больше ранг
+func getLevelScore(level int) float64 {
+	value := float64(level)
+	// Уровни 35, 65, 75, 90 и 91 располагаются между основными уровнями
+	if level >= 10 {
+		value /= 10
+	}
+
+	return math.Max(0, 1-value/10)
+}
+
+// Получить ранг статуса центра от 0 до 1
+func getCenterScore(status int) float64 {
+	switch status {
+	case 0:
+		return 0
+	case 1:
+		// Центр района
+		return 0.5
+	default:
+		// Центр региона
+		return 1
+	}
+}
+
+// Получить ранг численности населения от 0 до 1 в логарифмической шкале
+func getPopulationScore(population int) float64 {
+	if population <= 0 {
+		return 0
+	}
+
+	return math.Min(1, math.Log10(float64(population)+1)/7)
+}
+
+// Проверить, что каждое слово подстроки является началом одного из слов адреса
+func isTermMatched(termWords []string, suggest string) bool {
+	suggestWords := prepareRankingWords(suggest)
+	for _, word := range termWords {
+		found := false
+		for _, suggestWord := range suggestWords {
+			if strings.HasPrefix(suggestWord, word) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Разбить строку на слова в нижнем регистре
+func prepareRankingWords(value string) []string {
+	value = strings.ReplaceAll(strings.ToLower(value), "ё", "е")
+
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"testing"
+)
+
+// Совпадающие с подстрокой элементы идут первыми, затем по убыванию ранга с сохранением исходного порядка равных
+func TestGetRankOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		matched []bool
+		scores  []float64
+		order   []int
+	}{
+		{"scores only", []bool{false, false, false}, []float64{0.1, 0.9, 0.5}, []int{1, 2, 0}},
+		{"matched first", []bool{false, true, false, true}, []float64{0.9, 0.1, 0.8, 0.5}, []int{3, 1, 0, 2}},
+		{"stable for equal scores", []bool{true, true, false, false}, []float64{0.5, 0.5, 0.5, 0.5}, []int{0, 1, 2, 3}},
+		{"empty", nil, nil, []int{}},
+	}
+	for _, test := range tests {
+		order := getRankOrder(test.matched, test.scores)
+		if len(order) != len(test.order) {
+			t.Errorf("%s: got %v, want %v", test.name, order, test.order)
+			continue
+		}
+		for i := range order {
+			if order[i] != test.order[i] {
+				t.Errorf("%s: got %v, want %v", test.name, order, test.order)
+				break
+			}
+		}
+	}
+}
+
+// Ранг уровня убывает от региона к дому, промежуточные уровни ФИАС располагаются между основными
+func TestGetLevelScore(t *testing.T) {
+	tests := []struct {
+		level int
+		score float64
+	}{
+		{1, 0.9},
+		{3, 0.7},
+		{35, 0.65},
+		{4, 0.6},
+		{6, 0.4},
+		{65, 0.35},
+		{7, 0.3},
+		{75, 0.25},
+		{8, 0.2},
+		{90, 0.1},
+		{91, 0.09},
+	}
+	for _, test := range tests {
+		if score := getLevelScore(test.level); score < test.score-1e-9 || score > test.score+1e-9 {
+			t.Errorf("level %d: got %v, want %v", test.level, score, test.score)
+		}
+	}
+	// Уровни по порядку от верхнего к нижнему
+	levels := []int{1, 2, 3, 35, 4, 5, 6, 65, 7, 75, 8, 90, 91}
+	for i := 1; i < len(levels); i++ {
+		if getLevelScore(levels[i-1]) <= getLevelScore(levels[i]) {
+			t.Errorf("level %d score is not greater than level %d score", levels[i-1], levels[i])
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"sort"
+	"sync"
+)
+
+// Фильтр импорта по регионам
+type RegionFilter struct {
+	addressRepo repository.AddressRepositoryInterface // Репозиторий адресов
+	regions     map[string]bool                       // Коды выбранных регионов
+	addresses   map[string]bool                       // Принадлежность адресов к выбранным регионам по GUID
+	Lookup      bool                                  // Искать в БД адреса, не встречавшиеся при разборе файлов
+	mu          sync.RWMutex                          // Блокировка списка адресов
+}
+
+// Инициализация фильтра
+func NewRegionFilter(addressRepo repository.AddressRepositoryInterface) *RegionFilter {
+	return &RegionFilter{
+		addressRepo: addressRepo,
+		regions:     make(map[string]bool),
+		addresses:   make(map[string]bool),
+	}
+}
+
+// Установить список регионов
+func (f *RegionFilter) SetRegions(regions []string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.regions = make(map[string]bool)
+	f.addresses = make(map[string]bool)
+	for _, region := range regions {
+		f.regions[region] = true
+	}
+}
+
+// Получить отсортированный список регионов
+func (f *RegionFilter) GetRegions() []string {
+	var regions []string
+	for region := range f.regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	return regions
+}
+
+// Проверить, ограничен ли импорт регионами
+func (f *RegionFilter) IsEnabled() bool {
+	return len(f.regions) > 0
+}
+
+// Проверить, входит ли регион в список
+func (f *RegionFilter) HasRegion(code string) bool {
+	return !f.IsEnabled() || f.regions[code]
+}
+
+// Запомнить адрес выбранного региона
+func (f *RegionFilter) AddAddress(guid string) {
+	if !f.IsEnabled() {
+		return
+	}
+	f.mu.Lock()
+	f.addresses[guid] = true
+	f.mu.Unlock()
+}
+
+// Проверить, относится ли адрес к выбранным регионам
+func (f *RegionFilter) HasAddress(guid string) bool {
+	if !f.IsEnabled() {
+		return true
+	}
+	f.mu.RLock()
+	found, ok := f.addresses[guid]
+	f.mu.RUnlock()
+	if ok || !f.Lookup {
+		return found
+	}
+
+	// Определяет регион адреса по данным в БД
+	address, _ := f.addressRepo.GetByGuid(guid)
+	found = address != nil && f.regions[address.RegionCode]
+	f.mu.Lock()
+	f.addresses[guid] = found
+	f.mu.Unlock()
+
+	return found
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	xmlparser "github.com/tamerh/xml-stream-parser"
+	"os"
+	"sync"
+	"time"
+)
+
+// Сервис импорта помещений
+type RoomImportService struct {
+	RoomRepo    repository.RoomRepositoryInterface // Репозиторий помещений
+	IsFull      bool                               `default:"false"` // Полный импорт
+	logger      interfaces.LoggerInterface         // Логгер
+	currentTime int64                              // Время начала импорта
+	journal     *journalService.JournalService     // Журнал импорта
+	regions     *RegionFilter                      // Фильтр импорта по регионам
+}
+
+// Инициализация сервиса
+func NewRoomImportService(roomRepo repository.RoomRepositoryInterface, logger interfaces.LoggerInterface, journal *journalService.JournalService, regions *RegionFilter) *RoomImportService {
+	err := roomRepo.Init()
+	if err != nil {
+		logger.Panic(err.Error())
+		os.Exit(1)
+	}
+
+	return &RoomImportService{
+		RoomRepo:    roomRepo,
+		logger:      logger,
+		currentTime: time.Now().Unix(),
+		journal:     journal,
+		regions:     regions,
+	}
+}
+
+// Импорт помещений
+func (r *RoomImportService) Import(file directoryEntity.File, wg *sync.WaitGroup, cnt chan int) {
+	defer wg.Done()
+	// Открывает файл или файл в архиве для потокового чтения
+	reader, err := file.Open()
+	if err != nil {
+		r.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "error": err}).Fatal("Error opening file")
+	}
+	defer reader.Close()
+	var importWg sync.WaitGroup
+	importWg.Add(2)
+	roomChannel := make(chan interface{})
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&importWg, reader, file.Path, roomChannel, r.logger, r.ParseElement, "Room", -1, r.journal.GetFileOffset(file.Path), r.journal.GetCheckpoint(file.Path))
+	// Сохраняет элементы в БД
+	go r.RoomRepo.InsertUpdateCollection(&importWg, roomChannel, cnt, r.IsFull)
+	importWg.Wait()
+	r.journal.FinishFile(file.Path)
+}
+
+// Разбор объекта из xml
+func (r *RoomImportService) ParseElement(element *xmlparser.XMLElement) (interface{}, error) {
+	// Пропускает неактивные элементы при полном импорте
+	if r.IsFull {
+		end, err := time.Parse("2006-01-02", element.Attrs["ENDDATE"])
+
+		if err != nil || end.Unix() <= r.currentTime {
+			return nil, nil
+		}
+	}
+	// Пропускает помещения невыбранных регионов
+	if !r.regions.HasRegion(element.Attrs["REGIONCODE"]) {
+		return nil, nil
+	}
+
+	result := entity.RoomObject{
+		ID:         element.Attrs["ROOMID"],
+		RoomGuid:   element.Attrs["ROOMGUID"],
+		HouseGuid:  element.Attrs["HOUSEGUID"],
+		RegionCode: element.Attrs["REGIONCODE"],
+		FlatNumber: element.Attrs["FLATNUMBER"],
+		FlatType:   element.Attrs["FLATTYPE"],
+		RoomNumber: element.Attrs["ROOMNUMBER"],
+		RoomType:   element.Attrs["ROOMTYPE"],
+		PostalCode: element.Attrs["POSTALCODE"],
+		CadNum:     element.Attrs["CADNUM"],
+		RoomCadNum: element.Attrs["ROOMCADNUM"],
+		StartDate:  element.Attrs["STARTDATE"],
+		EndDate:    element.Attrs["ENDDATE"],
+		UpdateDate: element.Attrs["UPDATEDATE"],
+	}
+	return result, nil
+}
+
+// Подсчитать общее количество помещений в БД
+func (r *RoomImportService) CountAllData() int64 {
+	res, err := r.RoomRepo.CountAllData(nil)
+	r.checkError(err)
+
+	return res
+}
+
+// Индексация таблицы помещений
+func (r *RoomImportService) Index(start time.Time, objects repository.GetIndexObjects) {
+	err := r.RoomRepo.Index(start, objects)
+	r.checkError(err)
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (r *RoomImportService) checkError(err error) {
+	if err != nil {
+		r.logger.Error(err.Error())
+	}
+}
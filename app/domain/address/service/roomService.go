@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"os"
+)
+
+// Сервис получения данных о помещениях
+type RoomService struct {
+	RoomRepo repository.RoomRepositoryInterface // Репозиторий помещений
+	logger   interfaces.LoggerInterface         // Логгер
+}
+
+// Инициализация сервиса
+func NewRoomService(roomRepo repository.RoomRepositoryInterface, logger interfaces.LoggerInterface) *RoomService {
+	err := roomRepo.Init()
+	if err != nil {
+		logger.Panic(err.Error())
+		os.Exit(1)
+	}
+
+	return &RoomService{
+		RoomRepo: roomRepo,
+		logger:   logger,
+	}
+}
+
+// Найти помещение по GUID
+func (r *RoomService) GetByGuid(guid string) *entity.RoomObject {
+	res, err := r.RoomRepo.GetByGuid(guid)
+	r.checkError(err)
+
+	return res
+}
+
+// Найти помещения по GUID дома
+func (r *RoomService) GetByHouseGuid(guid string) []*entity.RoomObject {
+	res, err := r.RoomRepo.GetByHouseGuid(guid)
+	r.checkError(err)
+
+	return res
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (r *RoomService) checkError(err error) {
+	if err != nil {
+		r.logger.Error(err.Error())
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	xmlparser "github.com/tamerh/xml-stream-parser"
+	"os"
+	"sync"
+	"time"
+)
+
+// Сервис импорта земельных участков
+type SteadImportService struct {
+	SteadRepo   repository.SteadRepositoryInterface // Репозиторий участков
+	IsFull      bool                                `default:"false"` // Полный импорт
+	logger      interfaces.LoggerInterface          // Логгер
+	currentTime int64                               // Время начала импорта
+	journal     *journalService.JournalService      // Журнал импорта
+	regions     *RegionFilter                       // Фильтр импорта по регионам
+}
+
+// Инициализация сервиса
+func NewSteadImportService(steadRepo repository.SteadRepositoryInterface, logger interfaces.LoggerInterface, journal *journalService.JournalService, regions *RegionFilter) *SteadImportService {
+	err := steadRepo.Init()
+	if err != nil {
+		logger.Panic(err.Error())
+		os.Exit(1)
+	}
+
+	return &SteadImportService{
+		SteadRepo:   steadRepo,
+		logger:      logger,
+		currentTime: time.Now().Unix(),
+		journal:     journal,
+		regions:     regions,
+	}
+}
+
+// Импорт участков
+func (s *SteadImportService) Import(file directoryEntity.File, wg *sync.WaitGroup, cnt chan int) {
+	defer wg.Done()
+	// Открывает файл или файл в архиве для потокового чтения
+	reader, err := file.Open()
+	if err != nil {
+		s.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "error": err}).Fatal("Error opening file")
+	}
+	defer reader.Close()
+	var importWg sync.WaitGroup
+	importWg.Add(2)
+	steadChannel := make(chan interface{})
+	// Чтение файла импорта и парсинг элементов
+	go util.ParseFile(&importWg, reader, file.Path, steadChannel, s.logger, s.ParseElement, "Stead", -1, s.journal.GetFileOffset(file.Path), s.journal.GetCheckpoint(file.Path))
+	// Сохраняет элементы в БД
+	go s.SteadRepo.InsertUpdateCollection(&importWg, steadChannel, cnt, s.IsFull)
+	importWg.Wait()
+	s.journal.FinishFile(file.Path)
+}
+
+// Разбор объекта из xml
+func (s *SteadImportService) ParseElement(element *xmlparser.XMLElement) (interface{}, error) {
+	// Пропускает неактивные элементы при полном импорте
+	if s.IsFull {
+		end, err := time.Parse("2006-01-02", element.Attrs["ENDDATE"])
+
+		if err != nil || end.Unix() <= s.currentTime {
+			return nil, nil
+		}
+	}
+	// Пропускает участки невыбранных регионов
+	if !s.regions.HasRegion(element.Attrs["REGIONCODE"]) {
+		return nil, nil
+	}
+
+	result := entity.SteadObject{
+		ID:         element.Attrs["STEADID"],
+		SteadGuid:  element.Attrs["STEADGUID"],
+		ParentGuid: element.Attrs["PARENTGUID"],
+		RegionCode: element.Attrs["REGIONCODE"],
+		Number:     element.Attrs["NUMBER"],
+		PostalCode: element.Attrs["POSTALCODE"],
+		Okato:      element.Attrs["OKATO"],
+		Oktmo:      element.Attrs["OKTMO"],
+		CadNum:     element.Attrs["CADNUM"],
+		DivType:    element.Attrs["DIVTYPE"],
+		StartDate:  element.Attrs["STARTDATE"],
+		EndDate:    element.Attrs["ENDDATE"],
+		UpdateDate: element.Attrs["UPDATEDATE"],
+	}
+	return result, nil
+}
+
+// Подсчитать общее количество участков в БД
+func (s *SteadImportService) CountAllData() int64 {
+	res, err := s.SteadRepo.CountAllData(nil)
+	s.checkError(err)
+
+	return res
+}
+
+// Индексация таблицы участков
+func (s *SteadImportService) Index(start time.Time, objects repository.GetIndexObjects) {
+	err := s.SteadRepo.Index(start, objects)
+	s.checkError(err)
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (s *SteadImportService) checkError(err error) {
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+}
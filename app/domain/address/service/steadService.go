@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"os"
+)
+
+// Сервис получения данных о земельных участках
+type SteadService struct {
+	SteadRepo repository.SteadRepositoryInterface // Репозиторий участков
+	logger    interfaces.LoggerInterface          // Логгер
+}
+
+// Инициализация сервиса
+func NewSteadService(steadRepo repository.SteadRepositoryInterface, logger interfaces.LoggerInterface) *SteadService {
+	err := steadRepo.Init()
+	if err != nil {
+		logger.Panic(err.Error())
+		os.Exit(1)
+	}
+
+	return &SteadService{
+		SteadRepo: steadRepo,
+		logger:    logger,
+	}
+}
+
+// Найти участок по GUID
+func (s *SteadService) GetByGuid(guid string) *entity.SteadObject {
+	res, err := s.SteadRepo.GetByGuid(guid)
+	s.checkError(err)
+
+	return res
+}
+
+// Найти участки по GUID адреса
+func (s *SteadService) GetByAddressGuid(guid string) []*entity.SteadObject {
+	res, err := s.SteadRepo.GetByAddressGuid(guid)
+	s.checkError(err)
+
+	return res
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (s *SteadService) checkError(err error) {
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+}
@@ -1,6 +1,58 @@
 package entity
 
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+)
+
 // Объект файла
 type File struct {
-	Path string
+	Path    string // Путь к файлу или название файла в архиве
+	Archive string // Путь к архиву, из которого файл читается без распаковки
+}
+
+// Открыть файл для чтения
+func (f File) Open() (io.ReadCloser, error) {
+	if f.Archive == "" {
+		return os.Open(f.Path)
+	}
+
+	r, err := zip.OpenReader(f.Archive)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range r.File {
+		if item.Name != f.Path {
+			continue
+		}
+		// Контрольная сумма файла проверяется при чтении до конца
+		rc, err := item.Open()
+		if err != nil {
+			r.Close()
+			return nil, err
+		}
+
+		return &archiveFile{ReadCloser: rc, archive: r}, nil
+	}
+	r.Close()
+
+	return nil, fmt.Errorf("file %s not found in archive %s", f.Path, f.Archive)
+}
+
+// Файл, открытый из архива
+type archiveFile struct {
+	io.ReadCloser
+	archive *zip.ReadCloser // Архив, закрывается вместе с файлом
+}
+
+// Закрыть файл и архив
+func (a *archiveFile) Close() error {
+	err := a.ReadCloser.Close()
+	if archiveErr := a.archive.Close(); err == nil {
+		err = archiveErr
+	}
+
+	return err
 }
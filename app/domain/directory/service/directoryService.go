@@ -25,16 +25,48 @@ func (d *DirectoryService) ClearDirectory() {
 	d.downloadService.ClearDirectory()
 }
 
-// Скачать и распаковать файлы
-func (d *DirectoryService) DownloadAndExtractFile(url string, fileName string, parts ...string) *[]entity.File {
+// Скачать архив и получить список файлов в нем
+func (d *DirectoryService) DownloadArchive(url string, fileName string, parts ...string) *[]entity.File {
 	// Скачивает файл
 	file, err := d.downloadService.DownloadFile(url, fileName)
 	d.checkFatalError(err)
-	// Распаковывает файл
-	extractedFiles, err := d.downloadService.Unzip(file, parts...)
+	// Проверяет целостность архива перед распаковкой
+	if err := d.downloadService.VerifyZip(file); err != nil {
+		d.logger.WithFields(interfaces.LoggerFields{"error": err}).Warn("Archive is broken, download it again")
+		// Удаляет поврежденный архив и скачивает его заново
+		d.checkFatalError(os.Remove(file.Path))
+		file, err = d.downloadService.DownloadFile(url, fileName)
+		d.checkFatalError(err)
+		d.checkFatalError(d.downloadService.VerifyZip(file))
+	}
+	// Получает список файлов для чтения без распаковки
+	files, err := d.downloadService.ListArchiveFiles(file, parts...)
+	d.checkFatalError(err)
+
+	return &files
+}
+
+// Получить список файлов в локальном архиве
+func (d *DirectoryService) OpenArchive(path string, parts ...string) *[]entity.File {
+	// Проверяет наличие архива
+	_, err := os.Stat(path)
+	d.checkFatalError(err)
+	// Проверяет целостность архива перед распаковкой
+	file := &entity.File{Path: path}
+	d.checkFatalError(d.downloadService.VerifyZip(file))
+	// Получает список файлов для чтения без распаковки
+	files, err := d.downloadService.ListArchiveFiles(file, parts...)
+	d.checkFatalError(err)
+
+	return &files
+}
+
+// Найти файлы в локальной директории
+func (d *DirectoryService) FindFiles(dir string, parts ...string) *[]entity.File {
+	files, err := d.downloadService.FindFiles(dir, parts...)
 	d.checkFatalError(err)
 
-	return &extractedFiles
+	return &files
 }
 
 // Проверяет наличие ошибки и логирует ее
@@ -0,0 +1,139 @@
+package service
+
+import (
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/directory/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/tamerh/xml-stream-parser"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Шаблоны файлов, которые импорт ищет в архиве или директории
+var testParts = []string{
+	addressEntity.AddressObject{}.GetXmlFile(),
+	addressEntity.HouseObject{}.GetXmlFile(),
+}
+
+func newTestDirectoryService() *DirectoryService {
+	logger := testLogger{}
+
+	return NewDirectoryService(NewDownloadService(logger, nil), logger, nil)
+}
+
+// Прочитать из файлов импорта идентификаторы объектов так же, как это делает импорт
+func readGuids(t *testing.T, files *[]entity.File) map[string][]string {
+	guids := make(map[string][]string)
+	for _, file := range *files {
+		name := filepath.Base(file.Path)
+		xmlName, attr := "Object", "AOGUID"
+		if strings.HasPrefix(name, testParts[1]) {
+			xmlName, attr = "House", "HOUSEGUID"
+		}
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Path, err)
+		}
+		c := make(chan interface{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		// Разбор закрывает канал после чтения файла
+		go util.ParseFile(&wg, reader, name, c, testLogger{}, func(element *xmlparser.XMLElement) (interface{}, error) {
+			return element.Attrs[attr], nil
+		}, xmlName, 0, 0, nil)
+		for item := range c {
+			if guid, ok := item.(string); ok {
+				guids[xmlName] = append(guids[xmlName], guid)
+			}
+		}
+		wg.Wait()
+		if err := reader.Close(); err != nil {
+			t.Fatalf("close %s: %v", file.Path, err)
+		}
+	}
+	for _, list := range guids {
+		sort.Strings(list)
+	}
+
+	return guids
+}
+
+func checkImportFiles(t *testing.T, files *[]entity.File) {
+	if len(*files) != 2 {
+		t.Fatalf("expected 2 import files, got %v", *files)
+	}
+	guids := readGuids(t, files)
+	if got := guids["Object"]; len(got) != 2 || got[0] != "c1" || got[1] != "r1" {
+		t.Errorf("unexpected address guids %v", got)
+	}
+	if got := guids["House"]; len(got) != 1 || got[0] != "hg1" {
+		t.Errorf("unexpected house guids %v", got)
+	}
+}
+
+// Импорт из локального архива (--from-file)
+func TestOpenArchive(t *testing.T) {
+	files := newTestDirectoryService().OpenArchive(filepath.Join("testdata", "fias.zip"), testParts...)
+	for _, file := range *files {
+		if file.Archive == "" {
+			t.Errorf("file %s must be read from archive", file.Path)
+		}
+	}
+	checkImportFiles(t, files)
+}
+
+// Импорт из директории с распакованными файлами (--from-dir)
+func TestFindFiles(t *testing.T) {
+	files := newTestDirectoryService().FindFiles(filepath.Join("testdata", "fias"), testParts...)
+	for _, file := range *files {
+		if file.Archive != "" {
+			t.Errorf("file %s must be read from directory", file.Path)
+		}
+	}
+	checkImportFiles(t, files)
+}
+
+// Поврежденный архив не проходит проверку перед импортом
+func TestVerifyBrokenZip(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "fias.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "fias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "broken.zip")
+	if err := ioutil.WriteFile(path, data[:len(data)/2], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	downloadService := NewDownloadService(testLogger{}, nil)
+	if err := downloadService.VerifyZip(&entity.File{Path: path}); err == nil {
+		t.Error("expected error for truncated archive")
+	}
+	if err := downloadService.VerifyZip(&entity.File{Path: filepath.Join("testdata", "fias.zip")}); err != nil {
+		t.Errorf("unexpected error for fixture archive: %v", err)
+	}
+}
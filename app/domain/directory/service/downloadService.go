@@ -2,6 +2,8 @@ package service
 
 import (
 	"archive/zip"
+	"context"
+	"fmt"
 	fileEntity "github.com/GarinAG/gofias/domain/directory/entity"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/GarinAG/gofias/util"
@@ -12,6 +14,13 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// Максимальная задержка между попытками загрузки
+	maxRetryDelay = 5 * time.Minute
 )
 
 // Сервис управления загрузкой файлов
@@ -54,23 +63,25 @@ func (d *DownloadService) CreateDirectory() {
 }
 
 // Получить размер файла
-func (d *DownloadService) GetDownloadSize(url string) uint64 {
+func (d *DownloadService) GetDownloadSize(url string) (uint64, error) {
+	client, err := d.getHttpClient()
+	if err != nil {
+		return 0, err
+	}
 	// Получает заголовки по URL
-	resp, err := http.Head(url)
+	resp, err := client.Head(url)
 	if err != nil {
-		d.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Get download file size error")
+		return 0, err
 	}
-	if resp != nil {
-		// Проверяет код ответа сервера
-		if resp.StatusCode != http.StatusOK {
-			d.logger.WithFields(interfaces.LoggerFields{"code": resp.StatusCode}).Fatal("Wrong http status code of file")
-		}
-		// Получает размер файла из заголовка
-		size, _ := strconv.Atoi(resp.Header.Get("Content-Length"))
-		return uint64(size)
+	defer resp.Body.Close()
+	// Проверяет код ответа сервера
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("wrong http status code of file: %d", resp.StatusCode)
 	}
+	// Получает размер файла из заголовка
+	size, _ := strconv.ParseUint(resp.Header.Get("Content-Length"), 10, 64)
 
-	return 0
+	return size, nil
 }
 
 // Скачать файл
@@ -80,138 +91,255 @@ func (d *DownloadService) DownloadFile(url string, fileName string) (*fileEntity
 
 	filePathLocal := d.config.GetConfig().DirectoryFilePath + fileName
 	// Проверяет наличие ранее скачанного файла
-	if _, err := os.Stat(filePathLocal); os.IsNotExist(err) {
-		d.logger.WithFields(interfaces.LoggerFields{"url": url, "path": filePathLocal}).Info("Download Started")
+	if _, err := os.Stat(filePathLocal); err == nil {
+		return &fileEntity.File{Path: filePathLocal}, nil
+	}
 
-		// Создает временный файл
-		out, err := os.Create(filePathLocal + ".tmp")
-		if err != nil {
-			return nil, err
+	downloadConfig := d.config.GetConfig().Download
+	delay := time.Duration(downloadConfig.RetryDelay) * time.Second
+	d.logger.WithFields(interfaces.LoggerFields{"url": url, "path": filePathLocal}).Info("Download Started")
+
+	var err error
+	for attempt := 0; attempt <= downloadConfig.Retries; attempt++ {
+		if attempt > 0 {
+			d.logger.WithFields(interfaces.LoggerFields{"error": err, "attempt": attempt, "delay": delay.String()}).Warn("Download failed, retry")
+			time.Sleep(delay)
+			// Увеличивает задержку перед следующей попыткой
+			if delay < maxRetryDelay {
+				delay *= 2
+			}
 		}
-		defer out.Close()
+		// Докачивает временный файл
+		if err = d.download(url, filePathLocal+".tmp"); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Переименовывает временный файл
+	if err = os.Rename(filePathLocal+".tmp", filePathLocal); err != nil {
+		return nil, err
+	}
+	d.logger.Info("Download Finished")
+
+	return &fileEntity.File{Path: filePathLocal}, nil
+}
 
-		// Создает прогресс-бар для отображение статуса загрузки
-		bar := util.StartNewProgress(int(d.GetDownloadSize(url)), "Downloading", true)
+// Проверить целостность ZIP-архива
+func (d *DownloadService) VerifyZip(file *fileEntity.File) error {
+	// Открывает архив, что проверяет наличие и корректность оглавления в конце файла
+	r, err := zip.OpenReader(file.Path)
+	if err != nil {
+		return fmt.Errorf("broken zip file %s: %w", file.Path, err)
+	}
+	defer r.Close()
+	if len(r.File) == 0 {
+		return fmt.Errorf("empty zip file %s", file.Path)
+	}
 
-		// Получает файл
-		resp, err := http.Get(url)
+	// Проверяет, что размер файла соответствует смещениям файлов в архиве
+	info, err := os.Stat(file.Path)
+	if err != nil {
+		return err
+	}
+	for _, f := range r.File {
+		offset, err := f.DataOffset()
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("broken zip file %s: %w", file.Path, err)
 		}
-		defer resp.Body.Close()
-
-		// Копирует файл во временный
-		if _, err = io.Copy(io.MultiWriter(out, bar.GeBar()), resp.Body); err != nil {
-			return nil, err
+		if uint64(offset)+f.CompressedSize64 > uint64(info.Size()) {
+			return fmt.Errorf("broken zip file %s: %s is out of file bounds", file.Path, f.Name)
 		}
-		// Переименовывает временный файл
-		if err = os.Rename(filePathLocal+".tmp", filePathLocal); err != nil {
-			return nil, err
+	}
+
+	return nil
+}
+
+// Скачивает файл во временный файл с докачкой ранее загруженной части
+func (d *DownloadService) download(url string, tmpPath string) error {
+	client, err := d.getHttpClient()
+	if err != nil {
+		return err
+	}
+	// Размер файла нужен только для прогресс-бара и проверки, поэтому ошибка не критична
+	size, err := d.GetDownloadSize(url)
+	if err != nil {
+		d.logger.WithFields(interfaces.LoggerFields{"error": err}).Warn("Get download file size error")
+	}
+
+	// Открывает временный файл без очистки для докачки
+	out, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	info, err := out.Stat()
+	if err != nil {
+		return err
+	}
+	offset := info.Size()
+	if size > 0 && uint64(offset) == size {
+		return nil
+	}
+
+	// Запрос прерывается, если данные не поступают дольше таймаута
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	// Получает файл
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		d.logger.WithFields(interfaces.LoggerFields{"offset": offset}).Info("Resume download")
+	case http.StatusOK:
+		// Сервер не поддерживает докачку, файл загружается заново
+		offset = 0
+	case http.StatusRequestedRangeNotSatisfiable:
+		// Временный файл поврежден, загрузка начнется заново при следующей попытке
+		if err := out.Truncate(0); err != nil {
+			return err
 		}
+		return fmt.Errorf("requested range not satisfiable, offset: %d", offset)
+	default:
+		return fmt.Errorf("wrong http status code of file: %d", resp.StatusCode)
+	}
+	if err := out.Truncate(offset); err != nil {
+		return err
+	}
+	if _, err := out.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if size == 0 && resp.ContentLength > 0 {
+		size = uint64(offset + resp.ContentLength)
+	}
+
+	// Создает прогресс-бар для отображение статуса загрузки
+	bar := util.StartNewProgress(int(size), "Downloading", true)
+	bar.SetCurrent(offset)
+	defer bar.Finish()
 
-		bar.Finish()
-		d.logger.Info("Download Finished")
+	body := newIdleTimeoutReader(resp.Body, time.Duration(d.config.GetConfig().Download.Timeout)*time.Second, cancel)
+	defer body.Stop()
+
+	// Копирует файл во временный
+	written, err := io.Copy(io.MultiWriter(out, bar.GeBar()), body)
+	if err != nil {
+		return err
+	}
+	// Проверяет размер загруженного файла
+	if size > 0 && uint64(offset+written) != size {
+		return fmt.Errorf("downloaded file size mismatch: expected %d, got %d", size, offset+written)
 	}
 
-	return &fileEntity.File{Path: filePathLocal}, nil
+	return nil
 }
 
-// Распаковать файл
-func (d *DownloadService) Unzip(file *fileEntity.File, parts ...string) ([]fileEntity.File, error) {
-	d.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "parts": parts}).Info("Start unzip file")
-	// Проверяет наличие шаблонов названий файлов для распаковки
+// Инициализация http-клиента
+func (d *DownloadService) getHttpClient() (*http.Client, error) {
+	downloadConfig := d.config.GetConfig().Download
+	transport, err := util.NewHttpTransport(downloadConfig.Proxy, time.Duration(downloadConfig.Timeout)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// Получить список файлов в архиве для чтения без распаковки
+func (d *DownloadService) ListArchiveFiles(file *fileEntity.File, parts ...string) ([]fileEntity.File, error) {
+	d.logger.WithFields(interfaces.LoggerFields{"file": file.Path, "parts": parts}).Info("Search files in archive")
+	// Проверяет наличие шаблонов названий файлов
 	if len(parts) == 0 {
 		d.logger.Panic("Parts is required field")
 		os.Exit(1)
 	}
-
-	dest := d.config.GetConfig().DirectoryFilePath
 	var filenames []fileEntity.File
 
 	r, err := zip.OpenReader(file.Path)
 	if err != nil {
 		return filenames, err
 	}
-	defer func() {
-		if err := r.Close(); err != nil {
-			d.logger.WithFields(interfaces.LoggerFields{"error": err}).Panic("Open zip error")
-			os.Exit(1)
-		}
-	}()
+	defer r.Close()
 
-	// Создаем обработчик для распаковки файла
-	extractAndWriteFile := func(f *zip.File) (interface{}, error) {
-		rc, err := f.Open()
-		if err != nil {
-			return nil, err
-		}
-		defer func() {
-			if err := rc.Close(); err != nil {
-				d.checkFatalError(err)
-			}
-		}()
-
-		savePath := filepath.Join(dest, f.Name)
-
-		// Проверяет является ли объект в архиве директорией
+	// Проходит по всем файлам в архиве
+	for _, f := range r.File {
 		if f.FileInfo().IsDir() {
-			// Создает директорию с правами 0777
-			err := os.MkdirAll(savePath, os.ModePerm)
+			continue
+		}
+		// Проходит по всем шаблонам названий файлов
+		for _, part := range parts {
+			matched, err := d.matchPart(part, f.Name)
 			if err != nil {
-				return nil, err
+				return filenames, err
 			}
-		} else {
-			// Проверяет наличие ранее распакованного файла
-			if _, err := os.Stat(savePath); err != nil {
-				// Создает поддиректорию с правами 0777
-				err := os.MkdirAll(filepath.Dir(savePath), os.ModePerm)
-				if err != nil {
-					return nil, err
-				}
-				// Создает временный файл
-				f, err := os.OpenFile(savePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
-				if err != nil {
-					return nil, err
-				}
-				defer func() {
-					if err := f.Close(); err != nil {
-						d.checkFatalError(err)
-					}
-				}()
-				// Распаковывает файл
-				_, err = io.Copy(f, rc)
-				if err != nil {
-					return nil, err
-				}
+			if matched {
+				filenames = append(filenames, fileEntity.File{Path: f.Name, Archive: file.Path})
 			}
 		}
+	}
+
+	// Возвращает список файлов в архиве
+	return filenames, nil
+}
 
-		return fileEntity.File{Path: savePath}, nil
+// Найти файлы в распакованной директории
+func (d *DownloadService) FindFiles(dir string, parts ...string) ([]fileEntity.File, error) {
+	d.logger.WithFields(interfaces.LoggerFields{"dir": dir, "parts": parts}).Info("Search files in directory")
+	// Проверяет наличие шаблонов названий файлов
+	if len(parts) == 0 {
+		d.logger.Panic("Parts is required field")
+		os.Exit(1)
 	}
+	var filenames []fileEntity.File
 
-	// Проходит по всем файлам в архиве
-	for _, f := range r.File {
-		// Проходит по всем шаблонам названий файлов
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		// Сравнивает с шаблонами путь относительно директории, как название файла в архиве
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
 		for _, part := range parts {
-			matched, err := regexp.MatchString(part, f.Name)
+			matched, err := d.matchPart(part, name)
 			if err != nil {
-				return filenames, err
+				return err
 			}
-			// Проверяет совпадение названия с шаблоном и расширение файла
-			if matched && strings.HasSuffix(f.Name, ".XML") {
-				// Распаковывает файл
-				file, err := extractAndWriteFile(f)
-
-				if err != nil {
-					return filenames, err
-				}
-				filenames = append(filenames, file.(fileEntity.File))
+			if matched {
+				filenames = append(filenames, fileEntity.File{Path: path})
 			}
 		}
+
+		return nil
+	})
+
+	return filenames, err
+}
+
+// Проверяет совпадение названия с шаблоном и расширение файла
+func (d *DownloadService) matchPart(part string, name string) (bool, error) {
+	matched, err := regexp.MatchString(part, name)
+	if err != nil {
+		return false, err
 	}
 
-	// Возвращает список распакованных файлов
-	return filenames, nil
+	return matched && strings.HasSuffix(name, ".XML"), nil
 }
 
 // Проверяет наличие ошибки и логирует ее
@@ -221,3 +349,47 @@ func (d *DownloadService) checkFatalError(err error) {
 		os.Exit(1)
 	}
 }
+
+// Чтение ответа с прерыванием запроса при отсутствии данных
+type idleTimeoutReader struct {
+	reader  io.Reader     // Тело ответа
+	timeout time.Duration // Максимальное время ожидания данных
+	timer   *time.Timer   // Таймер прерывания запроса
+	expired int32         // Флаг срабатывания таймаута
+}
+
+// Инициализация чтения с таймаутом
+func newIdleTimeoutReader(reader io.Reader, timeout time.Duration, cancel context.CancelFunc) *idleTimeoutReader {
+	r := &idleTimeoutReader{
+		reader:  reader,
+		timeout: timeout,
+	}
+	if timeout > 0 {
+		r.timer = time.AfterFunc(timeout, func() {
+			atomic.StoreInt32(&r.expired, 1)
+			cancel()
+		})
+	}
+
+	return r
+}
+
+// Прочитать данные и продлить таймаут
+func (r *idleTimeoutReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err != nil && atomic.LoadInt32(&r.expired) == 1 {
+		return n, fmt.Errorf("no data received for %s", r.timeout)
+	}
+	if r.timer != nil && n > 0 {
+		r.timer.Reset(r.timeout)
+	}
+
+	return n, err
+}
+
+// Остановить таймер
+func (r *idleTimeoutReader) Stop() {
+	if r.timer != nil {
+		r.timer.Stop()
+	}
+}
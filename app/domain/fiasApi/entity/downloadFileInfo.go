@@ -6,4 +6,6 @@ type DownloadFileInfo struct {
 	TextVersion        string
 	FiasCompleteXmlUrl string
 	FiasDeltaXmlUrl    string
+	GarXmlFullUrl      string
+	GarXmlDeltaUrl     string
 }
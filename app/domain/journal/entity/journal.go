@@ -0,0 +1,29 @@
+package entity
+
+// Этапы импорта
+const (
+	PhaseDownload = "download" // Загрузка и распаковка файлов
+	PhaseParse    = "parse"    // Разбор файлов и сохранение элементов
+	PhaseIndex    = "index"    // Индексация
+	PhaseDone     = "done"     // Импорт завершен
+)
+
+// Отметка о полной обработке файла
+const FileFinished = -1
+
+// Объект журнала импорта
+type Journal struct {
+	VersionId int            // Импортируемая версия ФИАС
+	IsFull    bool           // Полный импорт
+	Phase     string         // Текущий этап импорта
+	File      string         // Последний обрабатываемый файл
+	Offset    int            // Количество обработанных элементов последнего файла
+	Files     map[string]int // Количество обработанных элементов по файлам
+	Begin     string         // Время начала импорта
+	UpdatedAt string         // Время последнего обновления журнала
+}
+
+// Получить название таблицы в БД
+func (j Journal) TableName() string {
+	return "fias_journal"
+}
@@ -0,0 +1,15 @@
+package repository
+
+import "github.com/GarinAG/gofias/domain/journal/entity"
+
+// Интерфейс репозитория журнала импорта
+type JournalRepositoryInterface interface {
+	// Инициализация таблицы в БД
+	Init() error
+	// Очистка таблицы в БД
+	Clear() error
+	// Получить журнал импорта
+	GetJournal() (*entity.Journal, error)
+	// Сохранить журнал импорта
+	SetJournal(journal *entity.Journal) error
+}
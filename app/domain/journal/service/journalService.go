@@ -0,0 +1,160 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/journal/entity"
+	"github.com/GarinAG/gofias/domain/journal/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"os"
+	"sync"
+	"time"
+)
+
+// Сервис журнала импорта
+type JournalService struct {
+	journalRepo repository.JournalRepositoryInterface // Репозиторий журнала импорта
+	logger      interfaces.LoggerInterface            // Логгер
+	journal     *entity.Journal                       // Текущий журнал импорта
+	mu          sync.Mutex                            // Блокировка изменения журнала
+}
+
+// Инициализация сервиса
+func NewJournalService(journalRepo repository.JournalRepositoryInterface, logger interfaces.LoggerInterface) *JournalService {
+	err := journalRepo.Init()
+	if err != nil {
+		logger.Fatal(err.Error())
+		os.Exit(1)
+	}
+
+	return &JournalService{
+		journalRepo: journalRepo,
+		logger:      logger,
+	}
+}
+
+// Загрузить незавершенный журнал импорта
+func (j *JournalService) Load() *entity.Journal {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	journal, err := j.journalRepo.GetJournal()
+	j.checkFatalError(err)
+	if journal == nil || journal.Phase == entity.PhaseDone {
+		return nil
+	}
+	j.journal = journal
+	j.logger.WithFields(interfaces.LoggerFields{
+		"version": journal.VersionId,
+		"phase":   journal.Phase,
+		"file":    journal.File,
+		"offset":  journal.Offset,
+	}).Info("Resume import")
+
+	return journal
+}
+
+// Получить текущий журнал импорта
+func (j *JournalService) GetJournal() *entity.Journal {
+	return j.journal
+}
+
+// Начать импорт версии, продолжает журнал, если версия совпадает
+func (j *JournalService) Start(versionId int, isFull bool, begin time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.journal != nil && j.journal.VersionId == versionId && j.journal.Phase != entity.PhaseDone {
+		return
+	}
+	j.journal = &entity.Journal{
+		VersionId: versionId,
+		IsFull:    isFull,
+		Phase:     entity.PhaseDownload,
+		Files:     make(map[string]int),
+		Begin:     begin.Format(util.TimeFormat),
+	}
+	j.save()
+}
+
+// Установить этап импорта
+func (j *JournalService) SetPhase(phase string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.journal == nil {
+		return
+	}
+	j.journal.Phase = phase
+	j.save()
+}
+
+// Получить количество обработанных элементов файла
+func (j *JournalService) GetFileOffset(file string) int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.journal == nil {
+		return 0
+	}
+
+	return j.journal.Files[file]
+}
+
+// Проверить, обработан ли файл полностью
+func (j *JournalService) IsFileFinished(file string) bool {
+	return j.GetFileOffset(file) == entity.FileFinished
+}
+
+// Получить функцию сохранения контрольной точки файла
+func (j *JournalService) GetCheckpoint(file string) util.Checkpoint {
+	return func(offset int) {
+		j.setFileOffset(file, offset)
+	}
+}
+
+// Отметить файл как полностью обработанный
+func (j *JournalService) FinishFile(file string) {
+	j.setFileOffset(file, entity.FileFinished)
+}
+
+// Завершить импорт
+func (j *JournalService) Finish() {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.journal == nil {
+		return
+	}
+	j.journal.Phase = entity.PhaseDone
+	j.save()
+	j.journal = nil
+}
+
+// Сохранить количество обработанных элементов файла
+func (j *JournalService) setFileOffset(file string, offset int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.journal == nil {
+		return
+	}
+	j.journal.File = file
+	j.journal.Offset = offset
+	j.journal.Files[file] = offset
+	j.save()
+}
+
+// Сохранить журнал в БД
+func (j *JournalService) save() {
+	j.journal.UpdatedAt = time.Now().Format(util.TimeFormat)
+	err := j.journalRepo.SetJournal(j.journal)
+	j.checkFatalError(err)
+}
+
+// Проверяет наличие ошибки и логирует ее
+func (j *JournalService) checkFatalError(err error) {
+	if err != nil {
+		j.logger.Fatal(err.Error())
+		os.Exit(1)
+	}
+}
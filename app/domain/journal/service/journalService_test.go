@@ -0,0 +1,174 @@
+package service
+
+import (
+	"github.com/GarinAG/gofias/domain/journal/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/tamerh/xml-stream-parser"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Репозиторий журнала в памяти, хранит копию сохраненного журнала
+type testJournalRepo struct {
+	journal *entity.Journal
+}
+
+func (r *testJournalRepo) Init() error  { return nil }
+func (r *testJournalRepo) Clear() error { r.journal = nil; return nil }
+func (r *testJournalRepo) GetJournal() (*entity.Journal, error) {
+	if r.journal == nil {
+		return nil, nil
+	}
+
+	return copyJournal(r.journal), nil
+}
+func (r *testJournalRepo) SetJournal(journal *entity.Journal) error {
+	r.journal = copyJournal(journal)
+
+	return nil
+}
+
+// Копия журнала, не связанная с исходным объектом
+func copyJournal(journal *entity.Journal) *entity.Journal {
+	result := *journal
+	result.Files = make(map[string]int)
+	for file, offset := range journal.Files {
+		result.Files[file] = offset
+	}
+
+	return &result
+}
+
+// XML-файл с элементами ITEM, пронумерованными с единицы
+func testXml(count int) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?><ITEMS>`)
+	for i := 1; i <= count; i++ {
+		b.WriteString(`<ITEM ID="` + strconv.Itoa(i) + `" />`)
+	}
+	b.WriteString(`</ITEMS>`)
+
+	return b.String()
+}
+
+// Разбор элемента в номер
+func testParseElement(element *xmlparser.XMLElement) (interface{}, error) {
+	return strconv.Atoi(element.Attrs["ID"])
+}
+
+// Импорт файла с остановкой после stopAfter элементов, возвращает сохраненные элементы
+func testImport(t *testing.T, journal *JournalService, repo *testJournalRepo, file string, count int, stopAfter int) []int {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	channel := make(chan interface{})
+	go util.ParseFile(&wg, strings.NewReader(testXml(count)), file, channel, testLogger{}, testParseElement, "ITEM", -1, journal.GetFileOffset(file), journal.GetCheckpoint(file))
+
+	start := journal.GetFileOffset(file)
+	var saved []int
+	var batch []int
+	stopped := false
+	for d := range channel {
+		if stopped {
+			continue
+		}
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			// Контрольная точка не сохраняется в БД до фиксации
+			if repo.journal.Files[file] >= checkpoint.Offset {
+				t.Errorf("checkpoint %d is saved before commit", checkpoint.Offset)
+			}
+			saved = append(saved, batch...)
+			batch = nil
+			checkpoint.Commit()
+			// Смещение в БД соответствует количеству сохраненных элементов
+			if offset := repo.journal.Files[file]; offset != checkpoint.Offset || offset != start+len(saved) {
+				t.Errorf("got journal offset %d after %d saved items, want %d", offset, start+len(saved), checkpoint.Offset)
+			}
+			continue
+		}
+		batch = append(batch, d.(int))
+		// Прерывает импорт, несохраненные элементы теряются
+		if stopAfter > 0 && batch[len(batch)-1] == stopAfter {
+			stopped = true
+			batch = nil
+		}
+	}
+	wg.Wait()
+	if !stopped {
+		saved = append(saved, batch...)
+		journal.FinishFile(file)
+	}
+
+	return saved
+}
+
+// Прерванный импорт продолжается с последней зафиксированной контрольной точки без потерь и повторов
+func TestJournalCheckpoints(t *testing.T) {
+	tests := []struct {
+		name      string
+		count     int
+		stopAfter int
+		offset    int
+	}{
+		{"stop between checkpoints", 25000, 15000, 10000},
+		{"stop before first checkpoint", 25000, 5000, 0},
+		{"stop after last checkpoint", 25000, 24999, 20000},
+	}
+	file := "AS_ADDROBJ.XML"
+	for _, test := range tests {
+		repo := &testJournalRepo{}
+		journal := NewJournalService(repo, testLogger{})
+		journal.Start(1, true, time.Now())
+		journal.SetPhase(entity.PhaseParse)
+		saved := testImport(t, journal, repo, file, test.count, test.stopAfter)
+		if journal.IsFileFinished(file) {
+			t.Errorf("%s: interrupted file is finished", test.name)
+		}
+
+		// Новый запуск загружает журнал из БД и продолжает разбор
+		resumed := NewJournalService(repo, testLogger{})
+		if resumed.Load() == nil {
+			t.Fatalf("%s: journal is not loaded", test.name)
+		}
+		if offset := resumed.GetFileOffset(file); offset != test.offset || offset != len(saved) {
+			t.Errorf("%s: got offset %d with %d saved items, want %d", test.name, offset, len(saved), test.offset)
+		}
+		resumed.Start(1, true, time.Now())
+		saved = append(saved, testImport(t, resumed, repo, file, test.count, 0)...)
+		if len(saved) != test.count {
+			t.Errorf("%s: got %d items, want %d", test.name, len(saved), test.count)
+		}
+		for i, item := range saved {
+			if item != i+1 {
+				t.Errorf("%s: got item %d at position %d", test.name, item, i)
+				break
+			}
+		}
+		if !resumed.IsFileFinished(file) {
+			t.Errorf("%s: file is not finished", test.name)
+		}
+
+		// Завершенный журнал не продолжается
+		resumed.Finish()
+		if NewJournalService(repo, testLogger{}).Load() != nil {
+			t.Errorf("%s: finished journal is loaded", test.name)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/paulmach/osm"
+)
+
+// Граница административного объекта из OSM-файла
+type Boundary struct {
+	FeatureID  osm.FeatureID       // Идентификатор отношения OSM
+	Name       string              // Название
+	AdminLevel int                 // Административный уровень (admin_level)
+	Polygons   entity.MultiPolygon // Контуры границы
+}
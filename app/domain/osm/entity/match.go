@@ -0,0 +1,30 @@
+package entity
+
+// Статусы сопоставления объекта OSM с объектом ФИАС
+const (
+	MatchStatusMatched   = "matched"   // Найден единственный подходящий объект
+	MatchStatusAmbiguous = "ambiguous" // Найдено несколько объектов с близкой оценкой
+	MatchStatusUnmatched = "unmatched" // Подходящий объект не найден
+)
+
+// Кандидат сопоставления
+type MatchCandidate struct {
+	AoGuid      string  `json:"ao_guid"`            // Идентификатор объекта ФИАС
+	AoLevel     int     `json:"ao_level"`           // Уровень объекта ФИАС
+	FullAddress string  `json:"full_address"`       // Полный адрес объекта ФИАС
+	Score       float64 `json:"score"`              // Итоговая оценка
+	Distance    float64 `json:"distance,omitempty"` // Расстояние до объекта ФИАС в метрах, если известны его координаты
+}
+
+// Результат сопоставления объекта OSM с объектом ФИАС
+type MatchResult struct {
+	Status     string           `json:"status"`               // Статус сопоставления
+	Reason     string           `json:"reason,omitempty"`     // Причина, по которой объект не сопоставлен
+	OsmId      string           `json:"osm_id"`               // Идентификатор объекта OSM
+	Name       string           `json:"name"`                 // Полное название объекта OSM
+	Place      string           `json:"place,omitempty"`      // Тип населенного пункта из тега place
+	Lat        float64          `json:"lat"`                  // Широта
+	Lon        float64          `json:"lon"`                  // Долгота
+	AoGuid     string           `json:"ao_guid,omitempty"`    // Идентификатор сопоставленного объекта ФИАС
+	Candidates []MatchCandidate `json:"candidates,omitempty"` // Кандидаты в порядке убывания оценки
+}
@@ -10,5 +10,11 @@ type Node struct {
 	Lat          float64
 	Lon          float64
 	PostalCode   string
-	Node         *osm.Node
+	Population   int
+	FeatureID    osm.FeatureID // Идентификатор исходного объекта OSM: точки, линии или отношения
+	Place        string        // Тип населенного пункта из тега place
+	PlaceName    string        // Название населенного пункта без родительских объектов
+	Parents      []string      // Названия родительских объектов из тегов addr:region, addr:district и addr:city
+	HouseNumber  string        // Номер дома из тега addr:housenumber
+	Street       string        // Название улицы дома из тега addr:street
 }
@@ -0,0 +1,114 @@
+package service
+
+import (
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/osm/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Количество границ, сохраняемых за один раз
+const boundaryBatchSize = 100
+
+// Уровни адресов ФИАС, соответствующие административным уровням границ OSM
+var boundaryAoLevels = map[int][]int{
+	4:  {1},    // Субъект РФ
+	5:  {1, 2}, // Автономный округ
+	6:  {3, 4}, // Муниципальный район или городской округ
+	8:  {4, 6}, // Городское или сельское поселение
+	9:  {5},    // Внутригородской район
+	10: {6},    // Населенный пункт
+}
+
+// Типы муниципальных образований, удаляемые из названия границы перед поиском
+var boundaryNameTypes = []string{
+	"городской округ",
+	"муниципальный район",
+	"муниципальный округ",
+	"городское поселение",
+	"сельское поселение",
+}
+
+// Получить административный уровень границы, 0 - если отношение не является поддерживаемой границей
+func (o *OsmService) getBoundaryAdminLevel(tags map[string]string) int {
+	if o.getTagByName(tags, "boundary") != "administrative" || o.getTagByName(tags, "name") == "" {
+		return 0
+	}
+	level, err := strconv.Atoi(o.getTagByName(tags, "admin_level"))
+	if err != nil {
+		return 0
+	}
+	if _, ok := boundaryAoLevels[level]; !ok {
+		return 0
+	}
+
+	return level
+}
+
+// Обновляет границы административных объектов
+func (o *OsmService) updateBoundaries(wg *sync.WaitGroup, boundaryChan <-chan *entity.Boundary) {
+	defer wg.Done()
+	var items []addressEntity.Boundary
+	matched, unmatched := 0, 0
+	for boundary := range boundaryChan {
+		address := o.matchBoundary(boundary)
+		if address == nil {
+			unmatched++
+			continue
+		}
+		matched++
+		items = append(items, addressEntity.Boundary{
+			ID:         boundary.FeatureID.String(),
+			AoGuid:     address.AoGuid,
+			AoLevel:    address.AoLevel,
+			AdminLevel: boundary.AdminLevel,
+			Name:       boundary.Name,
+			Polygons:   boundary.Polygons,
+		})
+		if len(items) >= boundaryBatchSize {
+			o.saveBoundaries(items)
+			items = nil
+		}
+	}
+	o.saveBoundaries(items)
+	o.logger.WithFields(interfaces.LoggerFields{"matched": matched, "unmatched": unmatched}).Info("OSM boundaries updated")
+}
+
+// Сохраняет границы
+func (o *OsmService) saveBoundaries(items []addressEntity.Boundary) {
+	if err := o.boundaryRepo.Save(items); err != nil {
+		o.logger.Error(err.Error())
+	}
+}
+
+// Найти объект ФИАС границы по названию среди объектов соответствующих уровней.
+// Подходит объект, координаты которого попадают в границу, либо единственный найденный объект без координат
+func (o *OsmService) matchBoundary(boundary *entity.Boundary) *addressEntity.AddressObject {
+	name := boundary.Name
+	for _, nameType := range boundaryNameTypes {
+		name = strings.Replace(name, nameType, "", 1)
+	}
+	filter := addressEntity.FilterObject{}
+	for _, level := range boundaryAoLevels[boundary.AdminLevel] {
+		filter.Level.Values = append(filter.Level.Values, float32(level))
+	}
+	candidates, err := o.addressRepo.GetAddressByTerm(strings.TrimSpace(util.Replace(name)), 10, 0, filter)
+	if err != nil {
+		o.logger.Error(err.Error())
+		return nil
+	}
+
+	for _, candidate := range candidates {
+		if lat, lon, ok := util.ParseLocation(candidate.Location); ok && boundary.Polygons.Contains(lat, lon) {
+			return candidate
+		}
+	}
+	if len(candidates) == 1 && candidates[0].Location == "" {
+		return candidates[0]
+	}
+
+	return nil
+}
@@ -0,0 +1,413 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/osm/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Веса составляющих оценки сопоставления населенного пункта OSM с объектом ФИАС
+const (
+	matchNameWeight     = 0.4 // Совпадение названия
+	matchTypeWeight     = 0.1 // Соответствие уровня объекта ФИАС типу населенного пункта
+	matchParentsWeight  = 0.3 // Совпадение родительских объектов
+	matchDistanceWeight = 0.2 // Близость к известным координатам объекта ФИАС
+)
+
+const (
+	matchCandidatesSize   = 10      // Количество кандидатов, запрашиваемых из БД
+	matchReportCandidates = 3       // Количество кандидатов, сохраняемых в отчете
+	matchAmbiguityMargin  = 0.1     // Разница оценок, при которой кандидаты считаются неразличимыми
+	matchNearDistance     = 10000.0 // Расстояние в метрах, на котором координаты считаются совпадающими
+	matchFarDistance      = 50000.0 // Расстояние в метрах, начиная с которого координаты считаются несовпадающими
+)
+
+// Уровни адресов ФИАС, соответствующие типам населенных пунктов OSM
+var placeAoLevels = map[string][]int{
+	"city":              {1, 4},
+	"town":              {4, 6},
+	"village":           {6},
+	"hamlet":            {6},
+	"isolated_dwelling": {6},
+	"locality":          {6},
+	"suburb":            {5, 6, 65},
+	"quarter":           {5, 65},
+	"neighbourhood":     {5, 65},
+}
+
+// Слова, не учитываемые при сравнении названий родительских объектов
+var matchStopWords = []string{
+	"область", "обл", "край", "республика", "респ", "автономный", "округ", "ао",
+	"район", "р-н", "городской", "муниципальный", "город", "г", "поселение",
+}
+
+// Типы населенных пунктов, не учитываемые при сравнении названий
+var matchPlaceTypeWords = []string{
+	"город", "г", "поселок", "пос", "п", "пгт", "рп", "село", "с", "деревня", "д",
+	"станица", "ст-ца", "хутор", "х", "аул", "слобода", "сл", "местечко", "м",
+}
+
+// Отчет сопоставления населенных пунктов OSM с объектами ФИАС в формате JSON Lines
+type osmMatchReport struct {
+	path    string         // Путь к файлу отчета
+	file    *os.File       // Файл отчета, отсутствует при ошибке создания
+	encoder *json.Encoder  // Кодировщик записей отчета
+	counts  map[string]int // Количество объектов по статусам сопоставления
+}
+
+// Создать файл отчета сопоставления в каталоге из конфигурации
+func (o *OsmService) createMatchReport() *osmMatchReport {
+	report := &osmMatchReport{counts: make(map[string]int)}
+	dir := o.config.GetConfig().Osm.ReportPath
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		o.logger.Error(err.Error())
+		return report
+	}
+	report.path = filepath.Join(dir, "osm_match_"+time.Now().Format("20060102_150405")+".jsonl")
+	file, err := os.Create(report.path)
+	if err != nil {
+		o.logger.Error(err.Error())
+		return report
+	}
+	report.file = file
+	report.encoder = json.NewEncoder(file)
+
+	return report
+}
+
+// Добавить результат сопоставления в отчет
+func (r *osmMatchReport) add(result entity.MatchResult) error {
+	r.counts[result.Status]++
+	if r.encoder == nil {
+		return nil
+	}
+
+	return r.encoder.Encode(result)
+}
+
+// Закрыть файл отчета
+func (r *osmMatchReport) close() error {
+	if r.file == nil {
+		return nil
+	}
+
+	return r.file.Close()
+}
+
+// Закрыть отчет сопоставления и вывести итоги в лог
+func (o *OsmService) closeMatchReport(report *osmMatchReport) {
+	if err := report.close(); err != nil {
+		o.logger.Error(err.Error())
+	}
+	o.logger.WithFields(interfaces.LoggerFields{
+		"matched":   report.counts[entity.MatchStatusMatched],
+		"ambiguous": report.counts[entity.MatchStatusAmbiguous],
+		"unmatched": report.counts[entity.MatchStatusUnmatched],
+		"report":    report.path,
+	}).Info("OSM places matched")
+}
+
+// Сопоставить населенный пункт OSM с объектом ФИАС.
+// Сначала кандидаты ищутся по полному названию, а если ни один не набрал пороговую оценку -
+// по названию населенного пункта среди объектов соответствующих уровней.
+// В parents кэшируются родительские объекты кандидатов между вызовами
+func (o *OsmService) matchPlace(node *entity.Node, parents map[string]*addressEntity.AddressObject) (*addressEntity.AddressObject, entity.MatchResult) {
+	result := entity.MatchResult{
+		OsmId: node.FeatureID.String(),
+		Name:  node.Name,
+		Place: node.Place,
+		Lat:   node.Lat,
+		Lon:   node.Lon,
+	}
+	threshold := o.config.GetConfig().Osm.MatchThreshold
+	objects := make(map[string]*addressEntity.AddressObject)
+
+	o.addMatchCandidates(node, &result, objects, parents, node.Name)
+	if node.PlaceName != "" && (len(result.Candidates) == 0 || result.Candidates[0].Score < threshold) {
+		filter := addressEntity.FilterObject{}
+		for _, level := range placeAoLevels[node.Place] {
+			filter.Level.Values = append(filter.Level.Values, float32(level))
+		}
+		o.addMatchCandidates(node, &result, objects, parents, strings.TrimSpace(util.Replace(node.PlaceName)), filter)
+	}
+
+	var matched *addressEntity.AddressObject
+	switch {
+	case len(result.Candidates) == 0:
+		result.Status = entity.MatchStatusUnmatched
+		result.Reason = "no candidates found"
+	case result.Candidates[0].Score < threshold:
+		result.Status = entity.MatchStatusUnmatched
+		result.Reason = fmt.Sprintf("best score %.3f is below threshold %.3f", result.Candidates[0].Score, threshold)
+	case len(result.Candidates) > 1 && result.Candidates[0].Score-result.Candidates[1].Score < matchAmbiguityMargin:
+		result.Status = entity.MatchStatusAmbiguous
+		result.Reason = fmt.Sprintf("scores of the best candidates differ by less than %.2f", matchAmbiguityMargin)
+	default:
+		result.Status = entity.MatchStatusMatched
+		result.AoGuid = result.Candidates[0].AoGuid
+		matched = objects[result.AoGuid]
+	}
+	if len(result.Candidates) > matchReportCandidates {
+		result.Candidates = result.Candidates[:matchReportCandidates]
+	}
+
+	return matched, result
+}
+
+// Найти кандидатов по строке и добавить их оценки в результат сопоставления
+func (o *OsmService) addMatchCandidates(node *entity.Node, result *entity.MatchResult, objects map[string]*addressEntity.AddressObject, parents map[string]*addressEntity.AddressObject, term string, filter ...addressEntity.FilterObject) {
+	items, err := o.addressRepo.GetAddressByTerm(term, matchCandidatesSize, 0, filter...)
+	if err != nil {
+		o.logger.Error(err.Error())
+		return
+	}
+	if len(node.Parents) > 0 {
+		o.loadMatchParents(items, parents)
+	}
+	for _, item := range items {
+		if _, ok := objects[item.AoGuid]; ok {
+			continue
+		}
+		objects[item.AoGuid] = item
+		result.Candidates = append(result.Candidates, o.scoreCandidate(node, item, parents))
+	}
+	sort.SliceStable(result.Candidates, func(i, j int) bool {
+		return result.Candidates[i].Score > result.Candidates[j].Score
+	})
+}
+
+// Оценить соответствие объекта ФИАС населенному пункту OSM.
+// Оценка - средневзвешенное значение составляющих, которые удалось проверить, от 0 до 1
+func (o *OsmService) scoreCandidate(node *entity.Node, item *addressEntity.AddressObject, parents map[string]*addressEntity.AddressObject) entity.MatchCandidate {
+	candidate := entity.MatchCandidate{
+		AoGuid:      item.AoGuid,
+		AoLevel:     item.AoLevel,
+		FullAddress: item.FullAddress,
+	}
+	name := node.PlaceName
+	if name == "" {
+		name = node.Name
+	}
+	score := matchNameWeight * matchNameScore(name, item.FormalName)
+	weight := matchNameWeight
+	if levels, ok := placeAoLevels[node.Place]; ok {
+		weight += matchTypeWeight
+		for _, level := range levels {
+			if level == item.AoLevel {
+				score += matchTypeWeight
+				break
+			}
+		}
+	}
+	if len(node.Parents) > 0 {
+		weight += matchParentsWeight
+		score += matchParentsWeight * matchParentsScore(node.Parents, getMatchParentNames(item, parents))
+	}
+	if lat, lon, ok := util.ParseLocation(item.Location); ok {
+		candidate.Distance = math.Round(util.GeoDistance(node.Lat, node.Lon, lat, lon))
+		weight += matchDistanceWeight
+		score += matchDistanceWeight * matchDistanceScore(candidate.Distance)
+	}
+	candidate.Score = math.Round(score/weight*1000) / 1000
+
+	return candidate
+}
+
+// Оценить совпадение названий: 1 при полном совпадении, иначе доля общих слов
+func matchNameScore(osmName string, fiasName string) float64 {
+	osmWords, fiasWords := matchNameWords(osmName), matchNameWords(fiasName)
+	if len(osmWords) == 0 || len(fiasWords) == 0 {
+		return 0
+	}
+	if strings.Join(osmWords, " ") == strings.Join(fiasWords, " ") {
+		return 1
+	}
+	common := 0
+	for _, word := range util.UniqueStringSlice(osmWords) {
+		if util.ContainsString(fiasWords, word) {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(util.UniqueStringSlice(append(osmWords, fiasWords...))))
+}
+
+// Загрузить в кэш недостающие родительские объекты кандидатов вверх по иерархии.
+// Не найденные в БД объекты сохраняются как nil, чтобы не запрашивать их повторно
+func (o *OsmService) loadMatchParents(items []*addressEntity.AddressObject, parents map[string]*addressEntity.AddressObject) {
+	for len(items) > 0 {
+		var guids []string
+		for _, item := range items {
+			if _, ok := parents[item.ParentGuid]; item.ParentGuid != "" && !ok && !util.ContainsString(guids, item.ParentGuid) {
+				guids = append(guids, item.ParentGuid)
+			}
+		}
+		if len(guids) == 0 {
+			return
+		}
+		list, err := o.addressRepo.GetAddressByGuidList(guids)
+		if err != nil {
+			o.logger.Error(err.Error())
+			return
+		}
+		for _, guid := range guids {
+			parents[guid] = nil
+		}
+		for _, parent := range list {
+			parents[parent.AoGuid] = parent
+		}
+		items = list
+	}
+}
+
+// Получить названия объекта ФИАС и всех его родителей из кэша
+func getMatchParentNames(item *addressEntity.AddressObject, parents map[string]*addressEntity.AddressObject) string {
+	names := []string{item.FullAddress}
+	for parent := parents[item.ParentGuid]; parent != nil && len(names) <= len(parents); parent = parents[parent.ParentGuid] {
+		names = append(names, parent.FormalName)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Оценить совпадение родителей: доля родительских объектов OSM, названия которых есть в адресе объекта ФИАС
+func matchParentsScore(parents []string, address string) float64 {
+	addressWords := matchWords(address)
+	found := 0
+	for _, parent := range parents {
+		for _, word := range matchWords(parent) {
+			if !util.ContainsString(matchStopWords, word) && util.ContainsString(addressWords, word) {
+				found++
+				break
+			}
+		}
+	}
+
+	return float64(found) / float64(len(parents))
+}
+
+// Оценить согласованность координат: 1 на близком расстоянии, 0 на дальнем, между ними - линейно
+func matchDistanceScore(distance float64) float64 {
+	if distance <= matchNearDistance {
+		return 1
+	}
+	if distance >= matchFarDistance {
+		return 0
+	}
+
+	return (matchFarDistance - distance) / (matchFarDistance - matchNearDistance)
+}
+
+// Выбрать среди найденных домов первый дом с номером и улицей из тегов OSM
+func (o *OsmService) matchHouse(node *entity.Node, items []*addressEntity.HouseObject) *addressEntity.HouseObject {
+	var houses []*addressEntity.HouseObject
+	var guids []string
+	for _, item := range items {
+		if matchHouseNumber(node.HouseNumber, item) {
+			houses = append(houses, item)
+			guids = append(guids, item.AoGuid)
+		}
+	}
+	if len(houses) == 0 {
+		return nil
+	}
+
+	// Получает улицы домов с подходящим номером
+	streets, err := o.addressRepo.GetAddressByGuidList(util.UniqueStringSlice(guids))
+	if err != nil {
+		o.logger.Error(err.Error())
+		return nil
+	}
+	names := make(map[string]string)
+	for _, street := range streets {
+		names[street.AoGuid] = street.FormalName
+	}
+	for _, item := range houses {
+		if name, ok := names[item.AoGuid]; ok && matchStreetName(node.Street, name) {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// Номер дома OSM: номер, корпус и строение
+var houseNumberRegexp = regexp.MustCompile(`^(.+?)(?:\s*(?:корпус|корп|к)\.?\s*([0-9а-я]+))?(?:\s*(?:строение|стр|с)\.?\s*([0-9а-я]+))?$`)
+
+// Разобрать номер дома OSM на номер дома, корпус и строение
+func parseHouseNumber(value string) (string, string, string) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	parts := houseNumberRegexp.FindStringSubmatch(value)
+	if parts == nil {
+		return normalizeHouseNumber(value), "", ""
+	}
+
+	return normalizeHouseNumber(parts[1]), normalizeHouseNumber(parts[2]), normalizeHouseNumber(parts[3])
+}
+
+// Привести часть номера дома к нижнему регистру без пробелов
+func normalizeHouseNumber(value string) string {
+	return strings.ReplaceAll(strings.ToLower(value), " ", "")
+}
+
+// Проверить совпадение номера дома OSM с номером, корпусом и строением дома ФИАС
+func matchHouseNumber(number string, item *addressEntity.HouseObject) bool {
+	house, build, structure := parseHouseNumber(number)
+
+	return house != "" &&
+		house == normalizeHouseNumber(item.HouseNum) &&
+		build == normalizeHouseNumber(item.BuildNum) &&
+		structure == normalizeHouseNumber(item.StructNum)
+}
+
+// Проверить, что все слова названия улицы ФИАС есть в названии улицы OSM
+func matchStreetName(osmStreet string, fiasName string) bool {
+	osmWords, fiasWords := matchWords(osmStreet), matchWords(fiasName)
+	if len(fiasWords) == 0 {
+		return false
+	}
+	for _, word := range fiasWords {
+		if !util.ContainsString(osmWords, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Разбить название населенного пункта на слова без типа населенного пункта.
+// Название, состоящее только из типа, например Хутор, сравнивается целиком
+func matchNameWords(value string) []string {
+	words := matchWords(value)
+	var result []string
+	for _, word := range words {
+		if !util.ContainsString(matchPlaceTypeWords, word) {
+			result = append(result, word)
+		}
+	}
+	if len(result) == 0 {
+		return words
+	}
+
+	return result
+}
+
+// Разбить название на слова в нижнем регистре
+func matchWords(value string) []string {
+	value = strings.ToLower(util.Replace(value))
+
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	})
+}
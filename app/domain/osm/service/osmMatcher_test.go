@@ -0,0 +1,224 @@
+package service
+
+import (
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/domain/osm/entity"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/paulmach/osm"
+	"math"
+	"testing"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Конфигурация с порогом сопоставления по умолчанию
+type testConfig struct{}
+
+func (c testConfig) Init() error                                   { return nil }
+func (c testConfig) GetString(key string, def ...string) string    { return "" }
+func (c testConfig) GetBool(key string) bool                       { return false }
+func (c testConfig) GetInt(key string, def ...int) int             { return 0 }
+func (c testConfig) GetFloat64(key string, def ...float64) float64 { return 0 }
+func (c testConfig) GetConfig() interfaces.BaseConfig {
+	return interfaces.BaseConfig{Osm: interfaces.OsmConfig{MatchThreshold: 0.75}}
+}
+
+// Репозиторий адресов, возвращающий одни и те же объекты на любой поисковый запрос
+type testAddressRepo struct {
+	repository.AddressRepositoryInterface
+	items []*addressEntity.AddressObject
+}
+
+func (r *testAddressRepo) GetAddressByTerm(term string, size int64, from int64, filter ...addressEntity.FilterObject) ([]*addressEntity.AddressObject, error) {
+	var items []*addressEntity.AddressObject
+	for _, item := range r.items {
+		if item.AoLevel > 1 {
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func (r *testAddressRepo) GetAddressByGuidList(guids []string) ([]*addressEntity.AddressObject, error) {
+	var items []*addressEntity.AddressObject
+	for _, item := range r.items {
+		for _, guid := range guids {
+			if item.AoGuid == guid {
+				items = append(items, item)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// Объект ФИАС для сопоставления
+func testAddress(guid string, parentGuid string, level int, name string, fullAddress string) *addressEntity.AddressObject {
+	return &addressEntity.AddressObject{AoGuid: guid, ParentGuid: parentGuid, AoLevel: level, FormalName: name, FullAddress: fullAddress}
+}
+
+var testRegion = testAddress("r1", "", 1, "Новосибирская", "Новосибирская обл")
+
+// Номер дома OSM сравнивается с номером, корпусом и строением дома ФИАС
+func TestMatchHouseNumber(t *testing.T) {
+	tests := []struct {
+		number string
+		house  addressEntity.HouseObject
+		match  bool
+	}{
+		{"12", addressEntity.HouseObject{HouseNum: "12"}, true},
+		{"12А", addressEntity.HouseObject{HouseNum: "12а"}, true},
+		{"12 а", addressEntity.HouseObject{HouseNum: "12А"}, true},
+		{"12/1", addressEntity.HouseObject{HouseNum: "12/1"}, true},
+		{"12 к1", addressEntity.HouseObject{HouseNum: "12", BuildNum: "1"}, true},
+		{"12 корп. 2", addressEntity.HouseObject{HouseNum: "12", BuildNum: "2"}, true},
+		{"12с3", addressEntity.HouseObject{HouseNum: "12", StructNum: "3"}, true},
+		{"12 к1 стр 2", addressEntity.HouseObject{HouseNum: "12", BuildNum: "1", StructNum: "2"}, true},
+		{"12", addressEntity.HouseObject{HouseNum: "12", BuildNum: "1"}, false},
+		{"12 к1", addressEntity.HouseObject{HouseNum: "12"}, false},
+		{"12 к1", addressEntity.HouseObject{HouseNum: "12", StructNum: "1"}, false},
+		{"12", addressEntity.HouseObject{HouseNum: "120"}, false},
+		{"12", addressEntity.HouseObject{HouseNum: "12а"}, false},
+		{"", addressEntity.HouseObject{}, false},
+	}
+	for _, test := range tests {
+		house := test.house
+		if match := matchHouseNumber(test.number, &house); match != test.match {
+			t.Errorf("matchHouseNumber(%q, %q/%q/%q) = %v, want %v", test.number, house.HouseNum, house.BuildNum, house.StructNum, match, test.match)
+		}
+	}
+}
+
+// Все слова названия улицы ФИАС должны быть в названии улицы OSM
+func TestMatchStreetName(t *testing.T) {
+	tests := []struct {
+		osmStreet string
+		fiasName  string
+		match     bool
+	}{
+		{"улица Ленина", "Ленина", true},
+		{"Ленина улица", "Ленина", true},
+		{"проспект Карла Маркса", "Карла Маркса", true},
+		{"улица Королёва", "Королева", true},
+		{"улица Ленина", "Мира", false},
+		{"улица Маркса", "Карла Маркса", false},
+		{"улица Ленина", "", false},
+	}
+	for _, test := range tests {
+		if match := matchStreetName(test.osmStreet, test.fiasName); match != test.match {
+			t.Errorf("matchStreetName(%q, %q) = %v, want %v", test.osmStreet, test.fiasName, match, test.match)
+		}
+	}
+}
+
+// Название населенного пункта сравнивается без типа из official_status и слов типа населенного пункта
+func TestMatchNameScore(t *testing.T) {
+	tests := []struct {
+		osmName  string
+		fiasName string
+		score    float64
+	}{
+		{"Новосибирск", "Новосибирск", 1},
+		{"город Новосибирск", "Новосибирск", 1},
+		{"посёлок Кольцово", "Кольцово", 1},
+		{"Хутор", "Хутор", 1},
+		{"Нижний Новгород", "Новгород", 0.5},
+		{"Новосибирск", "Бердск", 0},
+		{"", "Бердск", 0},
+	}
+	for _, test := range tests {
+		if score := matchNameScore(test.osmName, test.fiasName); math.Abs(score-test.score) > 1e-9 {
+			t.Errorf("matchNameScore(%q, %q) = %v, want %v", test.osmName, test.fiasName, score, test.score)
+		}
+	}
+}
+
+// Населенные пункты с типом в official_status сопоставляются с объектами ФИАС по названию без типа
+func TestMatchPlace(t *testing.T) {
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		candidates []*addressEntity.AddressObject
+		status     string
+		aoGuid     string
+		score      float64
+	}{
+		{
+			name:       "city with official status",
+			tags:       map[string]string{"place": "city", "name": "Новосибирск", "official_status": "ru:город", "addr:region": "Новосибирская область"},
+			candidates: []*addressEntity.AddressObject{testAddress("c1", "r1", 4, "Новосибирск", "Новосибирская обл, г. Новосибирск")},
+			status:     entity.MatchStatusMatched,
+			aoGuid:     "c1",
+			score:      1,
+		},
+		{
+			name: "town among other towns",
+			tags: map[string]string{"place": "town", "name": "Бердск", "official_status": "ru:город", "addr:region": "Новосибирская область"},
+			candidates: []*addressEntity.AddressObject{
+				testAddress("c2", "r1", 4, "Бердск", "Новосибирская обл, г. Бердск"),
+				testAddress("c3", "r1", 4, "Искитим", "Новосибирская обл, г. Искитим"),
+			},
+			status: entity.MatchStatusMatched,
+			aoGuid: "c2",
+			score:  1,
+		},
+		{
+			name:       "village with type in name",
+			tags:       map[string]string{"place": "village", "name": "село Каменка", "official_status": "ru:село", "addr:region": "Новосибирская область"},
+			candidates: []*addressEntity.AddressObject{testAddress("v1", "r1", 6, "Каменка", "Новосибирская обл, с. Каменка")},
+			status:     entity.MatchStatusMatched,
+			aoGuid:     "v1",
+			score:      1,
+		},
+		{
+			name: "same villages",
+			tags: map[string]string{"place": "village", "name": "Каменка", "official_status": "ru:село", "addr:region": "Новосибирская область"},
+			candidates: []*addressEntity.AddressObject{
+				testAddress("v1", "r1", 6, "Каменка", "Новосибирская обл, с. Каменка"),
+				testAddress("v2", "r1", 6, "Каменка", "Новосибирская обл, д. Каменка"),
+			},
+			status: entity.MatchStatusAmbiguous,
+			score:  1,
+		},
+		{
+			name:       "different name",
+			tags:       map[string]string{"place": "town", "name": "Обь", "official_status": "ru:город", "addr:region": "Новосибирская область"},
+			candidates: []*addressEntity.AddressObject{testAddress("c4", "r1", 4, "Обская", "Новосибирская обл, г. Обская")},
+			status:     entity.MatchStatusUnmatched,
+			score:      0.5,
+		},
+	}
+	for _, test := range tests {
+		repo := &testAddressRepo{items: append([]*addressEntity.AddressObject{testRegion}, test.candidates...)}
+		service := NewOsmService(repo, nil, nil, nil, testLogger{}, testConfig{})
+		node := service.prepareItems(test.tags, 55, 83, osm.NodeID(1).FeatureID())
+		if node == nil {
+			t.Errorf("%s: node is not prepared", test.name)
+			continue
+		}
+		item, result := service.matchPlace(node, make(map[string]*addressEntity.AddressObject))
+		if result.Status != test.status || result.AoGuid != test.aoGuid {
+			t.Errorf("%s: got status %q guid %q, want %q %q (%s)", test.name, result.Status, result.AoGuid, test.status, test.aoGuid, result.Reason)
+		}
+		if (item != nil) != (test.aoGuid != "") {
+			t.Errorf("%s: got matched object %v", test.name, item)
+		}
+		if len(result.Candidates) == 0 || math.Abs(result.Candidates[0].Score-test.score) > 1e-9 {
+			t.Errorf("%s: got candidates %+v, want best score %v", test.name, result.Candidates, test.score)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
 	"github.com/GarinAG/gofias/domain/directory/service"
 	"github.com/GarinAG/gofias/domain/osm/entity"
@@ -10,24 +11,28 @@ import (
 	"github.com/GarinAG/gofias/util"
 	"github.com/paulmach/osm"
 	"github.com/paulmach/osm/osmpbf"
+	"io"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 )
 
 // Сервис работы с OSM
 type OsmService struct {
-	addressRepo     repository.AddressRepositoryInterface // Репозиторий адресов
-	houseRepo       repository.HouseRepositoryInterface   // Репозиторий домов
-	logger          interfaces.LoggerInterface            // Логгер
-	downloadService *service.DownloadService              // Сервис управления загрузкой файлов
-	config          interfaces.ConfigInterface            // Конфигурация
+	addressRepo     repository.AddressRepositoryInterface  // Репозиторий адресов
+	houseRepo       repository.HouseRepositoryInterface    // Репозиторий домов
+	boundaryRepo    repository.BoundaryRepositoryInterface // Репозиторий границ административных объектов
+	logger          interfaces.LoggerInterface             // Логгер
+	downloadService *service.DownloadService               // Сервис управления загрузкой файлов
+	config          interfaces.ConfigInterface             // Конфигурация
 }
 
 // Инициализация сервиса
 func NewOsmService(
 	addressRepo repository.AddressRepositoryInterface,
 	houseRepo repository.HouseRepositoryInterface,
+	boundaryRepo repository.BoundaryRepositoryInterface,
 	downloadService *service.DownloadService,
 	logger interfaces.LoggerInterface,
 	config interfaces.ConfigInterface,
@@ -35,6 +40,7 @@ func NewOsmService(
 	return &OsmService{
 		addressRepo:     addressRepo,
 		houseRepo:       houseRepo,
+		boundaryRepo:    boundaryRepo,
 		logger:          logger,
 		downloadService: downloadService,
 		config:          config,
@@ -61,23 +67,41 @@ func (o *OsmService) parseFile(filepath string) {
 	o.checkFatalError(err)
 	defer f.Close()
 
-	// Создает объект сканнера
-	scanner := osmpbf.New(context.Background(), f, 3)
-	defer scanner.Close()
-
+	// Создает список условий: наличие названия у населенных пунктов или номера дома у зданий
+	tagList := "name,addr:housenumber"
+	conditions := make(map[string][]string)
+	for _, group := range strings.Split(tagList, ",") {
+		conditions[group] = strings.Split(group, "+")
+	}
 	addressChan := make(chan *entity.Node)
 	housesChan := make(chan *entity.Node)
 	// Проверяет наличие домов в БД
 	housesCnt, _ := o.houseRepo.CountAllData(nil)
-	housesCnt = 0 // TODO Enable houses import
 	if housesCnt == 0 {
 		housesChan = nil
 	}
 
+	boundaryChan := make(chan *entity.Boundary)
+	// Проверяет необходимость загрузки границ административных объектов
+	if !o.config.GetConfig().Osm.Boundaries {
+		boundaryChan = nil
+	} else {
+		o.checkFatalError(o.boundaryRepo.Init())
+	}
+
+	// Собирает линии и отношения, координаты которых вычисляются после чтения точек
+	shapes := o.collectShapes(f, conditions, housesChan != nil, boundaryChan != nil)
+	_, err = f.Seek(0, io.SeekStart)
+	o.checkFatalError(err)
+
+	// Создает объект сканнера
+	scanner := osmpbf.New(context.Background(), f, 3)
+	defer scanner.Close()
+
 	var wg sync.WaitGroup
 	wg.Add(2)
 	// Сканирует файл с данными OSM
-	go o.scan(&wg, scanner, addressChan, housesChan)
+	go o.scan(&wg, scanner, conditions, shapes, addressChan, housesChan, boundaryChan)
 	// Обновляет адреса
 	go o.updateAddresses(&wg, addressChan)
 	// При наличии домов разрешает обновление местоположений
@@ -86,6 +110,11 @@ func (o *OsmService) parseFile(filepath string) {
 		// Обновляет дома
 		go o.updateHouses(&wg, housesChan)
 	}
+	if boundaryChan != nil {
+		wg.Add(1)
+		// Обновляет границы
+		go o.updateBoundaries(&wg, boundaryChan)
+	}
 	wg.Wait()
 	o.downloadService.ClearDirectory()
 	o.logger.Info("OSM parsing finished")
@@ -96,49 +125,56 @@ func (o *OsmService) parseFile(filepath string) {
 }
 
 // Сканирует файл с данными OSM
-func (o *OsmService) scan(wg *sync.WaitGroup, scanner *osmpbf.Scanner, addressChan chan<- *entity.Node, housesChan chan<- *entity.Node) {
+func (o *OsmService) scan(wg *sync.WaitGroup, scanner *osmpbf.Scanner, conditions map[string][]string, shapes *osmShapes, addressChan chan<- *entity.Node, housesChan chan<- *entity.Node, boundaryChan chan<- *entity.Boundary) {
 	defer wg.Done()
-	// Создает список условий, проставляет обязательное условие - наличие названия
-	tagList := "name"
-	conditions := make(map[string][]string)
-	for _, group := range strings.Split(tagList, ",") {
-		conditions[group] = strings.Split(group, "+")
-	}
-
 	bar := util.StartNewProgress(-1, "Import OSM", false)
+	// Отправляет объект на обновление адресов или домов
+	send := func(node *entity.Node) {
+		bar.Increment()
+		switch node.Type {
+		case "place": // Объект является адресом
+			addressChan <- node
+		case "building": // Объект является домом
+			if housesChan != nil {
+				housesChan <- node
+			}
+		}
+	}
 
 	for scanner.Scan() {
-		switch e := scanner.Object().(type) {
-		// Элемент является объектом
-		case *osm.Node:
-			if e.Tags != nil {
-				// Проверяет условия
-				if o.hasTags(e.TagMap()) && o.containsValidTags(e.TagMap(), conditions) {
-					// Проверяет и разбирает объект
-					node := o.prepareItems(e)
-					bar.Increment()
-					if node != nil {
-						switch node.Type {
-						case "place": // Объект является адресом
-							addressChan <- node
-						case "building": // Объект является домом
-							if housesChan != nil {
-								housesChan <- node
-							}
-						}
-					}
+		e, ok := scanner.Object().(*osm.Node)
+		// Точки в файле идут перед линиями и отношениями, после них чтение прекращается
+		if !ok {
+			break
+		}
+		shapes.addPoint(e, func(way *osmWay) {
+			shapes.completeWay(way, send)
+		})
+		if e.Tags != nil {
+			tags := e.TagMap()
+			// Проверяет условия
+			if o.hasTags(tags) && o.containsValidTags(tags, conditions) {
+				// Проверяет и разбирает объект
+				if node := o.prepareItems(tags, e.Lat, e.Lon, e.FeatureID()); node != nil {
+					send(node)
 				}
 			}
-			//case *osm.Way:
-			//case *osm.Relation:
 		}
 	}
+	// Разбирает линии и отношения по собранным координатам точек
+	o.prepareShapes(shapes, send, func(boundary *entity.Boundary) {
+		bar.Increment()
+		boundaryChan <- boundary
+	})
 
 	bar.Finish()
 	close(addressChan)
 	if housesChan != nil {
 		close(housesChan)
 	}
+	if boundaryChan != nil {
+		close(boundaryChan)
+	}
 }
 
 // Обновляет адреса
@@ -150,30 +186,30 @@ func (o *OsmService) updateAddresses(wg *sync.WaitGroup, addressChan <-chan *ent
 	importWg.Add(1)
 	// Сохраняет элементы в БД
 	go o.addressRepo.InsertUpdateCollection(&importWg, address, addressCnt, true)
-	f, err := os.Create("lines.txt")
-	if err != nil {
-		fmt.Println(err)
-		f.Close()
-		return
-	}
-	defer f.Close()
+	report := o.createMatchReport()
+	defer o.closeMatchReport(report)
+	// Родительские объекты кандидатов, общие для всех населенных пунктов
+	parents := make(map[string]*addressEntity.AddressObject)
 
 	for d := range addressChan {
-		// Ищет адреса в БД по названию
-		items, _ := o.addressRepo.GetAddressByTerm(d.Name, 1, 0)
-		if len(items) > 0 {
-			item := items[0]
+		// Сопоставляет населенный пункт с адресами в БД
+		item, result := o.matchPlace(d, parents)
+		if err := report.add(result); err != nil {
+			o.logger.Error(err.Error())
+		}
+		if item != nil {
 			location := fmt.Sprint(d.Lat, ",", d.Lon)
-			// Сохраняет только адреса, у которых отличается местоположение или индекс с данными из OSM
-			if item.Location != location || (d.PostalCode != "" && item.PostalCode != d.PostalCode) {
+			// Сохраняет только адреса, у которых отличается местоположение, индекс или численность населения с данными из OSM
+			if item.Location != location || (d.PostalCode != "" && item.PostalCode != d.PostalCode) || (d.Population > 0 && item.Population != d.Population) {
 				item.Location = location
 				if d.PostalCode != "" && item.PostalCode != d.PostalCode {
 					item.PostalCode = d.PostalCode
 				}
+				if d.Population > 0 {
+					item.Population = d.Population
+				}
 				address <- *item
 			}
-		} else {
-			fmt.Fprintln(f, d.Name, d.Lat, d.Lon)
 		}
 	}
 	close(address)
@@ -190,6 +226,7 @@ func (o *OsmService) updateHouses(wg *sync.WaitGroup, housesChan <-chan *entity.
 	importWg.Add(1)
 	// Сохраняет элементы в БД
 	go o.houseRepo.InsertUpdateCollection(&importWg, houses, housesCnt, true)
+	matched, unmatched := 0, 0
 
 	for d := range housesChan {
 		// Ищет ближайщий адрес при отсутствии города
@@ -202,10 +239,16 @@ func (o *OsmService) updateHouses(wg *sync.WaitGroup, housesChan <-chan *entity.
 			}
 		}
 
-		// Ищет дома в БД по адресу
-		items, _ := o.houseRepo.GetAddressByTerm(d.Name, 1, 0)
-		if len(items) > 0 {
-			item := items[0]
+		// Ищет дома в БД по адресу и проверяет номер дома и улицу найденных домов
+		items, err := o.houseRepo.GetAddressByTerm(d.Name, matchCandidatesSize, 0)
+		if err != nil {
+			o.logger.Error(err.Error())
+		}
+		item := o.matchHouse(d, items)
+		if item == nil {
+			unmatched++
+		} else {
+			matched++
 			location := fmt.Sprint(d.Lat, ",", d.Lon)
 			// Сохраняет только дома, у которых отличается местоположение или индекс с данными из OSM
 			if item.Location != location || (d.PostalCode != "" && item.PostalCode != d.PostalCode) {
@@ -220,19 +263,21 @@ func (o *OsmService) updateHouses(wg *sync.WaitGroup, housesChan <-chan *entity.
 	close(houses)
 	<-housesCnt
 	importWg.Wait()
+	o.logger.WithFields(interfaces.LoggerFields{"matched": matched, "unmatched": unmatched}).Info("OSM houses matched")
 }
 
 // Проверяет и разбирает объект
-func (o *OsmService) prepareItems(e *osm.Node) *entity.Node {
-	place := o.getTagByName(e.TagMap(), "place")
-	official := strings.Split(o.getTagByName(e.TagMap(), "official_status"), ":")
-	postal := o.getTagByName(e.TagMap(), "addr:postcode")
-	region := o.getTagByName(e.TagMap(), "addr:region")
-	district := o.getTagByName(e.TagMap(), "addr:district")
-	city := o.getTagByName(e.TagMap(), "addr:city")
-	street := o.getTagByName(e.TagMap(), "addr:street")
-	housenum := o.getTagByName(e.TagMap(), "addr:housenumber")
-	name := o.getTagByName(e.TagMap(), "name")
+func (o *OsmService) prepareItems(tags map[string]string, lat float64, lon float64, id osm.FeatureID) *entity.Node {
+	place := o.getTagByName(tags, "place")
+	official := strings.Split(o.getTagByName(tags, "official_status"), ":")
+	postal := o.getTagByName(tags, "addr:postcode")
+	region := o.getTagByName(tags, "addr:region")
+	district := o.getTagByName(tags, "addr:district")
+	city := o.getTagByName(tags, "addr:city")
+	street := o.getTagByName(tags, "addr:street")
+	housenum := o.getTagByName(tags, "addr:housenumber")
+	name := o.getTagByName(tags, "name")
+	population := o.parsePopulation(o.getTagByName(tags, "population"))
 	houseAddress := ""
 	if strings.Contains(district, "городской округ") {
 		district = ""
@@ -240,7 +285,7 @@ func (o *OsmService) prepareItems(e *osm.Node) *entity.Node {
 
 	// Проверяет наличие тегов у объекта
 	if place != "" || (housenum != "" && street != "") {
-		if place != "" && place != "city" && place != "town" && region == "" && district == "" && city == "" {
+		if place != "" && (name == "" || (place != "city" && place != "town" && region == "" && district == "" && city == "")) {
 			return nil
 		}
 
@@ -279,8 +324,9 @@ func (o *OsmService) prepareItems(e *osm.Node) *entity.Node {
 			if fullAddr != "" {
 				fullAddr += ", "
 			}
+			// Тип населенного пункта из official_status добавляется только в полный адрес
 			if len(official) > 0 && len(official[len(official)-1]) > 0 {
-				name = official[len(official)-1] + " " + name
+				fullAddr += official[len(official)-1] + " "
 			}
 
 			fullAddr += name
@@ -289,16 +335,26 @@ func (o *OsmService) prepareItems(e *osm.Node) *entity.Node {
 		replacedAddr := strings.TrimSpace(util.Replace(fullAddr))
 		node := entity.Node{
 			Name:       replacedAddr,
-			Lat:        e.Lat,
-			Lon:        e.Lon,
+			Lat:        lat,
+			Lon:        lon,
 			PostalCode: postal,
-			Node:       e,
+			Population: population,
+			FeatureID:  id,
 		}
 
 		if place != "" {
 			node.Type = "place"
+			node.Place = place
+			node.PlaceName = name
+			for _, parent := range []string{region, district, city} {
+				if parent != "" {
+					node.Parents = append(node.Parents, parent)
+				}
+			}
 		} else {
 			node.Type = "building"
+			node.HouseNumber = housenum
+			node.Street = street
 			if city == "" {
 				node.HouseAddress = houseAddress
 			}
@@ -310,6 +366,17 @@ func (o *OsmService) prepareItems(e *osm.Node) *entity.Node {
 	return nil
 }
 
+// Разбирает численность населения, записанную с разделителями разрядов
+func (o *OsmService) parsePopulation(value string) int {
+	value = strings.NewReplacer(" ", "", ",", "", ".", "").Replace(value)
+	population, err := strconv.Atoi(value)
+	if err != nil || population < 0 {
+		return 0
+	}
+
+	return population
+}
+
 // Проверяет наличие конкретных тегов у объекта по группам
 func (o *OsmService) containsValidTags(tags map[string]string, group map[string][]string) bool {
 	for _, list := range group {
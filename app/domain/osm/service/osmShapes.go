@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	addressEntity "github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/osm/entity"
+	"github.com/GarinAG/gofias/util"
+	"github.com/paulmach/osm"
+	"github.com/paulmach/osm/osmpbf"
+	"io"
+	"math"
+	"os"
+	"sort"
+)
+
+// Линия OSM, координаты которой вычисляются по ее точкам
+type osmWay struct {
+	Node      *entity.Node    // Объект разбора линии, отсутствует у линий, которые нужны только отношениям
+	Points    []util.GeoPoint // Координаты точек линии, у неполученных точек широта равна NaN
+	Remaining int             // Количество точек, координаты которых еще не получены
+	IsMember  bool            // Линия входит в отношение, координаты ее точек хранятся до разбора отношений
+}
+
+// Отношение OSM, координаты которого вычисляются по его линиям
+type osmRelation struct {
+	ID          osm.RelationID    // Идентификатор отношения
+	Tags        map[string]string // Теги отношения
+	Outer       []osm.WayID       // Внешние линии контура
+	Inner       []osm.WayID       // Внутренние линии контура, собираются только для границ
+	Center      osm.NodeID        // Точка центра отношения (label или admin_centre)
+	CenterPoint util.GeoPoint     // Координаты точки центра
+	HasCenter   bool              // Координаты точки центра получены
+	IsPlace     bool              // Отношение является населенным пунктом или домом
+	AdminLevel  int               // Административный уровень границы, 0 - не является границей
+}
+
+// Ссылка на точку, координаты которой нужны линии или отношению
+type osmPointRef struct {
+	Node  osm.NodeID // Идентификатор точки
+	Index int32      // Индекс линии или отношения
+	Pos   int32      // Позиция точки в линии, -1 - точка центра отношения
+}
+
+// Линии и отношения OSM, ожидающие получения координат точек
+type osmShapes struct {
+	Ways      []*osmWay           // Линии
+	Members   map[osm.WayID]int32 // Индексы линий, входящих в отношения, -1 до чтения линии
+	Relations []*osmRelation      // Отношения
+	Refs      []osmPointRef       // Ссылки на нужные точки, упорядоченные по идентификатору точки
+}
+
+// Собирает линии и отношения, подходящие по условиям, за два прохода по файлу: отношения, затем линии
+func (o *OsmService) collectShapes(f *os.File, conditions map[string][]string, withHouses bool, withBoundaries bool) *osmShapes {
+	shapes := &osmShapes{
+		Members: make(map[osm.WayID]int32),
+	}
+
+	o.logger.Info("Collect OSM relations")
+	o.scanObjects(f, osm.TypeRelation, func(object osm.Object) {
+		e := object.(*osm.Relation)
+		tags := e.TagMap()
+		relationType := o.getTagByName(tags, "type")
+		if relationType != "multipolygon" && relationType != "boundary" {
+			return
+		}
+		relation := osmRelation{ID: e.ID, Tags: tags}
+		relation.IsPlace = o.prepareShape(tags, conditions, withHouses, e.FeatureID()) != nil
+		if withBoundaries && relationType == "boundary" {
+			relation.AdminLevel = o.getBoundaryAdminLevel(tags)
+		}
+		if !relation.IsPlace && relation.AdminLevel == 0 {
+			return
+		}
+		for _, member := range e.Members {
+			switch {
+			case member.Type == osm.TypeWay && (member.Role == "outer" || member.Role == ""):
+				relation.Outer = append(relation.Outer, osm.WayID(member.Ref))
+				shapes.Members[osm.WayID(member.Ref)] = -1
+			case member.Type == osm.TypeWay && member.Role == "inner" && relation.AdminLevel > 0:
+				relation.Inner = append(relation.Inner, osm.WayID(member.Ref))
+				shapes.Members[osm.WayID(member.Ref)] = -1
+			case member.Type == osm.TypeNode && (member.Role == "label" || member.Role == "admin_centre"):
+				if relation.Center == 0 || member.Role == "label" {
+					relation.Center = osm.NodeID(member.Ref)
+				}
+			}
+		}
+		if relation.Center != 0 {
+			shapes.Refs = append(shapes.Refs, osmPointRef{Node: relation.Center, Index: int32(len(shapes.Relations)), Pos: -1})
+		}
+		shapes.Relations = append(shapes.Relations, &relation)
+	})
+
+	o.logger.Info("Collect OSM ways")
+	o.scanObjects(f, osm.TypeWay, func(object osm.Object) {
+		e := object.(*osm.Way)
+		_, isMember := shapes.Members[e.ID]
+		var node *entity.Node
+		if e.Tags != nil {
+			node = o.prepareShape(e.TagMap(), conditions, withHouses, e.FeatureID())
+		}
+		if !isMember && node == nil {
+			return
+		}
+		index := int32(len(shapes.Ways))
+		way := osmWay{Node: node, Points: make([]util.GeoPoint, len(e.Nodes)), Remaining: len(e.Nodes), IsMember: isMember}
+		for i, wayNode := range e.Nodes {
+			way.Points[i].Lat = math.NaN()
+			shapes.Refs = append(shapes.Refs, osmPointRef{Node: wayNode.ID, Index: index, Pos: int32(i)})
+		}
+		if isMember {
+			shapes.Members[e.ID] = index
+		}
+		shapes.Ways = append(shapes.Ways, &way)
+	})
+
+	// Упорядочивает ссылки для поиска точек при чтении файла
+	sort.Slice(shapes.Refs, func(i, j int) bool {
+		return shapes.Refs[i].Node < shapes.Refs[j].Node
+	})
+
+	return shapes
+}
+
+// Разбирает линию или отношение, если оно является населенным пунктом или домом.
+// Координаты объекта разбора заполняются после чтения точек
+func (o *OsmService) prepareShape(tags map[string]string, conditions map[string][]string, withHouses bool, id osm.FeatureID) *entity.Node {
+	if !o.hasTags(tags) || !o.containsValidTags(tags, conditions) {
+		return nil
+	}
+	node := o.prepareItems(tags, 0, 0, id)
+	if node == nil || (!withHouses && node.Type == "building") {
+		return nil
+	}
+
+	return node
+}
+
+// Сканирует объекты указанного типа, файл читается с начала и до окончания объектов этого типа
+func (o *OsmService) scanObjects(f *os.File, objectType osm.Type, handler func(object osm.Object)) {
+	_, err := f.Seek(0, io.SeekStart)
+	o.checkFatalError(err)
+	scanner := osmpbf.New(context.Background(), f, 3)
+	defer scanner.Close()
+
+	bar := util.StartNewProgress(-1, "Collect OSM "+string(objectType), false)
+	for scanner.Scan() {
+		object := scanner.Object()
+		if object.ObjectID().Type() != objectType {
+			// Объекты в файле упорядочены по типу: точки, линии, отношения
+			if objectType == osm.TypeWay && object.ObjectID().Type() == osm.TypeRelation {
+				break
+			}
+			continue
+		}
+		bar.Increment()
+		handler(object)
+	}
+	bar.Finish()
+	o.checkFatalError(scanner.Err())
+}
+
+// Запоминает координаты точки, если она нужна линиям или отношениям.
+// Линии, получившие координаты всех точек, передаются обработчику
+func (s *osmShapes) addPoint(e *osm.Node, complete func(way *osmWay)) {
+	point := util.GeoPoint{Lat: e.Lat, Lon: e.Lon}
+	i := sort.Search(len(s.Refs), func(i int) bool {
+		return s.Refs[i].Node >= e.ID
+	})
+	for ; i < len(s.Refs) && s.Refs[i].Node == e.ID; i++ {
+		ref := s.Refs[i]
+		if ref.Pos < 0 {
+			relation := s.Relations[ref.Index]
+			relation.CenterPoint, relation.HasCenter = point, true
+			continue
+		}
+		way := s.Ways[ref.Index]
+		way.Points[ref.Pos] = point
+		way.Remaining--
+		if way.Remaining == 0 {
+			complete(way)
+		}
+	}
+}
+
+// Вычисляет центр линии и передает объект разбора обработчику.
+// Координаты точек сохраняются только у линий, которые нужны отношениям
+func (s *osmShapes) completeWay(way *osmWay, handler func(node *entity.Node)) {
+	if way.Node != nil {
+		if center, ok := util.GeoCentroid([][]util.GeoPoint{s.getWayPoints(way)}); ok {
+			way.Node.Lat, way.Node.Lon = center.Lat, center.Lon
+			handler(way.Node)
+		}
+		way.Node = nil
+	}
+	if !way.IsMember {
+		way.Points = nil
+	}
+}
+
+// Получить координаты точек линии, пропуская точки, отсутствующие в файле
+func (s *osmShapes) getWayPoints(way *osmWay) []util.GeoPoint {
+	if way.Remaining == 0 {
+		return way.Points
+	}
+	var points []util.GeoPoint
+	for _, point := range way.Points {
+		if !math.IsNaN(point.Lat) {
+			points = append(points, point)
+		}
+	}
+
+	return points
+}
+
+// Получить центр отношения: точку центра, если она указана, иначе центр тяжести контура
+func (s *osmShapes) getRelationCenter(relation *osmRelation) (util.GeoPoint, bool) {
+	if relation.HasCenter {
+		return relation.CenterPoint, true
+	}
+	lines := s.getRelationLines(relation)
+	if rings := util.JoinGeoRings(lines); len(rings) > 0 {
+		return util.GeoCentroid(rings)
+	}
+
+	// Контур не замыкается, например, если часть линий отсутствует в файле
+	return util.GeoCentroid(lines)
+}
+
+// Получить внешние линии отношения
+func (s *osmShapes) getRelationLines(relation *osmRelation) [][]util.GeoPoint {
+	return s.getLines(relation.Outer)
+}
+
+// Получить линии по идентификаторам, пропуская линии, отсутствующие в файле
+func (s *osmShapes) getLines(ids []osm.WayID) [][]util.GeoPoint {
+	var lines [][]util.GeoPoint
+	for _, id := range ids {
+		if index, ok := s.Members[id]; ok && index >= 0 {
+			lines = append(lines, s.getWayPoints(s.Ways[index]))
+		}
+	}
+
+	return lines
+}
+
+// Получить контуры границы: полигоны из внешних контуров с отверстиями из внутренних
+func (s *osmShapes) getRelationPolygons(relation *osmRelation) addressEntity.MultiPolygon {
+	var polygons addressEntity.MultiPolygon
+	for _, ring := range util.JoinGeoRings(s.getLines(relation.Outer)) {
+		polygons = append(polygons, [][][2]float64{s.toCoordinates(ring)})
+	}
+	for _, ring := range util.JoinGeoRings(s.getLines(relation.Inner)) {
+		polygons.AddHole(s.toCoordinates(ring))
+	}
+
+	return polygons
+}
+
+// Конвертирует контур в координаты формата GeoJSON
+func (s *osmShapes) toCoordinates(ring []util.GeoPoint) [][2]float64 {
+	coordinates := make([][2]float64, len(ring))
+	for i, point := range ring {
+		coordinates[i] = [2]float64{point.Lon, point.Lat}
+	}
+
+	return coordinates
+}
+
+// Формирует объекты разбора и границы из линий и отношений с вычисленными координатами
+func (o *OsmService) prepareShapes(shapes *osmShapes, handler func(node *entity.Node), boundaryHandler func(boundary *entity.Boundary)) {
+	shapes.Refs = nil
+	// Линии, часть точек которых отсутствует в файле, разбираются по найденным точкам
+	for _, way := range shapes.Ways {
+		shapes.completeWay(way, handler)
+	}
+	for _, relation := range shapes.Relations {
+		if relation.IsPlace {
+			if center, ok := shapes.getRelationCenter(relation); ok {
+				if node := o.prepareItems(relation.Tags, center.Lat, center.Lon, relation.ID.FeatureID()); node != nil {
+					handler(node)
+				}
+			}
+		}
+		if relation.AdminLevel > 0 {
+			if polygons := shapes.getRelationPolygons(relation); len(polygons) > 0 {
+				boundaryHandler(&entity.Boundary{
+					FeatureID:  relation.ID.FeatureID(),
+					Name:       o.getTagByName(relation.Tags, "name"),
+					AdminLevel: relation.AdminLevel,
+					Polygons:   polygons,
+				})
+			}
+		}
+	}
+}
@@ -7,6 +7,7 @@ type Version struct {
 	UpdateDate       string
 	RecUpdateAddress int
 	RecUpdateHouses  int
+	Regions          []string // Коды загруженных регионов, пустой список - все регионы
 }
 
 // Получить название таблицы в БД
@@ -7,6 +7,7 @@ require (
 	github.com/allegro/bigcache v1.2.1
 	github.com/antchfx/xpath v1.1.9 // indirect
 	github.com/antihax/optional v1.0.0 // indirect
+	github.com/blevesearch/bleve v1.0.14
 	github.com/cheggaaa/pb/v3 v3.0.4
 	github.com/cpuguy83/go-md2man/v2 v2.0.0 // indirect
 	github.com/dustin/go-humanize v1.0.0
@@ -34,6 +35,7 @@ require (
 	github.com/tamerh/xml-stream-parser v1.4.0
 	github.com/tamerh/xpath v1.0.0 // indirect
 	github.com/urfave/cli/v2 v2.2.0
+	go.etcd.io/bbolt v1.3.5
 	go.uber.org/zap v1.15.0
 	golang.org/x/net v0.0.0-20200707034311-ab3426394381 // indirect
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d // indirect
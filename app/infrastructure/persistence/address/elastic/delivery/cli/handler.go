@@ -2,24 +2,29 @@ package cli
 
 import (
 	service2 "github.com/GarinAG/gofias/domain/address/service"
+	versionService "github.com/GarinAG/gofias/domain/version/service"
 	"github.com/GarinAG/gofias/interfaces"
 )
 
 // Обработчик индексации БД
 type Handler struct {
-	importService *service2.ImportService    // Сервис импорта
-	logger        interfaces.LoggerInterface // Логгер
+	importService  *service2.ImportService        // Сервис импорта
+	versionService *versionService.VersionService // Сервис версий
+	logger         interfaces.LoggerInterface     // Логгер
 }
 
 // Инициализация обработчика
-func NewHandler(s *service2.ImportService, logger interfaces.LoggerInterface) *Handler {
+func NewHandler(s *service2.ImportService, v *versionService.VersionService, logger interfaces.LoggerInterface) *Handler {
 	return &Handler{
-		importService: s,
-		logger:        logger,
+		importService:  s,
+		versionService: v,
+		logger:         logger,
 	}
 }
 
 // Индексация БД
 func (h *Handler) Index() {
+	// По умолчанию индексируются регионы загруженной версии
+	h.importService.PrepareIndexRegions(h.versionService.GetLastVersionInfo())
 	h.importService.Index()
 }
@@ -2,17 +2,26 @@ package cli
 
 import (
 	cli2 "github.com/GarinAG/gofias/infrastructure/persistence/cli"
+	"github.com/GarinAG/gofias/util"
 	"github.com/urfave/cli/v2"
 )
 
 // Регистрация основной команды индексации
 func RegisterIndexCliEndpoint(app *cli2.App) {
-	h := NewHandler(app.ImportService, app.Logger)
+	h := NewHandler(app.ImportService, app.VersionService, app.Logger)
 	app.Server.Commands = append(app.Server.Commands, &cli.Command{
 		Name:  "index",
 		Usage: "Run fias elastic index",
+		Flags: []cli.Flag{
+			// Список регионов индексации
+			&cli.StringFlag{
+				Name:  "regions",
+				Usage: "Comma-separated region codes to index, e.g. 77,50",
+			},
+		},
 		Action: func(c *cli.Context) error {
 			h.importService.IsFull = true
+			h.importService.Regions = util.SplitRegions(c.String("regions"))
 			h.Index()
 			return nil
 		},
@@ -0,0 +1,14 @@
+package dto
+
+// Объект в эластике, полный адрес которого формируется по адресу родителя: помещение или земельный участок
+type ChildObjectDto interface {
+	GetId() string                            // Получить идентификатор объекта
+	GetGuid() string                          // Получить GUID объекта
+	GetParentGuid() string                    // Получить GUID родительского объекта
+	GetFullNum() string                       // Получить полный номер объекта
+	GetFullAddress() string                   // Получить полный адрес объекта
+	IsActive() bool                           // Проверяет активность объекта
+	UpdateBazisDate()                         // Устанавливает время обновления объекта
+	UpdateFullAddress(parentAddress string)   // Формирует полный адрес по адресу родителя
+	UpdateFromExistItem(exist ChildObjectDto) // Заполняет объект из данных сохраненного объекта
+}
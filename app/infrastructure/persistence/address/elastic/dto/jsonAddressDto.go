@@ -10,54 +10,59 @@ import (
 
 // Объект адреса в эластике
 type JsonAddressDto struct {
-	ID              string `json:"ao_id"`
-	AoGuid          string `json:"ao_guid"`
-	ParentGuid      string `json:"parent_guid"`
-	FormalName      string `json:"formal_name"`
-	ShortName       string `json:"short_name"`
-	AoLevel         int    `json:"ao_level"`
-	OffName         string `json:"off_name"`
-	FullName        string `json:"full_name"`
-	Code            string `json:"code"`
-	RegionCode      string `json:"region_code"`
-	PostalCode      string `json:"postal_code"`
-	Okato           string `json:"okato"`
-	Oktmo           string `json:"oktmo"`
-	ActStatus       string `json:"act_status"`
-	LiveStatus      string `json:"live_status"`
-	CurrStatus      string `json:"curr_status"`
-	StartDate       string `json:"start_date"`
-	EndDate         string `json:"end_date"`
-	UpdateDate      string `json:"update_date"`
-	RegionGuid      string `json:"district_guid"`
-	RegionKladr     string `json:"district_kladr"`
-	Region          string `json:"district"`
-	RegionType      string `json:"district_type"`
-	RegionFull      string `json:"district_full"`
-	AreaGuid        string `json:"area_guid"`
-	AreaKladr       string `json:"area_kladr"`
-	Area            string `json:"area"`
-	AreaType        string `json:"area_type"`
-	AreaFull        string `json:"area_full"`
-	CityGuid        string `json:"city_guid"`
-	CityKladr       string `json:"city_kladr"`
-	City            string `json:"city"`
-	CityType        string `json:"city_type"`
-	CityFull        string `json:"city_full"`
-	SettlementGuid  string `json:"settlement_guid"`
-	SettlementKladr string `json:"settlement_kladr"`
-	Settlement      string `json:"settlement"`
-	SettlementType  string `json:"settlement_type"`
-	SettlementFull  string `json:"settlement_full"`
-	StreetGuid      string `json:"street_guid"`
-	StreetKladr     string `json:"street_kladr"`
-	Street          string `json:"street"`
-	StreetType      string `json:"street_type"`
-	StreetFull      string `json:"street_full"`
-	AddressSuggest  string `json:"address_suggest"`
-	FullAddress     string `json:"full_address"`
-	Location        string `json:"location"`
-	BazisUpdateDate string `json:"bazis_update_date"`
+	ID                string `json:"ao_id"`
+	AoGuid            string `json:"ao_guid"`
+	ParentGuid        string `json:"parent_guid"`
+	MunParentGuid     string `json:"mun_parent_guid"`
+	FormalName        string `json:"formal_name"`
+	ShortName         string `json:"short_name"`
+	AoLevel           int    `json:"ao_level"`
+	OffName           string `json:"off_name"`
+	FullName          string `json:"full_name"`
+	Code              string `json:"code"`
+	RegionCode        string `json:"region_code"`
+	PostalCode        string `json:"postal_code"`
+	Okato             string `json:"okato"`
+	Oktmo             string `json:"oktmo"`
+	ActStatus         string `json:"act_status"`
+	LiveStatus        string `json:"live_status"`
+	CurrStatus        string `json:"curr_status"`
+	StartDate         string `json:"start_date"`
+	EndDate           string `json:"end_date"`
+	UpdateDate        string `json:"update_date"`
+	CentStatus        int    `json:"cent_status"`
+	Population        int    `json:"population"`
+	RegionGuid        string `json:"district_guid"`
+	RegionKladr       string `json:"district_kladr"`
+	Region            string `json:"district"`
+	RegionType        string `json:"district_type"`
+	RegionFull        string `json:"district_full"`
+	AreaGuid          string `json:"area_guid"`
+	AreaKladr         string `json:"area_kladr"`
+	Area              string `json:"area"`
+	AreaType          string `json:"area_type"`
+	AreaFull          string `json:"area_full"`
+	CityGuid          string `json:"city_guid"`
+	CityKladr         string `json:"city_kladr"`
+	City              string `json:"city"`
+	CityType          string `json:"city_type"`
+	CityFull          string `json:"city_full"`
+	SettlementGuid    string `json:"settlement_guid"`
+	SettlementKladr   string `json:"settlement_kladr"`
+	Settlement        string `json:"settlement"`
+	SettlementType    string `json:"settlement_type"`
+	SettlementFull    string `json:"settlement_full"`
+	StreetGuid        string `json:"street_guid"`
+	StreetKladr       string `json:"street_kladr"`
+	Street            string `json:"street"`
+	StreetType        string `json:"street_type"`
+	StreetFull        string `json:"street_full"`
+	AddressSuggest    string `json:"address_suggest"`
+	FullAddress       string `json:"full_address"`
+	MunAddressSuggest string `json:"mun_address_suggest"`
+	MunFullAddress    string `json:"mun_full_address"`
+	Location          string `json:"location"`
+	BazisUpdateDate   string `json:"bazis_update_date"`
 }
 
 // Конвертирует объект адреса эластика в объект адрес
@@ -83,6 +88,12 @@ func (item *JsonAddressDto) GetFromEntity(entity entity.AddressObject) {
 	if item.AddressSuggest == "" {
 		item.AddressSuggest = util.PrepareSuggest("", item.ShortName, item.FormalName)
 	}
+	if item.MunFullAddress == "" {
+		item.MunFullAddress = item.FullAddress
+	}
+	if item.MunAddressSuggest == "" {
+		item.MunAddressSuggest = item.AddressSuggest
+	}
 
 	item.UpdateBazisDate()
 }
@@ -106,12 +117,40 @@ func (item *JsonAddressDto) UpdateBazisDate() {
 
 // Заполняет объект адреса эластика из данных адреса
 func (item *JsonAddressDto) UpdateFromExistItem(entity entity.AddressObject) {
+	// Объекты ГАР не содержат иерархию и параметры, они сохраняются из БД
+	if item.ParentGuid == "" {
+		item.ParentGuid = entity.ParentGuid
+	}
+	if item.MunParentGuid == "" {
+		item.MunParentGuid = entity.MunParentGuid
+	}
+	if item.Code == "" {
+		item.Code = entity.Code
+	}
+	if item.PostalCode == "" {
+		item.PostalCode = entity.PostalCode
+	}
+	if item.Okato == "" {
+		item.Okato = entity.Okato
+	}
+	if item.Oktmo == "" {
+		item.Oktmo = entity.Oktmo
+	}
+	if item.CentStatus == 0 {
+		item.CentStatus = entity.CentStatus
+	}
 	if entity.FullAddress != "" {
 		item.FullAddress = entity.FullAddress
 	}
 	if entity.AddressSuggest != "" {
 		item.AddressSuggest = entity.AddressSuggest
 	}
+	if entity.MunFullAddress != "" {
+		item.MunFullAddress = entity.MunFullAddress
+	}
+	if entity.MunAddressSuggest != "" {
+		item.MunAddressSuggest = entity.MunAddressSuggest
+	}
 	if entity.RegionGuid != "" {
 		item.RegionGuid = entity.RegionGuid
 	}
@@ -172,4 +211,7 @@ func (item *JsonAddressDto) UpdateFromExistItem(entity entity.AddressObject) {
 	if entity.Location != "" {
 		item.Location = entity.Location
 	}
+	if entity.Population > 0 {
+		item.Population = entity.Population
+	}
 }
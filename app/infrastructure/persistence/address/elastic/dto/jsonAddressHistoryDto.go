@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"gopkg.in/jeevatkm/go-model.v1"
+)
+
+// Объект версии адреса в эластике
+type JsonAddressHistoryDto struct {
+	ID         string `json:"ao_id"`
+	AoGuid     string `json:"ao_guid"`
+	ParentGuid string `json:"parent_guid"`
+	FormalName string `json:"formal_name"`
+	ShortName  string `json:"short_name"`
+	OffName    string `json:"off_name"`
+	AoLevel    int    `json:"ao_level"`
+	Code       string `json:"code"`
+	RegionCode string `json:"region_code"`
+	PostalCode string `json:"postal_code"`
+	Okato      string `json:"okato"`
+	Oktmo      string `json:"oktmo"`
+	ActStatus  string `json:"act_status"`
+	LiveStatus string `json:"live_status"`
+	CurrStatus string `json:"curr_status"`
+	StartDate  string `json:"start_date"`
+	EndDate    string `json:"end_date"`
+	UpdateDate string `json:"update_date"`
+	PrevId     string `json:"prev_id"`
+	NextId     string `json:"next_id"`
+}
+
+// Конвертирует объект версии адреса эластика в объект адреса
+func (item *JsonAddressHistoryDto) ToEntity() *entity.AddressObject {
+	address := entity.AddressObject{}
+	model.Copy(&address, item)
+
+	return &address
+}
+
+// Конвертирует объект адреса в объект версии адреса эластика
+func (item *JsonAddressHistoryDto) GetFromEntity(entity entity.AddressObject) {
+	model.Copy(item, entity)
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Контур границы в формате GeoJSON
+type GeoShapeDto struct {
+	Type        string              `json:"type"`
+	Coordinates entity.MultiPolygon `json:"coordinates"`
+}
+
+// Объект границы в эластике
+type JsonBoundaryDto struct {
+	ID         string       `json:"id"`
+	AoGuid     string       `json:"ao_guid"`
+	AoLevel    int          `json:"ao_level"`
+	AdminLevel int          `json:"admin_level"`
+	Name       string       `json:"name"`
+	Shape      *GeoShapeDto `json:"shape,omitempty"`
+}
+
+// Конвертирует объект границы эластика в границу
+func (item *JsonBoundaryDto) ToEntity() *entity.Boundary {
+	boundary := entity.Boundary{
+		ID:         item.ID,
+		AoGuid:     item.AoGuid,
+		AoLevel:    item.AoLevel,
+		AdminLevel: item.AdminLevel,
+		Name:       item.Name,
+	}
+	if item.Shape != nil {
+		boundary.Polygons = item.Shape.Coordinates
+	}
+
+	return &boundary
+}
+
+// Конвертирует границу в объект границы эластика
+func (item *JsonBoundaryDto) GetFromEntity(entity entity.Boundary) {
+	item.ID = entity.ID
+	item.AoGuid = entity.AoGuid
+	item.AoLevel = entity.AoLevel
+	item.AdminLevel = entity.AdminLevel
+	item.Name = entity.Name
+	item.Shape = &GeoShapeDto{
+		Type:        "MultiPolygon",
+		Coordinates: entity.Polygons,
+	}
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"gopkg.in/jeevatkm/go-model.v1"
+)
+
+// Объект записи журнала изменений в эластике
+type JsonChangeLogDto struct {
+	ID         string `json:"id"`
+	VersionId  int    `json:"version_id"`
+	ObjectType string `json:"object_type"`
+	Guid       string `json:"guid"`
+	ChangeType string `json:"change_type"`
+	RegionCode string `json:"region_code"`
+}
+
+// Конвертирует объект записи журнала эластика в запись журнала изменений
+func (item *JsonChangeLogDto) ToEntity() *entity.ChangeLogItem {
+	change := entity.ChangeLogItem{}
+	model.Copy(&change, item)
+
+	return &change
+}
+
+// Конвертирует запись журнала изменений в объект записи журнала эластика
+func (item *JsonChangeLogDto) GetFromEntity(entity entity.ChangeLogItem) {
+	model.Copy(item, entity)
+}
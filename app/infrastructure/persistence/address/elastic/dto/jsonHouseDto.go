@@ -89,6 +89,22 @@ func (item *JsonHouseDto) UpdateBazisDate() {
 
 // Заполняет объект дома эластика из данных дома
 func (item *JsonHouseDto) UpdateFromExistItem(entity entity.HouseObject) {
+	// Объекты ГАР не содержат иерархию и параметры, они сохраняются из БД
+	if item.AoGuid == "" {
+		item.AoGuid = entity.AoGuid
+	}
+	if item.PostalCode == "" {
+		item.PostalCode = entity.PostalCode
+	}
+	if item.Okato == "" {
+		item.Okato = entity.Okato
+	}
+	if item.Oktmo == "" {
+		item.Oktmo = entity.Oktmo
+	}
+	if item.CadNum == "" {
+		item.CadNum = entity.CadNum
+	}
 	if entity.FullAddress != "" {
 		item.FullAddress = entity.FullAddress
 	}
@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"time"
+)
+
+// Объект помещения в эластике
+type JsonRoomDto struct {
+	ID              string `json:"room_id"`
+	RoomGuid        string `json:"room_guid"`
+	HouseGuid       string `json:"house_guid"`
+	RegionCode      string `json:"region_code"`
+	FlatNumber      string `json:"flat_number"`
+	FlatType        string `json:"flat_type"`
+	RoomNumber      string `json:"room_number"`
+	RoomType        string `json:"room_type"`
+	RoomFullNum     string `json:"room_full_num"`
+	FullAddress     string `json:"full_address"`
+	PostalCode      string `json:"postal_code"`
+	CadNum          string `json:"cad_num"`
+	RoomCadNum      string `json:"room_cad_num"`
+	StartDate       string `json:"start_date"`
+	EndDate         string `json:"end_date"`
+	UpdateDate      string `json:"update_date"`
+	BazisUpdateDate string `json:"bazis_update_date"`
+}
+
+// Конвертирует объект помещения эластика в объект помещения
+func (item *JsonRoomDto) ToEntity() *entity.RoomObject {
+	room := entity.RoomObject{}
+	model.Copy(&room, item)
+
+	return &room
+}
+
+// Конвертирует объект помещения в объект помещения эластика
+func (item *JsonRoomDto) GetFromEntity(entity entity.RoomObject) {
+	model.Copy(item, entity)
+
+	if item.RoomFullNum == "" {
+		item.RoomFullNum = entity.GetFullNum()
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.RoomFullNum
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *JsonRoomDto) IsActive() bool {
+	end, err := time.Parse("2006-01-02", item.EndDate)
+	if err != nil || end.Unix() <= time.Now().Unix() {
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *JsonRoomDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Получить идентификатор объекта
+func (item *JsonRoomDto) GetId() string {
+	return item.ID
+}
+
+// Получить GUID объекта
+func (item *JsonRoomDto) GetGuid() string {
+	return item.RoomGuid
+}
+
+// Получить GUID родительского объекта
+func (item *JsonRoomDto) GetParentGuid() string {
+	return item.HouseGuid
+}
+
+// Получить полный номер объекта
+func (item *JsonRoomDto) GetFullNum() string {
+	return item.RoomFullNum
+}
+
+// Получить полный адрес объекта
+func (item *JsonRoomDto) GetFullAddress() string {
+	return item.FullAddress
+}
+
+// Формирует полный адрес помещения по адресу дома
+func (item *JsonRoomDto) UpdateFullAddress(parentAddress string) {
+	item.FullAddress = parentAddress + ", " + item.RoomFullNum
+}
+
+// Заполняет объект помещения эластика из данных помещения
+func (item *JsonRoomDto) UpdateFromExistItem(exist ChildObjectDto) {
+	if exist.GetFullAddress() != "" {
+		item.FullAddress = exist.GetFullAddress()
+	}
+}
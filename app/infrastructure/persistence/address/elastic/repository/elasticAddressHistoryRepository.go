@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"github.com/olivere/elastic/v7"
+	"sync"
+	"time"
+)
+
+const (
+	// Структура индекса истории адресов в эластике
+	addressHistoryIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": 0,
+		  "refresh_interval": "5s",
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "ao_id": {
+			"type": "keyword"
+		  },
+		  "ao_guid": {
+			"type": "keyword"
+		  },
+		  "parent_guid": {
+			"type": "keyword"
+		  },
+		  "formal_name": {
+			"type": "keyword"
+		  },
+		  "short_name": {
+			"type": "keyword"
+		  },
+		  "off_name": {
+			"type": "keyword"
+		  },
+		  "ao_level": {
+			"type": "integer"
+		  },
+		  "code": {
+			"type": "keyword"
+		  },
+		  "region_code": {
+			"type": "keyword"
+		  },
+		  "postal_code": {
+			"type": "keyword"
+		  },
+		  "okato": {
+			"type": "keyword"
+		  },
+		  "oktmo": {
+			"type": "keyword"
+		  },
+		  "act_status": {
+			"type": "keyword"
+		  },
+		  "live_status": {
+			"type": "keyword"
+		  },
+		  "curr_status": {
+			"type": "keyword"
+		  },
+		  "end_date": {
+			"type": "date"
+		  },
+		  "start_date": {
+			"type": "date"
+		  },
+		  "update_date": {
+			"type": "date"
+		  },
+		  "prev_id": {
+			"type": "keyword"
+		  },
+		  "next_id": {
+			"type": "keyword"
+		  }
+		}
+	  }
+	}
+	`
+)
+
+// Репозиторий истории адресов в эластике
+type ElasticAddressHistoryRepository struct {
+	elasticClient *elasticHelper.Client      // Клиент эластика
+	logger        interfaces.LoggerInterface // Логгер
+	batchSize     int                        // Размер пачки для обновления
+	indexName     string                     // Название индекса
+}
+
+// Инициализация репозитория
+func NewElasticAddressHistoryRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, indexName string) repository.AddressHistoryRepositoryInterface {
+	return &ElasticAddressHistoryRepository{
+		elasticClient: elasticClient,
+		logger:        logger,
+		batchSize:     batchSize,
+		indexName:     indexName,
+	}
+}
+
+// Инициализация индекса
+func (a *ElasticAddressHistoryRepository) Init() error {
+	return a.elasticClient.CreateIndex(a.indexName, addressHistoryIndexSettings)
+}
+
+// Получить назваине индекса
+func (a *ElasticAddressHistoryRepository) GetIndexName() string {
+	return a.indexName
+}
+
+// Удалить индекс
+func (a *ElasticAddressHistoryRepository) Clear() error {
+	return a.elasticClient.DropIndex(a.indexName)
+}
+
+// Получить все версии адреса по GUID
+func (a *ElasticAddressHistoryRepository) GetByGuid(guid string) ([]*entity.AddressObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewTermQuery("ao_guid", guid)).
+		Sort("start_date", true)
+	scrollData, err := a.elasticClient.ScrollData(scrollService, a.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.AddressObject
+	for _, hit := range scrollData {
+		var item dto.JsonAddressHistoryDto
+		// Конвертирует структуру ответа в DTO
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Получить версию адреса по идентификатору записи
+func (a *ElasticAddressHistoryRepository) GetById(id string) (*entity.AddressObject, error) {
+	if id == "" {
+		return nil, nil
+	}
+	res, err := a.elasticClient.Client.
+		Search(a.indexName).
+		Query(elastic.NewTermQuery("ao_id", id)).
+		Size(1).
+		Do(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var item *dto.JsonAddressHistoryDto
+	// Конвертирует структуру ответа в DTO
+	if len(res.Hits.Hits) > 0 {
+		if err := json.Unmarshal(res.Hits.Hits[0].Source, &item); err != nil {
+			return nil, err
+		}
+
+		return item.ToEntity(), nil
+	}
+
+	return nil, nil
+}
+
+// Сохранить коллекцию версий адресов
+func (a *ElasticAddressHistoryRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	bulk := a.GetBulkService()
+
+	// Цикл получения объекта адреса из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if bulk.NumberOfActions() > 0 {
+				a.commitBulk(bulk)
+				bulk = a.GetBulkService()
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.JsonAddressHistoryDto{}
+		saveItem.GetFromEntity(d.(entity.AddressObject))
+		bulk.Add(elastic.NewBulkIndexRequest().Id(saveItem.ID).Doc(saveItem))
+
+		// Отправляет запросы в эластик при превышении размера пачки
+		if bulk.NumberOfActions() >= a.batchSize {
+			a.commitBulk(bulk)
+			bulk = a.GetBulkService()
+		}
+	}
+
+	// Отправляет оставшиеся запросы в эластик
+	if bulk.NumberOfActions() > 0 {
+		a.commitBulk(bulk)
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address history import execution time")
+	a.elasticClient.RefreshIndexes([]string{a.GetIndexName()})
+	count <- int(total)
+}
+
+// Отправляет пачку запросов в эластик
+func (a *ElasticAddressHistoryRepository) commitBulk(bulk *elastic.BulkService) {
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add address history bulk commit failed")
+	}
+	if res != nil && res.Errors {
+		a.logger.WithFields(interfaces.LoggerFields{"error": a.elasticClient.GetBulkError(res)}).Fatal("Add address history bulk commit failed")
+	}
+}
+
+// Получить объект для работы с пачками элементов
+func (a *ElasticAddressHistoryRepository) GetBulkService() *elastic.BulkService {
+	return a.elasticClient.Client.Bulk().Index(a.GetIndexName())
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	cache "github.com/AeroAgency/golang-bigcache-lib"
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
@@ -72,6 +73,14 @@ const (
           "full_address": {
             "type": "keyword"
           },
+          "mun_address_suggest": {
+            "type": "text",
+            "analyzer": "edge_ngram_analyzer",
+            "search_analyzer": "keyword_analyzer"
+          },
+          "mun_full_address": {
+            "type": "keyword"
+          },
           "formal_name": {
             "type": "keyword"
           },
@@ -94,6 +103,9 @@ const (
           "parent_guid": {
             "type": "keyword"
           },
+          "mun_parent_guid": {
+            "type": "keyword"
+          },
           "ao_level": {
             "type": "integer"
           },
@@ -115,6 +127,12 @@ const (
           "live_status": {
             "type": "integer"
           },
+          "cent_status": {
+            "type": "integer"
+          },
+          "population": {
+            "type": "integer"
+          },
           "postal_code": {
             "type": "keyword"
           },
@@ -233,6 +251,8 @@ const (
       }
     }
     `
+	// Максимальная глубина иерархии адресов
+	maxHierarchyDepth = 15
 	// Обработчик удаления старых адресов
 	addrPipelineId   = "addr_drop_pipeline"
 	addrDropPipeline = `
@@ -360,6 +380,35 @@ func (a *ElasticAddressRepository) GetAddressByGuidList(guids []string) ([]*enti
 	return items, nil
 }
 
+// Найти адреса по идентификаторам объектов
+func (a *ElasticAddressRepository) GetAddressByIdList(ids []string) ([]*entity.AddressObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewTermsQuery("ao_id", util.ConvertStringSliceToInterface(ids)...))
+
+	scrollData, err := a.elasticClient.ScrollData(scrollService, a.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.AddressObject
+	var item *dto.JsonAddressDto
+
+	// Получает данные из эластика пачками
+	for _, hit := range scrollData {
+		// Конвертирует структуру ответа в DTO
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
 // Найти адрес по GUID
 func (a *ElasticAddressRepository) GetByGuid(guid string) (*entity.AddressObject, error) {
 	if guid == "" {
@@ -480,17 +529,52 @@ func (a *ElasticAddressRepository) GetAddressByTerm(term string, size int64, fro
 	if size == 0 {
 		size = 100
 	}
-	queries := []elastic.Query{elastic.NewMatchQuery("address_suggest", term).Operator("and")}
-	queries = a.prepareFilter(queries, filter...)
+	limit := from + size
+	var items []*entity.AddressObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
+
+// Найти адрес по варианту подстроки
+func (a *ElasticAddressRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	queries := a.prepareFilter([]elastic.Query{a.variantQuery(suggestField, variant)}, filter...)
 
 	res, err := a.elasticClient.Client.
 		Search(a.indexName).
 		Query(elastic.NewBoolQuery().Must(queries...)).
-		From(int(from)).
 		Size(int(size)).
 		Sort("ao_level", true).
 		Sort("_score", false).
-		Sort("full_address", true).
+		Sort(fullAddressField, true).
 		Do(context.Background())
 
 	if err != nil {
@@ -512,6 +596,122 @@ func (a *ElasticAddressRepository) GetAddressByTerm(term string, size int64, fro
 	return items, nil
 }
 
+// Найти страницу адресов по последнему варианту подстроки после курсора, исключая адреса предыдущих вариантов
+func (a *ElasticAddressRepository) GetAddressByVariantAfter(variants []util.TermVariant, size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	queries := a.prepareFilter([]elastic.Query{a.variantQuery(suggestField, variants[len(variants)-1])}, filter...)
+	searchQuery := elastic.NewBoolQuery().Must(queries...)
+	for _, variant := range variants[:len(variants)-1] {
+		searchQuery.MustNot(a.variantQuery(suggestField, variant))
+	}
+
+	return a.searchAfter(searchQuery, size, after, fullAddressField)
+}
+
+// Получить страницу городов после курсора
+func (a *ElasticAddressRepository) GetCitiesAfter(size int64, after *entity.Cursor) ([]*entity.AddressObject, error) {
+	searchQuery := elastic.NewBoolQuery().Filter(
+		elastic.NewTermQuery("short_name", "г"),
+		elastic.NewTermsQuery("ao_level", 1, 4))
+
+	return a.searchAfter(searchQuery, size, after, "full_address")
+}
+
+// Получить страницу адресов по фильтру после курсора
+func (a *ElasticAddressRepository) GetListAfter(size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		fullAddressField = "mun_full_address"
+	}
+	searchQuery := elastic.NewBoolQuery().Filter(a.prepareFilter(nil, filter...)...)
+
+	return a.searchAfter(searchQuery, size, after, fullAddressField)
+}
+
+// Выполнить постраничную выборку адресов после курсора
+func (a *ElasticAddressRepository) searchAfter(searchQuery elastic.Query, size int64, after *entity.Cursor, fullAddressField string) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	search := a.elasticClient.Client.
+		Search(a.indexName).
+		Query(searchQuery).
+		Size(int(size)).
+		Sort("ao_level", true).
+		Sort(fullAddressField, true).
+		Sort("ao_id", true)
+	if after.HasPosition() {
+		search = search.SearchAfter(after.Level, after.FullAddress, after.ID)
+	}
+	res, err := search.Do(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.AddressObject
+	var item *dto.JsonAddressDto
+	// Конвертирует структуру ответа в DTO
+	for _, el := range res.Hits.Hits {
+		if err := json.Unmarshal(el.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Выгрузить адреса по фильтру пачками
+func (a *ElasticAddressRepository) Export(filter entity.ExportFilter, handler func(items []*entity.AddressObject) error) error {
+	var queries []elastic.Query
+	if len(filter.Regions) > 0 {
+		queries = append(queries, elastic.NewTermsQuery("region_code", util.ConvertStringSliceToInterface(filter.Regions)...))
+	}
+	if len(filter.Levels) > 0 {
+		queries = append(queries, elastic.NewTermsQuery("ao_level", util.ConvertIntSliceToInterface(filter.Levels)...))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		queries = append(queries, elastic.NewRangeQuery("bazis_update_date").Gte(filter.UpdatedSince.Format(util.TimeFormat)))
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewBoolQuery().Filter(queries...)).
+		Sort("_doc", true)
+
+	return a.elasticClient.ScrollBatches(scrollService, a.batchSize, func(hits []*elastic.SearchHit) error {
+		var items []*entity.AddressObject
+		for _, hit := range hits {
+			var item dto.JsonAddressDto
+			// Конвертирует структуру ответа в DTO
+			if err := json.Unmarshal(hit.Source, &item); err != nil {
+				return err
+			}
+			items = append(items, item.ToEntity())
+		}
+
+		return handler(items)
+	})
+}
+
+// Получить запрос поиска по варианту подстроки
+func (a *ElasticAddressRepository) variantQuery(field string, variant util.TermVariant) elastic.Query {
+	match := elastic.NewMatchQuery(field, variant.Term).Operator("and")
+	if variant.Fuzzy {
+		match.Fuzziness("AUTO").PrefixLength(1)
+	}
+
+	return match
+}
+
 // Подготовить фильтр для запроса
 func (a *ElasticAddressRepository) prepareFilter(queries []elastic.Query, filters ...entity.FilterObject) []elastic.Query {
 	for _, filter := range filters {
@@ -529,7 +729,11 @@ func (a *ElasticAddressRepository) prepareFilter(queries []elastic.Query, filter
 			queries = append(queries, rangeQuery)
 		}
 		if len(filter.ParentGuid.Values) > 0 {
-			queries = append(queries, elastic.NewTermsQuery("parent_guid", util.ConvertStringSliceToInterface(filter.ParentGuid.Values)...))
+			parentField := "parent_guid"
+			if filter.Hierarchy == entity.MunHierarchy {
+				parentField = "mun_parent_guid"
+			}
+			queries = append(queries, elastic.NewTermsQuery(parentField, util.ConvertStringSliceToInterface(filter.ParentGuid.Values)...))
 		}
 		if len(filter.KladrId.Values) > 0 {
 			queries = append(queries, elastic.NewTermsQuery("code", util.ConvertStringSliceToInterface(filter.KladrId.Values)...))
@@ -539,6 +743,17 @@ func (a *ElasticAddressRepository) prepareFilter(queries []elastic.Query, filter
 	return queries
 }
 
+// Проверить, запрошена ли муниципальная иерархия
+func (a *ElasticAddressRepository) isMunHierarchy(filters ...entity.FilterObject) bool {
+	for _, filter := range filters {
+		if filter.Hierarchy == entity.MunHierarchy {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Найти адрес по почтовому индексу
 func (a *ElasticAddressRepository) GetAddressByPostal(term string, size int64, from int64) ([]*entity.AddressObject, error) {
 	if size == 0 {
@@ -658,6 +873,15 @@ func (a *ElasticAddressRepository) InsertUpdateCollection(wg *sync.WaitGroup, ch
 		if d == nil {
 			break
 		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
 		total++
 		saveItem := dto.JsonAddressDto{}
 		saveItem.GetFromEntity(d.(entity.AddressObject))
@@ -731,6 +955,71 @@ func (a *ElasticAddressRepository) update(updated map[string]dto.JsonAddressDto,
 	}
 }
 
+// Обновить иерархию адресов
+func (a *ElasticAddressRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	if len(items) == 0 {
+		return nil
+	}
+	bulk := a.GetBulkService()
+	// Обновляет дату изменения для перестроения полного адреса при индексации
+	updateDate := time.Now().Format(util.TimeFormat)
+	for _, item := range items {
+		field := "parent_guid"
+		if item.Type == entity.MunHierarchy {
+			field = "mun_parent_guid"
+		}
+		bulk.Add(elastic.NewBulkUpdateRequest().Id(item.ObjectId).Doc(map[string]interface{}{
+			field:               item.ParentGuid,
+			"bazis_update_date": updateDate,
+		}))
+	}
+
+	return a.updateBulk(bulk)
+}
+
+// Обновить параметры адресов
+func (a *ElasticAddressRepository) UpdateParams(items []entity.ParamObject) error {
+	bulk := a.GetBulkService()
+	for _, item := range items {
+		var field string
+		switch item.TypeId {
+		case entity.ParamPostalCode:
+			field = "postal_code"
+		case entity.ParamOkato:
+			field = "okato"
+		case entity.ParamOktmo:
+			field = "oktmo"
+		case entity.ParamKladr:
+			field = "code"
+		default:
+			continue
+		}
+		bulk.Add(elastic.NewBulkUpdateRequest().Id(item.ObjectId).Doc(map[string]interface{}{
+			field: item.Value,
+		}))
+	}
+	if bulk.NumberOfActions() == 0 {
+		return nil
+	}
+
+	return a.updateBulk(bulk)
+}
+
+// Выполняет частичное обновление пачки элементов
+func (a *ElasticAddressRepository) updateBulk(bulk *elastic.BulkService) error {
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		return err
+	}
+	if res != nil && res.Errors {
+		if bulkErr := a.elasticClient.GetBulkUpdateError(res); bulkErr != nil {
+			return errors.New(bulkErr.Reason)
+		}
+	}
+
+	return nil
+}
+
 // Обновить индекс
 func (a *ElasticAddressRepository) Refresh() {
 	a.elasticClient.RefreshIndexes([]string{a.GetIndexName()})
@@ -743,7 +1032,7 @@ func (a *ElasticAddressRepository) ReopenIndex() {
 }
 
 // Индексация адресов
-func (a *ElasticAddressRepository) Index(isFull bool, start time.Time, guids []string, indexChan chan<- entity.IndexObject) error {
+func (a *ElasticAddressRepository) Index(isFull bool, start time.Time, guids []string, regions []string, indexChan chan<- entity.IndexObject) error {
 	done := make(chan bool)
 	// Создает канал для работы с объектами
 	a.jobs = make(chan dto.JsonAddressDto, a.noOfWorkers)
@@ -752,7 +1041,7 @@ func (a *ElasticAddressRepository) Index(isFull bool, start time.Time, guids []s
 	// Обновляет индекс
 	a.Refresh()
 	// Подготавливает фильтр для получения элементов
-	query := a.prepareIndexQuery(isFull, start, guids)
+	query := a.prepareIndexQuery(isFull, start, guids, regions)
 	// Получает общее количество элементов по фильтру
 	queryCount := a.calculateIndexCount(query)
 	// Получает элементы из индекса для переиндексации
@@ -769,7 +1058,7 @@ func (a *ElasticAddressRepository) Index(isFull bool, start time.Time, guids []s
 }
 
 // Подготовить фильтр для получения элементов
-func (a *ElasticAddressRepository) prepareIndexQuery(isFull bool, start time.Time, guids []string) elastic.Query {
+func (a *ElasticAddressRepository) prepareIndexQuery(isFull bool, start time.Time, guids []string, regions []string) elastic.Query {
 	var query elastic.Query
 	var queries []elastic.Query
 	// Проверяет, является ли индексация полной
@@ -789,6 +1078,10 @@ func (a *ElasticAddressRepository) prepareIndexQuery(isFull bool, start time.Tim
 		// Индексирует все элементы в индексе
 		a.logger.Info("Full indexing...")
 	}
+	// Добавляет фильтр на ограничение выборки по регионам
+	if len(regions) > 0 {
+		queries = append(queries, elastic.NewTermsQuery("region_code", util.ConvertStringSliceToInterface(regions)...))
+	}
 	query = elastic.NewBoolQuery().Must(queries...)
 
 	return query
@@ -892,16 +1185,7 @@ func (a *ElasticAddressRepository) prepareItemsBeforeSave(wg *sync.WaitGroup) {
 
 		// Ищет родительские объекты и дополняет адрес текущего объекта
 		if guid != "" {
-			var search *entity.AddressObject
-			searchObject := entity.AddressObject{}
-			// Ищет родительский объект в кэше
-			searchResult := a.indexCache.Get(guid, &searchObject)
-			if searchResult == nil {
-				search, _ = a.GetByGuid(guid)
-			} else {
-				search = searchResult.(*entity.AddressObject)
-			}
-
+			search := a.getParent(guid)
 			if search != nil {
 				// Конвертирует объект адреса в DTO
 				dtoItem.GetFromEntity(*search)
@@ -1007,12 +1291,57 @@ func (a *ElasticAddressRepository) prepareItemsBeforeSave(wg *sync.WaitGroup) {
 			address.StreetFull += util.PrepareFullName(address.StreetType, address.Street)
 		}
 
+		// Формирует адрес объекта в муниципальном делении
+		a.prepareMunAddress(&address)
+
 		a.results <- address
 	}
 
 	wg.Done()
 }
 
+// Получить родительский объект из кэша или БД
+func (a *ElasticAddressRepository) getParent(guid string) *entity.AddressObject {
+	searchObject := entity.AddressObject{}
+	// Ищет родительский объект в кэше
+	searchResult := a.indexCache.Get(guid, &searchObject)
+	if searchResult != nil {
+		return searchResult.(*entity.AddressObject)
+	}
+	search, _ := a.GetByGuid(guid)
+
+	return search
+}
+
+// Сформировать адрес объекта в муниципальном делении
+func (a *ElasticAddressRepository) prepareMunAddress(address *dto.JsonAddressDto) {
+	address.MunFullAddress = address.FullName
+	address.MunAddressSuggest = util.PrepareSuggest("", address.ShortName, address.FormalName)
+	guid := address.MunParentGuid
+	if guid == "" {
+		guid = address.ParentGuid
+	}
+
+	// Поднимается по цепочке родителей, у объектов без муниципальной иерархии используется административная
+	for depth := 0; guid != "" && depth < maxHierarchyDepth; depth++ {
+		parent := a.getParent(guid)
+		if parent == nil {
+			break
+		}
+		fullName := parent.FullName
+		if fullName == "" {
+			fullName = util.PrepareFullName(parent.ShortName, parent.FormalName)
+		}
+		address.MunFullAddress = fullName + ", " + address.MunFullAddress
+		address.MunAddressSuggest = util.PrepareSuggest("", parent.ShortName, parent.FormalName) + ", " + address.MunAddressSuggest
+
+		guid = parent.MunParentGuid
+		if guid == "" {
+			guid = parent.ParentGuid
+		}
+	}
+}
+
 // Обновить элементы в индексе
 func (a *ElasticAddressRepository) saveIndexItems(done chan bool, begin time.Time, total int64, indexChan chan<- entity.IndexObject) {
 	// Получает объект для работы с пачками элементов
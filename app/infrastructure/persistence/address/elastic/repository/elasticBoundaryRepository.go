@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	// Структура индекса границ административных объектов в эластике
+	boundaryIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": 0,
+		  "refresh_interval": "5s",
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "id": {
+			"type": "keyword"
+		  },
+		  "ao_guid": {
+			"type": "keyword"
+		  },
+		  "ao_level": {
+			"type": "integer"
+		  },
+		  "admin_level": {
+			"type": "integer"
+		  },
+		  "name": {
+			"type": "keyword"
+		  },
+		  "shape": {
+			"type": "geo_shape"
+		  }
+		}
+	  }
+	}
+	`
+	// Запрос поиска границ, содержащих точку
+	boundaryPointQuery = `{"bool":{"filter":{"geo_shape":{"shape":{"shape":{"type":"point","coordinates":[%f,%f]},"relation":"intersects"}}}}}`
+	// Максимальное количество границ, содержащих точку
+	maxBoundaryHits = 100
+)
+
+// Репозиторий границ административных объектов в эластике
+type ElasticBoundaryRepository struct {
+	elasticClient *elasticHelper.Client      // Клиент эластика
+	logger        interfaces.LoggerInterface // Логгер
+	batchSize     int                        // Размер пачки для обновления
+	indexName     string                     // Название индекса
+}
+
+// Инициализация репозитория
+func NewElasticBoundaryRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.BoundaryRepositoryInterface {
+	return &ElasticBoundaryRepository{
+		elasticClient: elasticClient,
+		logger:        logger,
+		batchSize:     batchSize,
+		indexName:     prefix + entity.Boundary{}.TableName(),
+	}
+}
+
+// Инициализация индекса
+func (b *ElasticBoundaryRepository) Init() error {
+	return b.elasticClient.CreateIndex(b.indexName, boundaryIndexSettings)
+}
+
+// Получить название индекса
+func (b *ElasticBoundaryRepository) GetIndexName() string {
+	return b.indexName
+}
+
+// Удалить индекс
+func (b *ElasticBoundaryRepository) Clear() error {
+	return b.elasticClient.DropIndex(b.indexName)
+}
+
+// Сохранить границы
+func (b *ElasticBoundaryRepository) Save(items []entity.Boundary) error {
+	if len(items) == 0 {
+		return nil
+	}
+	bulk := b.elasticClient.Client.Bulk().Index(b.indexName)
+	for _, item := range items {
+		saveItem := dto.JsonBoundaryDto{}
+		saveItem.GetFromEntity(item)
+		bulk.Add(elastic.NewBulkIndexRequest().Id(saveItem.ID).Doc(saveItem))
+	}
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		return err
+	}
+	if res != nil && res.Errors {
+		detail := b.elasticClient.GetBulkError(res)
+		return fmt.Errorf("%s: %s", detail.Type, detail.Reason)
+	}
+	b.elasticClient.RefreshIndexes([]string{b.indexName})
+
+	return nil
+}
+
+// Получить границы, содержащие точку
+func (b *ElasticBoundaryRepository) GetByPoint(lat float64, lon float64) ([]*entity.Boundary, error) {
+	res, err := b.elasticClient.Client.
+		Search(b.indexName).
+		Query(elastic.NewRawStringQuery(fmt.Sprintf(boundaryPointQuery, lon, lat))).
+		FetchSourceContext(elastic.NewFetchSourceContext(true).Exclude("shape")).
+		Size(maxBoundaryHits).
+		Do(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.Boundary
+	for _, hit := range res.Hits.Hits {
+		var item dto.JsonBoundaryDto
+		// Конвертирует структуру ответа в DTO
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	// Структура индекса журнала изменений в эластике
+	changeLogIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": 0,
+		  "refresh_interval": "5s",
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "id": {
+			"type": "keyword"
+		  },
+		  "version_id": {
+			"type": "integer"
+		  },
+		  "object_type": {
+			"type": "keyword"
+		  },
+		  "guid": {
+			"type": "keyword"
+		  },
+		  "change_type": {
+			"type": "keyword"
+		  },
+		  "region_code": {
+			"type": "keyword"
+		  }
+		}
+	  }
+	}
+	`
+)
+
+// Репозиторий журнала изменений в эластике
+type ElasticChangeLogRepository struct {
+	elasticClient *elasticHelper.Client      // Клиент эластика
+	logger        interfaces.LoggerInterface // Логгер
+	batchSize     int                        // Размер пачки для обновления
+	indexName     string                     // Название индекса
+}
+
+// Инициализация репозитория
+func NewElasticChangeLogRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.ChangeLogRepositoryInterface {
+	return &ElasticChangeLogRepository{
+		elasticClient: elasticClient,
+		logger:        logger,
+		batchSize:     batchSize,
+		indexName:     prefix + entity.ChangeLogItem{}.TableName(),
+	}
+}
+
+// Инициализация индекса
+func (c *ElasticChangeLogRepository) Init() error {
+	return c.elasticClient.CreateIndex(c.indexName, changeLogIndexSettings)
+}
+
+// Получить назваине индекса
+func (c *ElasticChangeLogRepository) GetIndexName() string {
+	return c.indexName
+}
+
+// Удалить индекс
+func (c *ElasticChangeLogRepository) Clear() error {
+	return c.elasticClient.DropIndex(c.indexName)
+}
+
+// Сохранить записи журнала изменений
+func (c *ElasticChangeLogRepository) Save(items []entity.ChangeLogItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+	bulk := c.elasticClient.Client.Bulk().Index(c.indexName)
+	for _, item := range items {
+		saveItem := dto.JsonChangeLogDto{}
+		saveItem.GetFromEntity(item)
+		bulk.Add(elastic.NewBulkIndexRequest().Id(saveItem.ID).Doc(saveItem))
+	}
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		return err
+	}
+	if res != nil && res.Errors {
+		detail := c.elasticClient.GetBulkError(res)
+		return fmt.Errorf("%s: %s", detail.Type, detail.Reason)
+	}
+	c.elasticClient.RefreshIndexes([]string{c.indexName})
+
+	return nil
+}
+
+// Получить записи журнала изменений версий после указанной, начиная с записи после afterId
+func (c *ElasticChangeLogRepository) GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, error) {
+	search := c.elasticClient.Client.
+		Search(c.indexName).
+		Query(elastic.NewRangeQuery("version_id").Gt(sinceVersion)).
+		Sort("id", true).
+		Size(int(size))
+	if afterId != "" {
+		search.SearchAfter(afterId)
+	}
+	res, err := search.Do(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.ChangeLogItem
+	for _, hit := range res.Hits.Hits {
+		var item dto.JsonChangeLogDto
+		// Конвертирует структуру ответа в DTO
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
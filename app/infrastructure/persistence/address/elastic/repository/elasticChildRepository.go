@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"github.com/olivere/elastic/v7"
+	"io"
+	"sync"
+	"time"
+)
+
+// Описание объектов, полный адрес которых формируется по адресу родителя: помещений или участков
+type childObjects struct {
+	name         string                                    // Название объектов в логах во множественном числе
+	title        string                                    // Название объекта в логах
+	settings     string                                    // Структура индекса
+	guidField    string                                    // Поле GUID объекта
+	parentField  string                                    // Поле GUID родительского объекта
+	fullNumField string                                    // Поле полного номера объекта
+	newDto       func() dto.ChildObjectDto                 // Создать пустой DTO
+	fromEntity   func(item interface{}) dto.ChildObjectDto // Конвертировать объект из канала импорта в DTO
+}
+
+// Общий репозиторий помещений и участков в эластике
+type elasticChildRepository struct {
+	elasticClient *elasticHelper.Client      // Клиент эластика
+	logger        interfaces.LoggerInterface // Логгер
+	batchSize     int                        // Размер пачки для обновления
+	indexName     string                     // Название индекса
+	objects       childObjects               // Описание хранимых объектов
+}
+
+// Инициализация общего репозитория
+func newElasticChildRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, indexName string, objects childObjects) *elasticChildRepository {
+	return &elasticChildRepository{
+		elasticClient: elasticClient,
+		logger:        logger,
+		batchSize:     batchSize,
+		indexName:     indexName,
+		objects:       objects,
+	}
+}
+
+// Инициализация индекса
+func (a *elasticChildRepository) Init() error {
+	return a.elasticClient.CreateIndex(a.indexName, a.objects.settings)
+}
+
+// Получить назваине индекса
+func (a *elasticChildRepository) GetIndexName() string {
+	return a.indexName
+}
+
+// Удалить индекс
+func (a *elasticChildRepository) Clear() error {
+	return a.elasticClient.DropIndex(a.indexName)
+}
+
+// Конвертировать ответ эластика в DTO
+func (a *elasticChildRepository) unmarshal(source json.RawMessage) (dto.ChildObjectDto, error) {
+	item := a.objects.newDto()
+	if err := json.Unmarshal(source, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Получить элементы из индекса через ScrollApi
+func (a *elasticChildRepository) scroll(scrollService *elastic.ScrollService) ([]dto.ChildObjectDto, error) {
+	scrollData, err := a.elasticClient.ScrollData(scrollService, a.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []dto.ChildObjectDto
+	// Получает данные из эластика пачками
+	for _, hit := range scrollData {
+		item, err := a.unmarshal(hit.Source)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Найти объект по GUID
+func (a *elasticChildRepository) getByGuid(guid string) (dto.ChildObjectDto, error) {
+	res, err := a.elasticClient.Client.
+		Search(a.indexName).
+		Query(elastic.NewTermQuery(a.objects.guidField, guid)).
+		Size(1).
+		Do(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Hits.Hits) == 0 {
+		return nil, nil
+	}
+
+	return a.unmarshal(res.Hits.Hits[0].Source)
+}
+
+// Получить объекты по GUID
+func (a *elasticChildRepository) getByGuidList(guids []string) ([]dto.ChildObjectDto, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewTermsQuery(a.objects.guidField, util.ConvertStringSliceToInterface(guids)...))
+
+	return a.scroll(scrollService)
+}
+
+// Найти объекты по GUID родителя
+func (a *elasticChildRepository) getByParentGuid(guid string) ([]dto.ChildObjectDto, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewTermQuery(a.objects.parentField, guid)).
+		Sort(a.objects.fullNumField, true)
+
+	return a.scroll(scrollService)
+}
+
+// Обновить коллекцию объектов
+func (a *elasticChildRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.ChildObjectDto)
+
+	// Цикл получения объекта из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := a.objects.fromEntity(d)
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.GetGuid()] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.GetId())
+		}
+
+		// Отправляет запросы в эластик при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to index")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в эластик
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to index")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " import execution time")
+	a.Refresh()
+	count <- int(total)
+}
+
+// Сохраняет данные в эластик
+func (a *elasticChildRepository) update(updated map[string]dto.ChildObjectDto, deleted []string, isFull bool) {
+	bulk := a.GetBulkService()
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.getByGuidList(updatedKeys)
+		for _, item := range items {
+			if updateItem, ok := updated[item.GetGuid()]; ok {
+				updateItem.UpdateFromExistItem(item)
+			}
+		}
+	}
+	for k, item := range updated {
+		bulk.Add(elastic.NewBulkIndexRequest().Id(item.GetId()).Doc(item))
+		delete(updated, k)
+	}
+	for _, item := range deleted {
+		bulk.Add(elastic.NewBulkDeleteRequest().Id(item))
+	}
+
+	a.commitBulk(bulk, "Add "+a.objects.name+" bulk commit failed")
+}
+
+// Отправляет пачку запросов в эластик
+func (a *elasticChildRepository) commitBulk(bulk *elastic.BulkService, message string) {
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal(message)
+	}
+	if res != nil && res.Errors {
+		a.logger.WithFields(interfaces.LoggerFields{"error": a.elasticClient.GetBulkError(res)}).Fatal(message)
+	}
+}
+
+// Подсчитать количество объектов в БД по фильтру
+func (a *elasticChildRepository) CountAllData(query interface{}) (int64, error) {
+	if query == nil {
+		query = elastic.NewBoolQuery()
+	}
+	return a.elasticClient.CountAllData(a.GetIndexName(), query.(elastic.Query))
+}
+
+// Обновить индекс
+func (a *elasticChildRepository) Refresh() {
+	a.elasticClient.RefreshIndexes([]string{a.GetIndexName()})
+}
+
+// Получить объект для работы с пачками элементов
+func (a *elasticChildRepository) GetBulkService() *elastic.BulkService {
+	return a.elasticClient.Client.Bulk().Index(a.GetIndexName())
+}
+
+// Индексация объектов
+func (a *elasticChildRepository) Index(start time.Time, GetIndexObjects repository.GetIndexObjects) error {
+	begin := time.Now()
+	batch := a.batchSize
+	// Ограничивает размер пачки при поиске
+	if batch > 10000 {
+		batch = 10000
+	}
+	a.Refresh()
+	query := elastic.NewRangeQuery("bazis_update_date").Gte(start.Format(util.TimeFormat))
+	total, _ := a.CountAllData(query)
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing "+a.objects.name, false)
+
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(query).
+		Size(batch)
+	ctx := context.Background()
+	scrollService.Scroll("1m")
+	count := 0
+
+	// Получает данные из эластика пачками
+	for {
+		res, err := scrollService.Do(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.Hits.Hits) == 0 {
+			break
+		}
+		var list []dto.ChildObjectDto
+		var guids []string
+		for _, hit := range res.Hits.Hits {
+			item, err := a.unmarshal(hit.Source)
+			if err != nil {
+				return err
+			}
+			guids = append(guids, item.GetParentGuid())
+			list = append(list, item)
+		}
+
+		// Формирует полный адрес объекта по адресу родителя
+		bulk := a.GetBulkService()
+		objectsList := GetIndexObjects(util.UniqueStringSlice(guids))
+		for _, item := range list {
+			bar.Increment()
+			object, ok := objectsList[item.GetParentGuid()]
+			if !ok {
+				continue
+			}
+			item.UpdateFullAddress(object.FullAddress)
+			item.UpdateBazisDate()
+			bulk.Add(elastic.NewBulkIndexRequest().Id(item.GetId()).Doc(item))
+		}
+		if bulk.NumberOfActions() > 0 {
+			count += bulk.NumberOfActions()
+			a.commitBulk(bulk, a.objects.title+" index bulk commit failed")
+		}
+	}
+
+	// Принудительно закрывает сервис выборки элементов
+	if err := scrollService.Clear(ctx); err != nil {
+		a.logger.Error(err.Error())
+	}
+	bar.Finish()
+	a.Refresh()
+	a.logger.WithFields(interfaces.LoggerFields{"count": count, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " index execution time")
+
+	return nil
+}
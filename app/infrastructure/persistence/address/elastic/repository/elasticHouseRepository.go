@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
 	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
@@ -13,6 +14,7 @@ import (
 	"github.com/olivere/elastic/v7"
 	"io"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -253,6 +255,37 @@ func (a *ElasticHouseRepository) GetByGuid(guid string) (*entity.HouseObject, er
 	return nil, nil
 }
 
+// Найти ближайший дом в радиусе в метрах
+func (a *ElasticHouseRepository) GetNearest(lon float64, lat float64, radius int) (*entity.HouseObject, error) {
+	res, err := a.elasticClient.Client.
+		Search(a.indexName).
+		Query(elastic.NewBoolQuery().Filter(
+			elastic.NewGeoDistanceQuery("location").Lon(lon).Lat(lat).Distance(strconv.Itoa(radius) + "m"))).
+		Size(1).
+		SortBy(
+			elastic.NewGeoDistanceSort("location").
+				Asc().
+				Point(lat, lon).
+				SortMode("min")).
+		Do(context.Background())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var item *dto.JsonHouseDto
+	// Конвертирует структуру ответа в DTO
+	if len(res.Hits.Hits) > 0 {
+		if err := json.Unmarshal(res.Hits.Hits[0].Source, &item); err != nil {
+			return nil, err
+		}
+
+		return item.ToEntity(), nil
+	}
+
+	return nil, nil
+}
+
 // Получить дома по GUID
 func (a *ElasticHouseRepository) GetByGuidList(guids []string) ([]*entity.HouseObject, error) {
 	if len(guids) == 0 {
@@ -282,6 +315,35 @@ func (a *ElasticHouseRepository) GetByGuidList(guids []string) ([]*entity.HouseO
 	return items, nil
 }
 
+// Найти дома по идентификаторам объектов
+func (a *ElasticHouseRepository) GetByIdList(ids []string) ([]*entity.HouseObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewTermsQuery("house_id", util.ConvertStringSliceToInterface(ids)...))
+
+	scrollData, err := a.elasticClient.ScrollData(scrollService, a.batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.HouseObject
+	var item *dto.JsonHouseDto
+
+	// Получает данные из эластика пачками
+	for _, hit := range scrollData {
+		// Конвертирует структуру ответа в DTO
+		if err := json.Unmarshal(hit.Source, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
 // Найти дома по GUID адресов
 func (a *ElasticHouseRepository) GetByAddressGuidList(guids []string) ([]*entity.HouseObject, error) {
 	if len(guids) == 0 {
@@ -296,6 +358,35 @@ func (a *ElasticHouseRepository) GetByAddressGuidList(guids []string) ([]*entity
 	return a.scroll(scrollService)
 }
 
+// Выгрузить дома по фильтру пачками
+func (a *ElasticHouseRepository) Export(filter entity.ExportFilter, handler func(items []*entity.HouseObject) error) error {
+	var queries []elastic.Query
+	if len(filter.AddressGuids) > 0 {
+		queries = append(queries, elastic.NewTermsQuery("ao_guid", util.ConvertStringSliceToInterface(filter.AddressGuids)...))
+	}
+	if !filter.UpdatedSince.IsZero() {
+		queries = append(queries, elastic.NewRangeQuery("bazis_update_date").Gte(filter.UpdatedSince.Format(util.TimeFormat)))
+	}
+	// Инициализирует сервис выборки элементов через ScrollApi
+	scrollService := a.elasticClient.Client.Scroll(a.GetIndexName()).
+		Query(elastic.NewBoolQuery().Filter(queries...)).
+		Sort("_doc", true)
+
+	return a.elasticClient.ScrollBatches(scrollService, a.batchSize, func(hits []*elastic.SearchHit) error {
+		var items []*entity.HouseObject
+		for _, hit := range hits {
+			var item dto.JsonHouseDto
+			// Конвертирует структуру ответа в DTO
+			if err := json.Unmarshal(hit.Source, &item); err != nil {
+				return err
+			}
+			items = append(items, item.ToEntity())
+		}
+
+		return handler(items)
+	})
+}
+
 // Найти дома по GUID адреса
 func (a *ElasticHouseRepository) GetByAddressGuid(guid string) ([]*entity.HouseObject, error) {
 	if guid == "" {
@@ -333,9 +424,43 @@ func (a *ElasticHouseRepository) GetAddressByTerm(term string, size int64, from
 	if size == 0 {
 		size = 100
 	}
+	limit := from + size
+	var items []*entity.HouseObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
 
-	queries := []elastic.Query{elastic.NewMatchQuery("address_suggest", term).Operator("and")}
-	queries = a.prepareFilter(queries, filter...)
+// Найти дома по варианту подстроки
+func (a *ElasticHouseRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error) {
+
+	match := elastic.NewMatchQuery("address_suggest", variant.Term).Operator("and")
+	if variant.Fuzzy {
+		match.Fuzziness("AUTO").PrefixLength(1)
+	}
+	queries := a.prepareFilter([]elastic.Query{match}, filter...)
 	if queries == nil {
 		return nil, nil
 	}
@@ -343,7 +468,6 @@ func (a *ElasticHouseRepository) GetAddressByTerm(term string, size int64, from
 	res, err := a.elasticClient.Client.
 		Search(a.indexName).
 		Query(elastic.NewBoolQuery().Must(queries...)).
-		From(int(from)).
 		Size(int(size)).
 		Sort("full_address", true).
 		Do(context.Background())
@@ -415,13 +539,24 @@ func (a *ElasticHouseRepository) InsertUpdateCollection(wg *sync.WaitGroup, chan
 		if d == nil {
 			break
 		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
 		total++
 		saveItem := dto.JsonHouseDto{}
 		saveItem.GetFromEntity(d.(entity.HouseObject))
 		// Проверяет активность объекта
 		if saveItem.IsActive() {
-			// Добавляет объект в очередь на сохранение
-			updated[saveItem.AoGuid] = saveItem
+			// Добавляет объект в очередь на сохранение.
+			// Ключом служит GUID дома: по GUID адреса в пачке оставался только один дом улицы,
+			// а дома ГАР до загрузки иерархии не имеют GUID адреса вовсе
+			updated[saveItem.HouseGuid] = saveItem
 		} else {
 			// Добавляет объект в очередь на удаление
 			deleted = append(deleted, saveItem.ID)
@@ -464,10 +599,10 @@ func (a *ElasticHouseRepository) update(updated map[string]dto.JsonHouseDto, del
 
 		items, _ := a.GetByGuidList(updatedKeys)
 		for _, item := range items {
-			updateItem, ok := updated[item.AoGuid]
+			updateItem, ok := updated[item.HouseGuid]
 			if ok {
 				updateItem.UpdateFromExistItem(*item)
-				updated[item.AoGuid] = updateItem
+				updated[item.HouseGuid] = updateItem
 			}
 		}
 	}
@@ -488,6 +623,67 @@ func (a *ElasticHouseRepository) update(updated map[string]dto.JsonHouseDto, del
 	}
 }
 
+// Обновить иерархию домов
+func (a *ElasticHouseRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	if len(items) == 0 {
+		return nil
+	}
+	bulk := a.GetBulkService()
+	// Обновляет дату изменения для перестроения полного адреса при индексации
+	updateDate := time.Now().Format(util.TimeFormat)
+	for _, item := range items {
+		bulk.Add(elastic.NewBulkUpdateRequest().Id(item.ObjectId).Doc(map[string]interface{}{
+			"ao_guid":           item.ParentGuid,
+			"bazis_update_date": updateDate,
+		}))
+	}
+
+	return a.updateBulk(bulk)
+}
+
+// Обновить параметры домов
+func (a *ElasticHouseRepository) UpdateParams(items []entity.ParamObject) error {
+	bulk := a.GetBulkService()
+	for _, item := range items {
+		var field string
+		switch item.TypeId {
+		case entity.ParamPostalCode:
+			field = "postal_code"
+		case entity.ParamOkato:
+			field = "okato"
+		case entity.ParamOktmo:
+			field = "oktmo"
+		case entity.ParamCadNum:
+			field = "cad_num"
+		default:
+			continue
+		}
+		bulk.Add(elastic.NewBulkUpdateRequest().Id(item.ObjectId).Doc(map[string]interface{}{
+			field: item.Value,
+		}))
+	}
+	if bulk.NumberOfActions() == 0 {
+		return nil
+	}
+
+	return a.updateBulk(bulk)
+}
+
+// Выполняет частичное обновление пачки элементов
+func (a *ElasticHouseRepository) updateBulk(bulk *elastic.BulkService) error {
+	res, err := bulk.Do(context.Background())
+	if err != nil {
+		return err
+	}
+	if res != nil && res.Errors {
+		if bulkErr := a.elasticClient.GetBulkUpdateError(res); bulkErr != nil {
+			return errors.New(bulkErr.Reason)
+		}
+	}
+
+	return nil
+}
+
 // Подсчитать количество домов в БД по фильтру
 func (a *ElasticHouseRepository) CountAllData(query interface{}) (int64, error) {
 	if query == nil {
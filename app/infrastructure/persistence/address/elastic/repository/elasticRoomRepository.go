@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура индекса помещений в эластике
+	roomIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": 0,
+		  "refresh_interval": "5s",
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "room_id": {
+			"type": "keyword"
+		  },
+		  "room_guid": {
+			"type": "keyword"
+		  },
+		  "house_guid": {
+			"type": "keyword"
+		  },
+		  "region_code": {
+			"type": "keyword"
+		  },
+		  "flat_number": {
+			"type": "keyword"
+		  },
+		  "room_number": {
+			"type": "keyword"
+		  },
+		  "room_full_num": {
+			"type": "keyword"
+		  },
+		  "full_address": {
+			"type": "keyword"
+		  },
+		  "postal_code": {
+			"type": "keyword"
+		  },
+		  "cad_num": {
+			"type": "keyword"
+		  },
+		  "room_cad_num": {
+			"type": "keyword"
+		  },
+		  "end_date": {
+			"type": "date"
+		  },
+		  "start_date": {
+			"type": "date"
+		  },
+		  "bazis_update_date": {
+			"type": "date"
+		  },
+		  "update_date": {
+			"type": "date"
+		  }
+		}
+	  }
+	}
+	`
+)
+
+// Репозиторий помещений в эластике
+type ElasticRoomRepository struct {
+	*elasticChildRepository
+}
+
+// Инициализация репозитория
+func NewElasticRoomRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.RoomRepositoryInterface {
+	return &ElasticRoomRepository{
+		elasticChildRepository: newElasticChildRepository(elasticClient, logger, batchSize, prefix+entity.RoomObject{}.TableName(), childObjects{
+			name:         "rooms",
+			title:        "Room",
+			settings:     roomIndexSettings,
+			guidField:    "room_guid",
+			parentField:  "house_guid",
+			fullNumField: "room_full_num",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.JsonRoomDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.JsonRoomDto{}
+				saveItem.GetFromEntity(item.(entity.RoomObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты помещений
+func (a *ElasticRoomRepository) toEntities(items []dto.ChildObjectDto) []*entity.RoomObject {
+	var list []*entity.RoomObject
+	for _, item := range items {
+		list = append(list, item.(*dto.JsonRoomDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти помещение по GUID
+func (a *ElasticRoomRepository) GetByGuid(guid string) (*entity.RoomObject, error) {
+	item, err := a.getByGuid(guid)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item.(*dto.JsonRoomDto).ToEntity(), nil
+}
+
+// Получить помещения по GUID
+func (a *ElasticRoomRepository) GetByGuidList(guids []string) ([]*entity.RoomObject, error) {
+	items, err := a.getByGuidList(guids)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
+
+// Найти помещения по GUID дома
+func (a *ElasticRoomRepository) GetByHouseGuid(guid string) ([]*entity.RoomObject, error) {
+	items, err := a.getByParentGuid(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/dto"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура индекса участков в эластике
+	steadIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": 0,
+		  "refresh_interval": "5s",
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "stead_id": {
+			"type": "keyword"
+		  },
+		  "stead_guid": {
+			"type": "keyword"
+		  },
+		  "parent_guid": {
+			"type": "keyword"
+		  },
+		  "region_code": {
+			"type": "keyword"
+		  },
+		  "number": {
+			"type": "keyword"
+		  },
+		  "stead_full_num": {
+			"type": "keyword"
+		  },
+		  "full_address": {
+			"type": "keyword"
+		  },
+		  "postal_code": {
+			"type": "keyword"
+		  },
+		  "cad_num": {
+			"type": "keyword"
+		  },
+		  "okato": {
+			"type": "keyword"
+		  },
+		  "oktmo": {
+			"type": "keyword"
+		  },
+		  "end_date": {
+			"type": "date"
+		  },
+		  "start_date": {
+			"type": "date"
+		  },
+		  "bazis_update_date": {
+			"type": "date"
+		  },
+		  "update_date": {
+			"type": "date"
+		  }
+		}
+	  }
+	}
+	`
+)
+
+// Репозиторий участков в эластике
+type ElasticSteadRepository struct {
+	*elasticChildRepository
+}
+
+// Инициализация репозитория
+func NewElasticSteadRepository(elasticClient *elasticHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.SteadRepositoryInterface {
+	return &ElasticSteadRepository{
+		elasticChildRepository: newElasticChildRepository(elasticClient, logger, batchSize, prefix+entity.SteadObject{}.TableName(), childObjects{
+			name:         "steads",
+			title:        "Stead",
+			settings:     steadIndexSettings,
+			guidField:    "stead_guid",
+			parentField:  "parent_guid",
+			fullNumField: "stead_full_num",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.JsonSteadDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.JsonSteadDto{}
+				saveItem.GetFromEntity(item.(entity.SteadObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты участков
+func (a *ElasticSteadRepository) toEntities(items []dto.ChildObjectDto) []*entity.SteadObject {
+	var list []*entity.SteadObject
+	for _, item := range items {
+		list = append(list, item.(*dto.JsonSteadDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти участок по GUID
+func (a *ElasticSteadRepository) GetByGuid(guid string) (*entity.SteadObject, error) {
+	item, err := a.getByGuid(guid)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item.(*dto.JsonSteadDto).ToEntity(), nil
+}
+
+// Получить участки по GUID
+func (a *ElasticSteadRepository) GetByGuidList(guids []string) ([]*entity.SteadObject, error) {
+	items, err := a.getByGuidList(guids)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
+
+// Найти участки по GUID адреса
+func (a *ElasticSteadRepository) GetByAddressGuid(guid string) ([]*entity.SteadObject, error) {
+	items, err := a.getByParentGuid(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Объект границы во встроенном хранилище
+type JsonBoundaryDto struct {
+	ID         string              `json:"id"`
+	AoGuid     string              `json:"ao_guid"`
+	AoLevel    int                 `json:"ao_level"`
+	AdminLevel int                 `json:"admin_level"`
+	Name       string              `json:"name"`
+	Polygons   entity.MultiPolygon `json:"polygons"`
+	MinLat     float64             `json:"min_lat"`
+	MinLon     float64             `json:"min_lon"`
+	MaxLat     float64             `json:"max_lat"`
+	MaxLon     float64             `json:"max_lon"`
+}
+
+// Конвертирует объект границы встроенного хранилища в границу
+func (item *JsonBoundaryDto) ToEntity() *entity.Boundary {
+	return &entity.Boundary{
+		ID:         item.ID,
+		AoGuid:     item.AoGuid,
+		AoLevel:    item.AoLevel,
+		AdminLevel: item.AdminLevel,
+		Name:       item.Name,
+		Polygons:   item.Polygons,
+	}
+}
+
+// Конвертирует границу в объект границы встроенного хранилища
+func (item *JsonBoundaryDto) GetFromEntity(entity entity.Boundary) {
+	item.ID = entity.ID
+	item.AoGuid = entity.AoGuid
+	item.AoLevel = entity.AoLevel
+	item.AdminLevel = entity.AdminLevel
+	item.Name = entity.Name
+	item.Polygons = entity.Polygons
+	item.MinLat, item.MinLon, item.MaxLat, item.MaxLon = entity.Polygons.Bound()
+}
+
+// Получить документ поискового индекса с прямоугольником границы
+func (item *JsonBoundaryDto) ToSearchDocument() map[string]interface{} {
+	return map[string]interface{}{
+		"ao_level": item.AoLevel,
+		"min_lat":  item.MinLat,
+		"min_lon":  item.MinLon,
+		"max_lat":  item.MaxLat,
+		"max_lon":  item.MaxLon,
+	}
+}
@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"time"
+)
+
+// Объект земельного участка в встроенном хранилище
+type JsonSteadDto struct {
+	ID              string `json:"stead_id"`
+	SteadGuid       string `json:"stead_guid"`
+	ParentGuid      string `json:"parent_guid"`
+	RegionCode      string `json:"region_code"`
+	Number          string `json:"number"`
+	SteadFullNum    string `json:"stead_full_num"`
+	FullAddress     string `json:"full_address"`
+	PostalCode      string `json:"postal_code"`
+	Okato           string `json:"okato"`
+	Oktmo           string `json:"oktmo"`
+	CadNum          string `json:"cad_num"`
+	DivType         string `json:"div_type"`
+	StartDate       string `json:"start_date"`
+	EndDate         string `json:"end_date"`
+	UpdateDate      string `json:"update_date"`
+	BazisUpdateDate string `json:"bazis_update_date"`
+}
+
+// Конвертирует объект участка встроенного хранилища в объект участка
+func (item *JsonSteadDto) ToEntity() *entity.SteadObject {
+	stead := entity.SteadObject{}
+	model.Copy(&stead, item)
+
+	return &stead
+}
+
+// Конвертирует объект участка в объект участка встроенного хранилища
+func (item *JsonSteadDto) GetFromEntity(entity entity.SteadObject) {
+	model.Copy(item, entity)
+
+	if item.SteadFullNum == "" {
+		item.SteadFullNum = entity.GetFullNum()
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.SteadFullNum
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *JsonSteadDto) IsActive() bool {
+	end, err := time.Parse("2006-01-02", item.EndDate)
+	if err != nil || end.Unix() <= time.Now().Unix() {
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *JsonSteadDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Получить идентификатор объекта
+func (item *JsonSteadDto) GetId() string {
+	return item.ID
+}
+
+// Получить GUID объекта
+func (item *JsonSteadDto) GetGuid() string {
+	return item.SteadGuid
+}
+
+// Получить GUID родительского объекта
+func (item *JsonSteadDto) GetParentGuid() string {
+	return item.ParentGuid
+}
+
+// Получить полный номер объекта
+func (item *JsonSteadDto) GetFullNum() string {
+	return item.SteadFullNum
+}
+
+// Получить полный адрес объекта
+func (item *JsonSteadDto) GetFullAddress() string {
+	return item.FullAddress
+}
+
+// Формирует полный адрес участка по родительскому адресу
+func (item *JsonSteadDto) UpdateFullAddress(parentAddress string) {
+	item.FullAddress = parentAddress + ", " + item.SteadFullNum
+}
+
+// Заполняет объект участка встроенного хранилища из данных участка
+func (item *JsonSteadDto) UpdateFromExistItem(exist ChildObjectDto) {
+	if exist.GetFullAddress() != "" {
+		item.FullAddress = exist.GetFullAddress()
+	}
+}
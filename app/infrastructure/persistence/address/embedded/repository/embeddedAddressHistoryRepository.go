@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	bolt "go.etcd.io/bbolt"
+	"sync"
+	"time"
+)
+
+// Репозиторий истории адресов во встроенном хранилище
+type EmbeddedAddressHistoryRepository struct {
+	logger         interfaces.LoggerInterface // Логгер
+	batchSize      int                        // Размер пачки для обновления
+	embeddedClient *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName     string                     // Название бакета с версиями адресов
+	guidBucketName string                     // Название бакета с идентификаторами версий по GUID адреса
+}
+
+// Инициализация репозитория
+func NewEmbeddedAddressHistoryRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, bucketName string) repository.AddressHistoryRepositoryInterface {
+
+	return &EmbeddedAddressHistoryRepository{
+		embeddedClient: embeddedClient,
+		logger:         logger,
+		batchSize:      batchSize,
+		bucketName:     bucketName,
+		guidBucketName: bucketName + "_guid",
+	}
+}
+
+// Инициализация бакетов
+func (a *EmbeddedAddressHistoryRepository) Init() error {
+	return a.embeddedClient.CreateBucket(a.bucketName, a.guidBucketName)
+}
+
+// Получить название бакета
+func (a *EmbeddedAddressHistoryRepository) GetIndexName() string {
+	return a.bucketName
+}
+
+// Удалить бакеты
+func (a *EmbeddedAddressHistoryRepository) Clear() error {
+	return a.embeddedClient.DropBucket(a.bucketName, a.guidBucketName)
+}
+
+// Получить ключ версии в бакете версий по GUID адреса
+func (a *EmbeddedAddressHistoryRepository) guidKey(guid string, id string) []byte {
+	return []byte(guid + keySeparator + id)
+}
+
+// Получить все версии адреса по GUID
+func (a *EmbeddedAddressHistoryRepository) GetByGuid(guid string) ([]*entity.AddressObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	var list []*entity.AddressObject
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		guidBucket := tx.Bucket([]byte(a.guidBucketName))
+		if bucket == nil || guidBucket == nil {
+			return nil
+		}
+		prefix := []byte(guid + keySeparator)
+		cursor := guidBucket.Cursor()
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			data := bucket.Get(k[len(prefix):])
+			if data == nil {
+				continue
+			}
+			var item dto.JsonAddressHistoryDto
+			if err := json.Unmarshal(data, &item); err != nil {
+				return err
+			}
+			list = append(list, item.ToEntity())
+		}
+
+		return nil
+	})
+
+	return list, err
+}
+
+// Получить версию адреса по идентификатору записи
+func (a *EmbeddedAddressHistoryRepository) GetById(id string) (*entity.AddressObject, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var item *dto.JsonAddressHistoryDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			return nil
+		}
+
+		return json.Unmarshal(data, &item)
+	})
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item.ToEntity(), nil
+}
+
+// Сохранить коллекцию версий адресов
+func (a *EmbeddedAddressHistoryRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	var items []dto.JsonAddressHistoryDto
+
+	// Цикл получения объекта адреса из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			a.update(items)
+			items = nil
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.JsonAddressHistoryDto{}
+		saveItem.GetFromEntity(d.(entity.AddressObject))
+		items = append(items, saveItem)
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(items) >= a.batchSize {
+			a.update(items)
+			items = nil
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	a.update(items)
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address history import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *EmbeddedAddressHistoryRepository) update(items []dto.JsonAddressHistoryDto) {
+	if len(items) == 0 {
+		return
+	}
+	err := a.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		guidBucket := tx.Bucket([]byte(a.guidBucketName))
+		for _, item := range items {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(item.ID), data); err != nil {
+				return err
+			}
+			if err := guidBucket.Put(a.guidKey(item.AoGuid, item.ID), nil); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add address history batch commit failed")
+	}
+}
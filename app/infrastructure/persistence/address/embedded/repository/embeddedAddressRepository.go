@@ -0,0 +1,1105 @@
+package repository
+
+import (
+	"encoding/json"
+	cache "github.com/AeroAgency/golang-bigcache-lib"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/dustin/go-humanize"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Максимальная глубина иерархии при формировании адреса
+	maxHierarchyDepth = 15
+)
+
+// Репозиторий адресов во встроенном хранилище
+type EmbeddedAddressRepository struct {
+	logger         interfaces.LoggerInterface // Логгер
+	batchSize      int                        // Размер пачки для обновления
+	embeddedClient *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName     string                     // Название бакета с адресами
+	guidBucketName string                     // Название бакета с идентификаторами адресов по GUID
+	jobs           chan dto.JsonAddressDto    // Список задач для индексации
+	results        chan dto.JsonAddressDto    // Список объектов индексации
+	noOfWorkers    int                        // Количество обработчиков индексации
+	indexCache     cache.CacheInterface       // Кэш объектов индексации
+}
+
+// Инициализация репозитория
+func NewEmbeddedAddressRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string, noOfWorkers int, cache cache.CacheInterface) repository.AddressRepositoryInterface {
+	if noOfWorkers == 0 {
+		noOfWorkers = 5
+	}
+	bucketName := prefix + entity.AddressObject{}.TableName()
+
+	return &EmbeddedAddressRepository{
+		logger:         logger,
+		embeddedClient: embeddedClient,
+		batchSize:      batchSize,
+		bucketName:     bucketName,
+		guidBucketName: bucketName + "_guid",
+		noOfWorkers:    noOfWorkers,
+		indexCache:     cache,
+	}
+}
+
+// Инициализация бакетов и поискового индекса
+func (a *EmbeddedAddressRepository) Init() error {
+	if err := a.embeddedClient.CreateBucket(a.bucketName, a.guidBucketName); err != nil {
+		return err
+	}
+	_, err := a.index()
+
+	return err
+}
+
+// Получить название бакета
+func (a *EmbeddedAddressRepository) GetIndexName() string {
+	return a.bucketName
+}
+
+// Удалить бакеты и поисковый индекс
+func (a *EmbeddedAddressRepository) Clear() error {
+	if err := a.embeddedClient.DropBucket(a.bucketName, a.guidBucketName); err != nil {
+		return err
+	}
+
+	return a.embeddedClient.DropIndex(a.bucketName)
+}
+
+// Получить поисковый индекс
+func (a *EmbeddedAddressRepository) index() (bleve.Index, error) {
+	return a.embeddedClient.OpenIndex(a.bucketName, a.indexMapping())
+}
+
+// Структура поискового индекса
+func (a *EmbeddedAddressRepository) indexMapping() mapping.IndexMapping {
+	indexMapping := embeddedHelper.NewIndexMapping()
+	document := indexMapping.DefaultMapping
+	for _, field := range []string{"address_suggest", "mun_address_suggest"} {
+		document.AddFieldMappingsAt(field, embeddedHelper.NewSuggestFieldMapping())
+	}
+	for _, field := range []string{"formal_name", "full_name"} {
+		document.AddFieldMappingsAt(field, embeddedHelper.NewTextFieldMapping())
+	}
+	for _, field := range []string{"ao_guid", "parent_guid", "mun_parent_guid", "short_name", "code", "postal_code", "full_address", "mun_full_address"} {
+		document.AddFieldMappingsAt(field, embeddedHelper.NewKeywordFieldMapping())
+	}
+	document.AddFieldMappingsAt("ao_level", embeddedHelper.NewNumericFieldMapping())
+	document.AddFieldMappingsAt("location", embeddedHelper.NewGeoPointFieldMapping())
+
+	return indexMapping
+}
+
+// Выполнить поиск и получить адреса в порядке результатов
+func (a *EmbeddedAddressRepository) search(request *bleve.SearchRequest) ([]*entity.AddressObject, error) {
+	index, err := a.index()
+	if err != nil {
+		return nil, err
+	}
+	res, err := index.Search(request)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ID)
+	}
+	items, err := a.getByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*entity.AddressObject
+	// Конвертирует DTO в объекты адресов
+	for _, item := range items {
+		list = append(list, item.ToEntity())
+	}
+
+	return list, nil
+}
+
+// Выполнить поиск и получить первый адрес
+func (a *EmbeddedAddressRepository) searchOne(request *bleve.SearchRequest) (*entity.AddressObject, error) {
+	request.Size = 1
+	items, err := a.search(request)
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить адреса из БД по идентификаторам
+func (a *EmbeddedAddressRepository) getByIds(ids []string) ([]dto.JsonAddressDto, error) {
+	var items []dto.JsonAddressDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		for _, id := range ids {
+			data := bucket.Get([]byte(id))
+			if data == nil {
+				continue
+			}
+			var item dto.JsonAddressDto
+			if err := json.Unmarshal(data, &item); err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+
+		return nil
+	})
+
+	return items, err
+}
+
+// Получить идентификаторы адресов по GUID
+func (a *EmbeddedAddressRepository) getIdsByGuids(guids []string) ([]string, error) {
+	var ids []string
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.guidBucketName))
+		if bucket == nil {
+			return nil
+		}
+		for _, guid := range guids {
+			if id := bucket.Get([]byte(guid)); id != nil {
+				ids = append(ids, string(id))
+			}
+		}
+
+		return nil
+	})
+
+	return ids, err
+}
+
+// Найти адрес по названию
+func (a *EmbeddedAddressRepository) GetByFormalName(term string) (*entity.AddressObject, error) {
+	return a.searchOne(bleve.NewSearchRequest(embeddedHelper.NewMatchQuery("formal_name", term)))
+}
+
+// Найти адреса по GUID
+func (a *EmbeddedAddressRepository) GetAddressByGuidList(guids []string) ([]*entity.AddressObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+	ids, err := a.getIdsByGuids(guids)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.GetAddressByIdList(ids)
+}
+
+// Найти адреса по идентификаторам объектов
+func (a *EmbeddedAddressRepository) GetAddressByIdList(ids []string) ([]*entity.AddressObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	items, err := a.getByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*entity.AddressObject
+	for _, item := range items {
+		list = append(list, item.ToEntity())
+	}
+
+	return list, nil
+}
+
+// Найти адрес по GUID
+func (a *EmbeddedAddressRepository) GetByGuid(guid string) (*entity.AddressObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	res, err := a.GetAddressByGuidList([]string{guid})
+	if err != nil || res == nil {
+		return nil, err
+	}
+
+	return res[0], nil
+}
+
+// Получить запрос поиска городов
+func (a *EmbeddedAddressRepository) citiesQuery() []query.Query {
+	return []query.Query{
+		embeddedHelper.NewTermsQuery("short_name", "г"),
+		embeddedHelper.NewNumbersQuery("ao_level", 1, 4),
+	}
+}
+
+// Найти город по названию
+func (a *EmbeddedAddressRepository) GetCityByFormalName(term string) (*entity.AddressObject, error) {
+	queries := append(a.citiesQuery(), embeddedHelper.NewMatchQuery("full_name", term))
+	request := bleve.NewSearchRequest(bleve.NewConjunctionQuery(queries...))
+	request.SortBy([]string{"ao_level", "-_score"})
+
+	return a.searchOne(request)
+}
+
+// Подсчитать количество адресов по фильтру
+func (a *EmbeddedAddressRepository) CountAllData(filter interface{}) (int64, error) {
+	searchQuery, ok := filter.(query.Query)
+	if !ok {
+		return a.embeddedClient.CountAllData(a.bucketName)
+	}
+	index, err := a.index()
+	if err != nil {
+		return 0, err
+	}
+	res, err := index.Search(bleve.NewSearchRequestOptions(searchQuery, 0, 0, false))
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(res.Total), nil
+}
+
+// Получить список всех городов
+func (a *EmbeddedAddressRepository) GetCities() ([]*entity.AddressObject, error) {
+	searchQuery := bleve.NewConjunctionQuery(a.citiesQuery()...)
+	total, err := a.CountAllData(searchQuery)
+	if err != nil || total == 0 {
+		return nil, err
+	}
+	request := bleve.NewSearchRequestOptions(searchQuery, int(total), 0, false)
+	request.SortBy([]string{"ao_level", "full_address"})
+
+	return a.search(request)
+}
+
+// Найти города по подстроке
+func (a *EmbeddedAddressRepository) GetCitiesByTerm(term string, size int64, from int64) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	request := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(
+		embeddedHelper.NewMatchQuery("address_suggest", term),
+		embeddedHelper.NewNumbersQuery("ao_level", 1, 4),
+	), int(size), int(from), false)
+	request.SortBy([]string{"ao_level", "full_address"})
+
+	return a.search(request)
+}
+
+// Найти адрес по подстроке
+func (a *EmbeddedAddressRepository) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	limit := from + size
+	var items []*entity.AddressObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
+
+// Найти адрес по варианту подстроки
+func (a *EmbeddedAddressRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	queries := a.prepareFilter([]query.Query{a.variantQuery(suggestField, variant)}, filter...)
+
+	request := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(queries...), int(size), 0, false)
+	request.SortBy([]string{"ao_level", "-_score", fullAddressField})
+
+	return a.search(request)
+}
+
+// Найти страницу адресов по последнему варианту подстроки после курсора, исключая адреса предыдущих вариантов
+func (a *EmbeddedAddressRepository) GetAddressByVariantAfter(variants []util.TermVariant, size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	searchQuery := bleve.NewBooleanQuery()
+	searchQuery.AddMust(a.prepareFilter([]query.Query{a.variantQuery(suggestField, variants[len(variants)-1])}, filter...)...)
+	for _, variant := range variants[:len(variants)-1] {
+		searchQuery.AddMustNot(a.variantQuery(suggestField, variant))
+	}
+
+	return a.searchAfter(searchQuery, size, after, fullAddressField)
+}
+
+// Получить страницу городов после курсора
+func (a *EmbeddedAddressRepository) GetCitiesAfter(size int64, after *entity.Cursor) ([]*entity.AddressObject, error) {
+	return a.searchAfter(bleve.NewConjunctionQuery(a.citiesQuery()...), size, after, "full_address")
+}
+
+// Получить страницу адресов по фильтру после курсора
+func (a *EmbeddedAddressRepository) GetListAfter(size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		fullAddressField = "mun_full_address"
+	}
+	var searchQuery query.Query = bleve.NewMatchAllQuery()
+	if queries := a.prepareFilter(nil, filter...); len(queries) > 0 {
+		searchQuery = bleve.NewConjunctionQuery(queries...)
+	}
+
+	return a.searchAfter(searchQuery, size, after, fullAddressField)
+}
+
+// Выполнить постраничную выборку адресов после курсора
+func (a *EmbeddedAddressRepository) searchAfter(searchQuery query.Query, size int64, after *entity.Cursor, fullAddressField string) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	request := bleve.NewSearchRequestOptions(searchQuery, int(size), 0, false)
+	request.SortBy([]string{"ao_level", fullAddressField, "_id"})
+	if after.HasPosition() {
+		request.SetSearchAfter([]string{embeddedHelper.NumericSortValue(float64(after.Level)), after.FullAddress, after.ID})
+	}
+
+	return a.search(request)
+}
+
+// Выгрузить адреса по фильтру пачками
+func (a *EmbeddedAddressRepository) Export(filter entity.ExportFilter, handler func(items []*entity.AddressObject) error) error {
+	regions := make(map[string]bool)
+	for _, region := range filter.Regions {
+		regions[region] = true
+	}
+	levels := make(map[int]bool)
+	for _, level := range filter.Levels {
+		levels[level] = true
+	}
+	updatedSince := ""
+	if !filter.UpdatedSince.IsZero() {
+		updatedSince = filter.UpdatedSince.Format(util.TimeFormat)
+	}
+
+	return a.embeddedClient.ScanBucket(a.bucketName, a.batchSize, func(values [][]byte) error {
+		var items []*entity.AddressObject
+		for _, value := range values {
+			var item dto.JsonAddressDto
+			if err := json.Unmarshal(value, &item); err != nil {
+				return err
+			}
+			if len(regions) > 0 && !regions[item.RegionCode] {
+				continue
+			}
+			if len(levels) > 0 && !levels[item.AoLevel] {
+				continue
+			}
+			if item.BazisUpdateDate < updatedSince {
+				continue
+			}
+			items = append(items, item.ToEntity())
+		}
+		if len(items) == 0 {
+			return nil
+		}
+
+		return handler(items)
+	})
+}
+
+// Получить запрос поиска по варианту подстроки
+func (a *EmbeddedAddressRepository) variantQuery(field string, variant util.TermVariant) query.Query {
+	if variant.Fuzzy {
+		return embeddedHelper.NewFuzzyMatchQuery(field, variant.Term)
+	}
+
+	return embeddedHelper.NewMatchQuery(field, variant.Term)
+}
+
+// Подготовить фильтр для запроса
+func (a *EmbeddedAddressRepository) prepareFilter(queries []query.Query, filters ...entity.FilterObject) []query.Query {
+	for _, filter := range filters {
+		if len(filter.Level.Values) > 0 {
+			var levels []float64
+			for _, level := range filter.Level.Values {
+				levels = append(levels, float64(level))
+			}
+			queries = append(queries, embeddedHelper.NewNumbersQuery("ao_level", levels...))
+		}
+		if filter.Level.Min > 0 || filter.Level.Max > 0 {
+			var min, max *float64
+			if filter.Level.Min > 0 {
+				value := float64(filter.Level.Min)
+				min = &value
+			}
+			if filter.Level.Max > 0 {
+				value := float64(filter.Level.Max)
+				max = &value
+			}
+			queries = append(queries, embeddedHelper.NewRangeQuery("ao_level", min, max))
+		}
+		if len(filter.ParentGuid.Values) > 0 {
+			parentField := "parent_guid"
+			if filter.Hierarchy == entity.MunHierarchy {
+				parentField = "mun_parent_guid"
+			}
+			queries = append(queries, embeddedHelper.NewTermsQuery(parentField, filter.ParentGuid.Values...))
+		}
+		if len(filter.KladrId.Values) > 0 {
+			queries = append(queries, embeddedHelper.NewTermsQuery("code", filter.KladrId.Values...))
+		}
+	}
+
+	return queries
+}
+
+// Проверить, запрошена ли муниципальная иерархия
+func (a *EmbeddedAddressRepository) isMunHierarchy(filters ...entity.FilterObject) bool {
+	for _, filter := range filters {
+		if filter.Hierarchy == entity.MunHierarchy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Найти адрес по почтовому индексу
+func (a *EmbeddedAddressRepository) GetAddressByPostal(term string, size int64, from int64) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	request := bleve.NewSearchRequestOptions(embeddedHelper.NewTermsQuery("postal_code", term), int(size), int(from), false)
+	request.SortBy([]string{"ao_level", "full_address"})
+
+	return a.search(request)
+}
+
+// Найти ближайший город по координатам
+func (a *EmbeddedAddressRepository) GetNearestCity(lon float64, lat float64) (*entity.AddressObject, error) {
+	maxLevel := 6.0
+	request, err := a.prepareGeoRequest(lon, lat, "20km", embeddedHelper.NewRangeQuery("ao_level", nil, &maxLevel))
+	if err != nil {
+		return nil, err
+	}
+
+	return a.searchOne(request)
+}
+
+// Найти ближайший адрес по координатам
+func (a *EmbeddedAddressRepository) GetNearestAddress(lon float64, lat float64, term string) (*entity.AddressObject, error) {
+	request, err := a.prepareGeoRequest(lon, lat, "5km", embeddedHelper.NewMatchQuery("address_suggest", term))
+	if err != nil {
+		return nil, err
+	}
+
+	return a.searchOne(request)
+}
+
+// Подготовить запрос поиска ближайших объектов
+func (a *EmbeddedAddressRepository) prepareGeoRequest(lon float64, lat float64, distance string, queries ...query.Query) (*bleve.SearchRequest, error) {
+	geoQuery := bleve.NewGeoDistanceQuery(lon, lat, distance)
+	geoQuery.SetField("location")
+	queries = append(queries, geoQuery)
+	request := bleve.NewSearchRequest(bleve.NewConjunctionQuery(queries...))
+	geoSort, err := search.NewSortGeoDistance("location", "m", lon, lat, false)
+	if err != nil {
+		return nil, err
+	}
+	request.SortByCustom(search.SortOrder{geoSort})
+
+	return request, nil
+}
+
+// Обновить коллекцию адресов
+func (a *EmbeddedAddressRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	begin := time.Now()
+	var total uint64
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.JsonAddressDto)
+
+	// Цикл получения объекта адреса из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.JsonAddressDto{}
+		saveItem.GetFromEntity(d.(entity.AddressObject))
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.AoGuid] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.ID)
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add addresses to storage")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add addresses to storage")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *EmbeddedAddressRepository) update(updated map[string]dto.JsonAddressDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.GetAddressByGuidList(updatedKeys)
+		for _, item := range items {
+			updateItem, ok := updated[item.AoGuid]
+			if ok {
+				updateItem.UpdateFromExistItem(*item)
+				updated[item.AoGuid] = updateItem
+			}
+		}
+	}
+	var items []dto.JsonAddressDto
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.save(items, deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add addresses batch commit failed")
+	}
+}
+
+// Сохранить и удалить элементы в БД и поисковом индексе
+func (a *EmbeddedAddressRepository) save(items []dto.JsonAddressDto, deleted []string) error {
+	err := a.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		guidBucket := tx.Bucket([]byte(a.guidBucketName))
+		for _, item := range items {
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(item.ID), data); err != nil {
+				return err
+			}
+			if err := guidBucket.Put([]byte(item.AoGuid), []byte(item.ID)); err != nil {
+				return err
+			}
+		}
+		for _, id := range deleted {
+			data := bucket.Get([]byte(id))
+			if data == nil {
+				continue
+			}
+			var item dto.JsonAddressDto
+			if err := json.Unmarshal(data, &item); err != nil {
+				return err
+			}
+			// Удаляет связь с GUID, только если она указывает на удаляемый объект
+			if string(guidBucket.Get([]byte(item.AoGuid))) == id {
+				if err := guidBucket.Delete([]byte(item.AoGuid)); err != nil {
+					return err
+				}
+			}
+			if err := bucket.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	index, err := a.index()
+	if err != nil {
+		return err
+	}
+	batch := index.NewBatch()
+	for _, item := range items {
+		if err := batch.Index(item.ID, item.ToSearchDocument()); err != nil {
+			return err
+		}
+	}
+	for _, id := range deleted {
+		batch.Delete(id)
+	}
+
+	return index.Batch(batch)
+}
+
+// Изменить сохраненные элементы по идентификаторам
+func (a *EmbeddedAddressRepository) modify(ids []string, modify func(item *dto.JsonAddressDto)) error {
+	items, err := a.getByIds(util.UniqueStringSlice(ids))
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	for i := range items {
+		modify(&items[i])
+	}
+
+	return a.save(items, nil)
+}
+
+// Обновить иерархию адресов
+func (a *EmbeddedAddressRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	admItems := make(map[string]string)
+	munItems := make(map[string]string)
+	var ids []string
+	for _, item := range items {
+		if item.Type == entity.MunHierarchy {
+			munItems[item.ObjectId] = item.ParentGuid
+		} else {
+			admItems[item.ObjectId] = item.ParentGuid
+		}
+		ids = append(ids, item.ObjectId)
+	}
+
+	return a.modify(ids, func(item *dto.JsonAddressDto) {
+		if parentGuid, ok := admItems[item.ID]; ok {
+			item.ParentGuid = parentGuid
+		}
+		if parentGuid, ok := munItems[item.ID]; ok {
+			item.MunParentGuid = parentGuid
+		}
+		// Обновляет дату изменения для перестроения полного адреса при индексации
+		item.UpdateBazisDate()
+	})
+}
+
+// Обновить параметры адресов
+func (a *EmbeddedAddressRepository) UpdateParams(items []entity.ParamObject) error {
+	params := make(map[string][]entity.ParamObject)
+	var ids []string
+	for _, item := range items {
+		switch item.TypeId {
+		case entity.ParamPostalCode, entity.ParamOkato, entity.ParamOktmo, entity.ParamKladr:
+			params[item.ObjectId] = append(params[item.ObjectId], item)
+			ids = append(ids, item.ObjectId)
+		}
+	}
+
+	return a.modify(ids, func(item *dto.JsonAddressDto) {
+		for _, param := range params[item.ID] {
+			switch param.TypeId {
+			case entity.ParamPostalCode:
+				item.PostalCode = param.Value
+			case entity.ParamOkato:
+				item.Okato = param.Value
+			case entity.ParamOktmo:
+				item.Oktmo = param.Value
+			case entity.ParamKladr:
+				item.Code = param.Value
+			}
+		}
+	})
+}
+
+// Индексация адресов
+func (a *EmbeddedAddressRepository) Index(isFull bool, start time.Time, guids []string, regions []string, indexChan chan<- entity.IndexObject) error {
+	done := make(chan bool)
+	// Создает канал для работы с объектами
+	a.jobs = make(chan dto.JsonAddressDto, a.noOfWorkers)
+	// Создает канал для сохранения объектов в БД
+	a.results = make(chan dto.JsonAddressDto, a.noOfWorkers)
+	// Получает идентификаторы элементов для переиндексации
+	ids, err := a.getIndexIds(isFull, start, guids, regions)
+	if err != nil {
+		return err
+	}
+	// Получает элементы из БД для переиндексации
+	go a.getIndexItems(ids)
+	// Обновляет элементы в БД
+	go a.saveIndexItems(done, time.Now(), int64(len(ids)), indexChan)
+	// Создает пул задач на обработку элементов
+	a.createWorkerPool(a.noOfWorkers)
+	<-done
+
+	return nil
+}
+
+// Получить идентификаторы элементов для переиндексации, отсортированные по уровню адреса
+func (a *EmbeddedAddressRepository) getIndexIds(isFull bool, start time.Time, guids []string, regions []string) ([]string, error) {
+	if isFull {
+		a.logger.Info("Full indexing...")
+	} else {
+		a.logger.Info("Indexing...")
+	}
+	guidList := make(map[string]bool)
+	for _, guid := range guids {
+		guidList[guid] = true
+	}
+	regionList := make(map[string]bool)
+	for _, region := range regions {
+		regionList[region] = true
+	}
+	startDate := start.Format(util.TimeFormat)
+	levels := make(map[string]int)
+	var ids []string
+	var total int64
+
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			total++
+			var item dto.JsonAddressDto
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			// Ограничивает выборку по регионам
+			if len(regionList) > 0 && !regionList[item.RegionCode] {
+				return nil
+			}
+			// Ограничивает выборку по уровню адреса, списку GUID или дате начала импорта
+			if !isFull {
+				if item.AoLevel <= 1 {
+					return nil
+				}
+				if len(guidList) > 0 && !guidList[item.AoGuid] {
+					return nil
+				}
+				if len(guidList) == 0 && item.BazisUpdateDate < startDate {
+					return nil
+				}
+			}
+			levels[item.ID] = item.AoLevel
+			ids = append(ids, item.ID)
+
+			return nil
+		})
+	})
+	sort.SliceStable(ids, func(i, j int) bool {
+		return levels[ids[i]] < levels[ids[j]]
+	})
+
+	a.logger.WithFields(interfaces.LoggerFields{"count": total}).Info("Total address count")
+	a.logger.WithFields(interfaces.LoggerFields{"count": len(ids)}).Info("Number of indexed addresses")
+
+	return ids, err
+}
+
+// Получить элементы из БД для переиндексации
+func (a *EmbeddedAddressRepository) getIndexItems(ids []string) {
+	defer close(a.jobs)
+	count := 0
+	// Получает данные из БД пачками
+	for start := 0; start < len(ids); start += a.batchSize {
+		end := start + a.batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		items, err := a.getByIds(ids[start:end])
+		if err != nil {
+			a.logger.Fatal(err.Error())
+		}
+		count += len(items)
+		// Добавляет элементы в пул задач
+		for _, item := range items {
+			a.jobs <- item
+		}
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": count}).Info("Address update count")
+}
+
+// Создать пул задач на обработку элементов
+func (a *EmbeddedAddressRepository) createWorkerPool(noOfWorkers int) {
+	var wg sync.WaitGroup
+	for i := 0; i < noOfWorkers; i++ {
+		wg.Add(1)
+		// Подготавливает элементы перед сохранением в БД
+		go a.prepareItemsBeforeSave(&wg)
+	}
+	wg.Wait()
+	close(a.results)
+}
+
+// Подготовить элементы перед сохранением в БД
+func (a *EmbeddedAddressRepository) prepareItemsBeforeSave(wg *sync.WaitGroup) {
+	for address := range a.jobs {
+		// Устанавливает время обновления объекта
+		address.UpdateBazisDate()
+		dtoItem := dto.JsonAddressDto{}
+		guid := address.ParentGuid
+		// Формирует информацию об адресе объекта
+		address.FullName = util.PrepareFullName(address.ShortName, address.FormalName)
+		address.FullAddress = address.FullName
+		address.AddressSuggest = util.PrepareSuggest("", address.ShortName, address.FormalName)
+
+		// Ищет родительские объекты и дополняет адрес текущего объекта
+		if guid != "" {
+			search := a.getParent(guid)
+			if search != nil {
+				// Конвертирует объект адреса в DTO
+				dtoItem.GetFromEntity(*search)
+				// Дополняет адрес текущего объекта
+				if address.AoLevel == 4 {
+					// Пропускаем район в названии
+					address.FullAddress = dtoItem.RegionFull + ", " + address.FullAddress
+				} else {
+					address.FullAddress = dtoItem.FullAddress + ", " + address.FullAddress
+				}
+				address.AddressSuggest = dtoItem.AddressSuggest + ", " + address.AddressSuggest
+				// Формирует информацию о регионе объекта
+				if dtoItem.Region != "" {
+					address.RegionGuid = dtoItem.RegionGuid
+					address.RegionKladr = dtoItem.RegionKladr
+					address.Region = dtoItem.Region
+					address.RegionType = dtoItem.RegionType
+					address.RegionFull = dtoItem.RegionFull
+				}
+				// Формирует информацию о районе объекта
+				if dtoItem.Area != "" {
+					address.AreaGuid = dtoItem.AreaGuid
+					address.AreaKladr = dtoItem.AreaKladr
+					address.Area = dtoItem.Area
+					address.AreaType = dtoItem.AreaType
+					address.AreaFull = dtoItem.AreaFull
+				}
+				// Формирует информацию о городе объекта
+				if dtoItem.City != "" {
+					address.CityGuid = dtoItem.CityGuid
+					address.CityKladr = dtoItem.CityKladr
+					address.City = dtoItem.City
+					address.CityType = dtoItem.CityType
+					address.CityFull = dtoItem.CityFull
+				}
+				// Устанавливает населенный пункт объекта
+				if dtoItem.Settlement != "" {
+					address.SettlementGuid = dtoItem.SettlementGuid
+					address.SettlementKladr = dtoItem.SettlementKladr
+					address.Settlement = dtoItem.Settlement
+					address.SettlementType = dtoItem.SettlementType
+					address.SettlementFull = dtoItem.SettlementFull
+				}
+			}
+		}
+
+		if address.AoLevel <= 2 {
+			address.RegionGuid = address.AoGuid
+			address.RegionKladr = address.Code
+			address.Region = strings.TrimSpace(address.FormalName)
+			address.RegionType = strings.TrimSpace(address.ShortName)
+			address.RegionFull = util.PrepareFullName(address.RegionType, address.Region)
+		} else if address.AoLevel == 3 {
+			address.AreaGuid = address.AoGuid
+			address.AreaKladr = address.Code
+			address.Area = strings.TrimSpace(address.FormalName)
+			address.AreaType = strings.TrimSpace(address.ShortName)
+			if address.RegionFull != "" {
+				address.AreaFull = address.RegionFull + ", "
+			}
+			address.AreaFull += util.PrepareFullName(address.AreaType, address.Area)
+		} else if address.AoLevel == 4 {
+			address.CityGuid = address.AoGuid
+			address.CityKladr = address.Code
+			address.City = strings.TrimSpace(address.FormalName)
+			address.CityType = strings.TrimSpace(address.ShortName)
+			if address.AreaFull != "" {
+				address.CityFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.CityFull = address.RegionFull + ", "
+			}
+			address.CityFull += util.PrepareFullName(address.CityType, address.City)
+		} else if address.AoLevel == 5 || address.AoLevel == 6 {
+			address.SettlementGuid = address.AoGuid
+			address.SettlementKladr = address.Code
+			address.Settlement = strings.TrimSpace(address.FormalName)
+			address.SettlementType = strings.TrimSpace(address.ShortName)
+			address.SettlementFull = ""
+			if address.CityFull != "" {
+				address.SettlementFull = address.CityFull + ", "
+			} else if address.AreaFull != "" {
+				address.SettlementFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.SettlementFull = address.RegionFull + ", "
+			}
+			address.SettlementFull += util.PrepareFullName(address.SettlementType, address.Settlement)
+		} else if address.AoLevel == 7 {
+			address.StreetGuid = address.AoGuid
+			address.StreetKladr = address.Code
+			address.StreetType = strings.TrimSpace(address.ShortName)
+			address.Street = strings.TrimSpace(address.FormalName)
+			address.StreetFull = ""
+			if address.SettlementFull != "" {
+				address.StreetFull = address.SettlementFull + ", "
+			} else if address.CityFull != "" {
+				address.StreetFull = address.CityFull + ", "
+			} else if address.AreaFull != "" {
+				address.StreetFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.StreetFull = address.RegionFull + ", "
+			}
+			address.StreetFull += util.PrepareFullName(address.StreetType, address.Street)
+		}
+
+		// Формирует адрес объекта в муниципальном делении
+		a.prepareMunAddress(&address)
+
+		a.results <- address
+	}
+
+	wg.Done()
+}
+
+// Получить родительский объект из кэша или БД
+func (a *EmbeddedAddressRepository) getParent(guid string) *entity.AddressObject {
+	searchObject := entity.AddressObject{}
+	// Ищет родительский объект в кэше
+	searchResult := a.indexCache.Get(guid, &searchObject)
+	if searchResult != nil {
+		return searchResult.(*entity.AddressObject)
+	}
+	search, _ := a.GetByGuid(guid)
+
+	return search
+}
+
+// Сформировать адрес объекта в муниципальном делении
+func (a *EmbeddedAddressRepository) prepareMunAddress(address *dto.JsonAddressDto) {
+	address.MunFullAddress = address.FullName
+	address.MunAddressSuggest = util.PrepareSuggest("", address.ShortName, address.FormalName)
+	guid := address.MunParentGuid
+	if guid == "" {
+		guid = address.ParentGuid
+	}
+
+	// Поднимается по цепочке родителей, у объектов без муниципальной иерархии используется административная
+	for depth := 0; guid != "" && depth < maxHierarchyDepth; depth++ {
+		parent := a.getParent(guid)
+		if parent == nil {
+			break
+		}
+		fullName := parent.FullName
+		if fullName == "" {
+			fullName = util.PrepareFullName(parent.ShortName, parent.FormalName)
+		}
+		address.MunFullAddress = fullName + ", " + address.MunFullAddress
+		address.MunAddressSuggest = util.PrepareSuggest("", parent.ShortName, parent.FormalName) + ", " + address.MunAddressSuggest
+
+		guid = parent.MunParentGuid
+		if guid == "" {
+			guid = parent.ParentGuid
+		}
+	}
+}
+
+// Обновить элементы в БД
+func (a *EmbeddedAddressRepository) saveIndexItems(done chan bool, begin time.Time, total int64, indexChan chan<- entity.IndexObject) {
+	var items []dto.JsonAddressDto
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing addresses", false)
+
+	for d := range a.results {
+		// Добавляет объект в индексацию домов, если данный объект является улицей
+		if d.AoLevel == 7 && indexChan != nil {
+			indexChan <- entity.IndexObject{
+				AoGuid:         d.AoGuid,
+				FullAddress:    d.FullAddress,
+				AddressSuggest: d.AddressSuggest,
+			}
+		} else if d.AoLevel <= 6 {
+			a.indexCache.Set(d.AoGuid, d.ToEntity())
+		}
+
+		// Добавляет объект в очередь на сохранение
+		items = append(items, d)
+		bar.Increment()
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(items) >= a.batchSize {
+			a.saveIndexBatch(items)
+			items = nil
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(items) > 0 {
+		a.saveIndexBatch(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address index execution time")
+	done <- true
+	if indexChan != nil {
+		close(indexChan)
+	}
+}
+
+// Сохранить пачку проиндексированных элементов и очистить кэш
+func (a *EmbeddedAddressRepository) saveIndexBatch(items []dto.JsonAddressDto) {
+	if err := a.save(items, nil); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Address index batch commit failed")
+		os.Exit(1)
+	}
+	a.indexCache.Clear()
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Максимальное количество границ, прямоугольник которых содержит точку
+const maxBoundaryCandidates = 1000
+
+// Репозиторий границ административных объектов во встроенном хранилище
+type EmbeddedBoundaryRepository struct {
+	logger         interfaces.LoggerInterface // Логгер
+	batchSize      int                        // Размер пачки для обновления
+	embeddedClient *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName     string                     // Название бакета
+}
+
+// Инициализация репозитория
+func NewEmbeddedBoundaryRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.BoundaryRepositoryInterface {
+	return &EmbeddedBoundaryRepository{
+		embeddedClient: embeddedClient,
+		logger:         logger,
+		batchSize:      batchSize,
+		bucketName:     prefix + entity.Boundary{}.TableName(),
+	}
+}
+
+// Инициализация бакета и поискового индекса
+func (b *EmbeddedBoundaryRepository) Init() error {
+	if err := b.embeddedClient.CreateBucket(b.bucketName); err != nil {
+		return err
+	}
+	_, err := b.index()
+
+	return err
+}
+
+// Получить название бакета
+func (b *EmbeddedBoundaryRepository) GetIndexName() string {
+	return b.bucketName
+}
+
+// Удалить бакет и поисковый индекс
+func (b *EmbeddedBoundaryRepository) Clear() error {
+	if err := b.embeddedClient.DropBucket(b.bucketName); err != nil {
+		return err
+	}
+
+	return b.embeddedClient.DropIndex(b.bucketName)
+}
+
+// Получить поисковый индекс
+func (b *EmbeddedBoundaryRepository) index() (bleve.Index, error) {
+	return b.embeddedClient.OpenIndex(b.bucketName, b.indexMapping())
+}
+
+// Структура поискового индекса: прямоугольники границ
+func (b *EmbeddedBoundaryRepository) indexMapping() mapping.IndexMapping {
+	indexMapping := embeddedHelper.NewIndexMapping()
+	document := indexMapping.DefaultMapping
+	for _, field := range []string{"ao_level", "min_lat", "min_lon", "max_lat", "max_lon"} {
+		document.AddFieldMappingsAt(field, embeddedHelper.NewNumericFieldMapping())
+	}
+
+	return indexMapping
+}
+
+// Сохранить границы
+func (b *EmbeddedBoundaryRepository) Save(items []entity.Boundary) error {
+	if len(items) == 0 {
+		return nil
+	}
+	var saveItems []dto.JsonBoundaryDto
+	err := b.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(b.bucketName))
+		for _, item := range items {
+			saveItem := dto.JsonBoundaryDto{}
+			saveItem.GetFromEntity(item)
+			data, err := json.Marshal(saveItem)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(saveItem.ID), data); err != nil {
+				return err
+			}
+			saveItems = append(saveItems, saveItem)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	index, err := b.index()
+	if err != nil {
+		return err
+	}
+	batch := index.NewBatch()
+	for _, item := range saveItems {
+		if err := batch.Index(item.ID, item.ToSearchDocument()); err != nil {
+			return err
+		}
+	}
+
+	return index.Batch(batch)
+}
+
+// Получить границы, содержащие точку
+func (b *EmbeddedBoundaryRepository) GetByPoint(lat float64, lon float64) ([]*entity.Boundary, error) {
+	index, err := b.index()
+	if err != nil {
+		return nil, err
+	}
+	// Отбирает границы по прямоугольнику, затем проверяет попадание точки в контур
+	query := bleve.NewConjunctionQuery(
+		embeddedHelper.NewRangeQuery("min_lat", nil, &lat),
+		embeddedHelper.NewRangeQuery("max_lat", &lat, nil),
+		embeddedHelper.NewRangeQuery("min_lon", nil, &lon),
+		embeddedHelper.NewRangeQuery("max_lon", &lon, nil),
+	)
+	res, err := index.Search(bleve.NewSearchRequestOptions(query, maxBoundaryCandidates, 0, false))
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.Boundary
+	err = b.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(b.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		for _, hit := range res.Hits {
+			data := bucket.Get([]byte(hit.ID))
+			if data == nil {
+				continue
+			}
+			var item dto.JsonBoundaryDto
+			if err := json.Unmarshal(data, &item); err != nil {
+				return err
+			}
+			if item.Polygons.Contains(lat, lon) {
+				boundary := item.ToEntity()
+				boundary.Polygons = nil
+				items = append(items, boundary)
+			}
+		}
+
+		return nil
+	})
+
+	return items, err
+}
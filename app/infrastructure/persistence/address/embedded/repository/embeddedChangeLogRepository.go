@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Репозиторий журнала изменений во встроенном хранилище
+type EmbeddedChangeLogRepository struct {
+	logger         interfaces.LoggerInterface // Логгер
+	batchSize      int                        // Размер пачки для обновления
+	embeddedClient *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName     string                     // Название бакета
+}
+
+// Инициализация репозитория
+func NewEmbeddedChangeLogRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.ChangeLogRepositoryInterface {
+	return &EmbeddedChangeLogRepository{
+		embeddedClient: embeddedClient,
+		logger:         logger,
+		batchSize:      batchSize,
+		bucketName:     prefix + entity.ChangeLogItem{}.TableName(),
+	}
+}
+
+// Инициализация бакета
+func (c *EmbeddedChangeLogRepository) Init() error {
+	return c.embeddedClient.CreateBucket(c.bucketName)
+}
+
+// Получить название бакета
+func (c *EmbeddedChangeLogRepository) GetIndexName() string {
+	return c.bucketName
+}
+
+// Удалить бакет
+func (c *EmbeddedChangeLogRepository) Clear() error {
+	return c.embeddedClient.DropBucket(c.bucketName)
+}
+
+// Сохранить записи журнала изменений
+func (c *EmbeddedChangeLogRepository) Save(items []entity.ChangeLogItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	return c.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(c.bucketName))
+		for _, item := range items {
+			saveItem := dto.JsonChangeLogDto{}
+			saveItem.GetFromEntity(item)
+			data, err := json.Marshal(saveItem)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(saveItem.ID), data); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Получить записи журнала изменений версий после указанной, начиная с записи после afterId
+func (c *EmbeddedChangeLogRepository) GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, error) {
+	// Идентификаторы записей начинаются с номера версии, поэтому ключи бакета упорядочены по версиям
+	start := fmt.Sprintf("%010d", sinceVersion+1)
+	if afterId > start {
+		start = afterId
+	}
+	var list []*entity.ChangeLogItem
+	err := c.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(c.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		cursor := bucket.Cursor()
+		for k, v := cursor.Seek([]byte(start)); k != nil && int64(len(list)) < size; k, v = cursor.Next() {
+			if string(k) == afterId {
+				continue
+			}
+			var item dto.JsonChangeLogDto
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			list = append(list, item.ToEntity())
+		}
+
+		return nil
+	})
+
+	return list, err
+}
@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	bolt "go.etcd.io/bbolt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Описание объектов, полный адрес которых формируется по адресу родителя: помещений или участков
+type childObjects struct {
+	name       string                                    // Название объектов в логах во множественном числе
+	title      string                                    // Название объекта в логах
+	newDto     func() dto.ChildObjectDto                 // Создать пустой DTO
+	fromEntity func(item interface{}) dto.ChildObjectDto // Конвертировать объект из канала импорта в DTO
+}
+
+// Общий репозиторий помещений и участков во встроенном хранилище
+type embeddedChildRepository struct {
+	logger           interfaces.LoggerInterface // Логгер
+	batchSize        int                        // Размер пачки для обновления
+	embeddedClient   *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName       string                     // Название бакета с объектами
+	guidBucketName   string                     // Название бакета с идентификаторами объектов по GUID
+	parentBucketName string                     // Название бакета с идентификаторами объектов по GUID родителя
+	objects          childObjects               // Описание хранимых объектов
+}
+
+// Инициализация общего репозитория
+func newEmbeddedChildRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, bucketName string, objects childObjects) *embeddedChildRepository {
+	return &embeddedChildRepository{
+		embeddedClient:   embeddedClient,
+		logger:           logger,
+		batchSize:        batchSize,
+		bucketName:       bucketName,
+		guidBucketName:   bucketName + "_guid",
+		parentBucketName: bucketName + "_parent",
+		objects:          objects,
+	}
+}
+
+// Инициализация бакетов
+func (a *embeddedChildRepository) Init() error {
+	return a.embeddedClient.CreateBucket(a.bucketName, a.guidBucketName, a.parentBucketName)
+}
+
+// Получить название бакета
+func (a *embeddedChildRepository) GetIndexName() string {
+	return a.bucketName
+}
+
+// Удалить бакеты
+func (a *embeddedChildRepository) Clear() error {
+	return a.embeddedClient.DropBucket(a.bucketName, a.guidBucketName, a.parentBucketName)
+}
+
+// Получить ключ объекта в бакете объектов по родителю
+func (a *embeddedChildRepository) parentKey(parentGuid string, id string) []byte {
+	return []byte(parentGuid + keySeparator + id)
+}
+
+// Конвертировать сохраненные данные в DTO
+func (a *embeddedChildRepository) unmarshal(data []byte) (dto.ChildObjectDto, error) {
+	item := a.objects.newDto()
+	if err := json.Unmarshal(data, item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Получить объекты по идентификаторам в рамках транзакции
+func (a *embeddedChildRepository) getByIdsTx(tx *bolt.Tx, ids []string) ([]dto.ChildObjectDto, error) {
+	bucket := tx.Bucket([]byte(a.bucketName))
+	if bucket == nil {
+		return nil, nil
+	}
+	var items []dto.ChildObjectDto
+	for _, id := range ids {
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			continue
+		}
+		item, err := a.unmarshal(data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Получить объекты по GUID
+func (a *embeddedChildRepository) getByGuidList(guids []string) ([]dto.ChildObjectDto, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+	var items []dto.ChildObjectDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.guidBucketName))
+		if bucket == nil {
+			return nil
+		}
+		var ids []string
+		for _, guid := range guids {
+			if id := bucket.Get([]byte(guid)); id != nil {
+				ids = append(ids, string(id))
+			}
+		}
+		var err error
+		items, err = a.getByIdsTx(tx, ids)
+
+		return err
+	})
+
+	return items, err
+}
+
+// Найти объекты по GUID родителя
+func (a *embeddedChildRepository) getByParentGuid(guid string) ([]dto.ChildObjectDto, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	var items []dto.ChildObjectDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.parentBucketName))
+		if bucket == nil {
+			return nil
+		}
+		var ids []string
+		prefix := []byte(guid + keySeparator)
+		cursor := bucket.Cursor()
+		for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+			ids = append(ids, string(k[len(prefix):]))
+		}
+		var err error
+		items, err = a.getByIdsTx(tx, ids)
+
+		return err
+	})
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].GetFullNum() < items[j].GetFullNum()
+	})
+
+	return items, err
+}
+
+// Обновить коллекцию объектов
+func (a *embeddedChildRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.ChildObjectDto)
+
+	// Цикл получения объекта из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := a.objects.fromEntity(d)
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.GetGuid()] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.GetId())
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to storage")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to storage")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *embeddedChildRepository) update(updated map[string]dto.ChildObjectDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.getByGuidList(updatedKeys)
+		for _, item := range items {
+			if updateItem, ok := updated[item.GetGuid()]; ok {
+				updateItem.UpdateFromExistItem(item)
+			}
+		}
+	}
+	var items []dto.ChildObjectDto
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.save(items, deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add " + a.objects.name + " batch commit failed")
+	}
+}
+
+// Сохранить и удалить элементы в БД
+func (a *embeddedChildRepository) save(items []dto.ChildObjectDto, deleted []string) error {
+	return a.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		guidBucket := tx.Bucket([]byte(a.guidBucketName))
+		parentBucket := tx.Bucket([]byte(a.parentBucketName))
+		// Удаляет связи элемента с GUID объекта и родителя
+		removeLinks := func(id string, keepParent string) error {
+			data := bucket.Get([]byte(id))
+			if data == nil {
+				return nil
+			}
+			old, err := a.unmarshal(data)
+			if err != nil {
+				return err
+			}
+			if old.GetParentGuid() != keepParent {
+				if err := parentBucket.Delete(a.parentKey(old.GetParentGuid(), id)); err != nil {
+					return err
+				}
+			}
+			if keepParent == "" && string(guidBucket.Get([]byte(old.GetGuid()))) == id {
+				return guidBucket.Delete([]byte(old.GetGuid()))
+			}
+
+			return nil
+		}
+
+		for _, item := range items {
+			if err := removeLinks(item.GetId(), item.GetParentGuid()); err != nil {
+				return err
+			}
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(item.GetId()), data); err != nil {
+				return err
+			}
+			if err := guidBucket.Put([]byte(item.GetGuid()), []byte(item.GetId())); err != nil {
+				return err
+			}
+			if err := parentBucket.Put(a.parentKey(item.GetParentGuid(), item.GetId()), nil); err != nil {
+				return err
+			}
+		}
+		for _, id := range deleted {
+			if err := removeLinks(id, ""); err != nil {
+				return err
+			}
+			if err := bucket.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Подсчитать количество объектов в БД
+func (a *embeddedChildRepository) CountAllData(filter interface{}) (int64, error) {
+	return a.embeddedClient.CountAllData(a.bucketName)
+}
+
+// Получить идентификаторы объектов, обновленных после указанной даты
+func (a *embeddedChildRepository) getUpdatedIds(start time.Time) ([]string, error) {
+	startDate := start.Format(util.TimeFormat)
+	var ids []string
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			// Читает только дату обновления, не разбирая объект целиком
+			var item struct {
+				BazisUpdateDate string `json:"bazis_update_date"`
+			}
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			if item.BazisUpdateDate >= startDate {
+				ids = append(ids, string(k))
+			}
+
+			return nil
+		})
+	})
+
+	return ids, err
+}
+
+// Индексация объектов
+func (a *embeddedChildRepository) Index(start time.Time, GetIndexObjects repository.GetIndexObjects) error {
+	begin := time.Now()
+	ids, err := a.getUpdatedIds(start)
+	if err != nil {
+		return err
+	}
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(len(ids), "Indexing "+a.objects.name, false)
+	count := 0
+
+	for from := 0; from < len(ids); from += a.batchSize {
+		to := from + a.batchSize
+		if to > len(ids) {
+			to = len(ids)
+		}
+		var list []dto.ChildObjectDto
+		err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+			var err error
+			list, err = a.getByIdsTx(tx, ids[from:to])
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		var guids []string
+		for _, item := range list {
+			guids = append(guids, item.GetParentGuid())
+		}
+
+		// Формирует полный адрес объекта по адресу родителя
+		var items []dto.ChildObjectDto
+		objectsList := GetIndexObjects(util.UniqueStringSlice(guids))
+		for _, item := range list {
+			bar.Increment()
+			object, ok := objectsList[item.GetParentGuid()]
+			if !ok {
+				continue
+			}
+			item.UpdateFullAddress(object.FullAddress)
+			item.UpdateBazisDate()
+			items = append(items, item)
+		}
+		if err := a.save(items, nil); err != nil {
+			a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal(a.objects.title + " index batch commit failed")
+		}
+		count += len(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"count": count, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " index execution time")
+
+	return nil
+}
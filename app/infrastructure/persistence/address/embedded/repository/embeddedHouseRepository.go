@@ -0,0 +1,854 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/dustin/go-humanize"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Разделитель составного ключа в бакете домов по адресу
+	keySeparator = "\x00"
+)
+
+// Репозиторий домов во встроенном хранилище
+type EmbeddedHouseRepository struct {
+	logger           interfaces.LoggerInterface // Логгер
+	batchSize        int                        // Размер пачки для обновления
+	embeddedClient   *embeddedHelper.Client     // Клиент встроенного хранилища
+	bucketName       string                     // Название бакета с домами
+	guidBucketName   string                     // Название бакета с идентификаторами домов по GUID
+	parentBucketName string                     // Название бакета с идентификаторами домов по GUID адреса
+	results          chan dto.JsonHouseDto      // Список объектов индексации
+	noOfWorkers      int                        // Количество обработчиков индексации
+}
+
+// Инициализация репозитория
+func NewEmbeddedHouseRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string, noOfWorkers int) repository.HouseRepositoryInterface {
+	if noOfWorkers == 0 {
+		noOfWorkers = 10
+	}
+	bucketName := prefix + entity.HouseObject{}.TableName()
+
+	return &EmbeddedHouseRepository{
+		embeddedClient:   embeddedClient,
+		logger:           logger,
+		batchSize:        batchSize,
+		bucketName:       bucketName,
+		guidBucketName:   bucketName + "_guid",
+		parentBucketName: bucketName + "_parent",
+		noOfWorkers:      noOfWorkers,
+	}
+}
+
+// Инициализация бакетов и поискового индекса
+func (a *EmbeddedHouseRepository) Init() error {
+	if err := a.embeddedClient.CreateBucket(a.bucketName, a.guidBucketName, a.parentBucketName); err != nil {
+		return err
+	}
+	_, err := a.index()
+
+	return err
+}
+
+// Получить название бакета
+func (a *EmbeddedHouseRepository) GetIndexName() string {
+	return a.bucketName
+}
+
+// Удалить бакеты и поисковый индекс
+func (a *EmbeddedHouseRepository) Clear() error {
+	if err := a.embeddedClient.DropBucket(a.bucketName, a.guidBucketName, a.parentBucketName); err != nil {
+		return err
+	}
+
+	return a.embeddedClient.DropIndex(a.bucketName)
+}
+
+// Получить поисковый индекс
+func (a *EmbeddedHouseRepository) index() (bleve.Index, error) {
+	return a.embeddedClient.OpenIndex(a.bucketName, a.indexMapping())
+}
+
+// Структура поискового индекса
+func (a *EmbeddedHouseRepository) indexMapping() mapping.IndexMapping {
+	indexMapping := embeddedHelper.NewIndexMapping()
+	document := indexMapping.DefaultMapping
+	document.AddFieldMappingsAt("address_suggest", embeddedHelper.NewSuggestFieldMapping())
+	document.AddFieldMappingsAt("ao_guid", embeddedHelper.NewKeywordFieldMapping())
+	document.AddFieldMappingsAt("full_address", embeddedHelper.NewKeywordFieldMapping())
+	document.AddFieldMappingsAt("location", embeddedHelper.NewGeoPointFieldMapping())
+
+	return indexMapping
+}
+
+// Получить ключ дома в бакете домов по адресу
+func (a *EmbeddedHouseRepository) parentKey(aoGuid string, id string) []byte {
+	return []byte(aoGuid + keySeparator + id)
+}
+
+// Получить дома из БД по идентификаторам
+func (a *EmbeddedHouseRepository) getByIds(ids []string) ([]dto.JsonHouseDto, error) {
+	var items []dto.JsonHouseDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		var err error
+		items, err = a.getByIdsTx(tx, ids)
+
+		return err
+	})
+
+	return items, err
+}
+
+// Получить дома по идентификаторам в рамках транзакции
+func (a *EmbeddedHouseRepository) getByIdsTx(tx *bolt.Tx, ids []string) ([]dto.JsonHouseDto, error) {
+	bucket := tx.Bucket([]byte(a.bucketName))
+	if bucket == nil {
+		return nil, nil
+	}
+	var items []dto.JsonHouseDto
+	for _, id := range ids {
+		data := bucket.Get([]byte(id))
+		if data == nil {
+			continue
+		}
+		var item dto.JsonHouseDto
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Конвертирует DTO в объекты домов
+func (a *EmbeddedHouseRepository) toEntities(items []dto.JsonHouseDto) []*entity.HouseObject {
+	var list []*entity.HouseObject
+	for _, item := range items {
+		list = append(list, item.ToEntity())
+	}
+
+	return list
+}
+
+// Найти дом по GUID
+func (a *EmbeddedHouseRepository) GetByGuid(guid string) (*entity.HouseObject, error) {
+	items, err := a.GetByGuidList([]string{guid})
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить дома по GUID
+func (a *EmbeddedHouseRepository) GetByGuidList(guids []string) ([]*entity.HouseObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+	var items []dto.JsonHouseDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.guidBucketName))
+		if bucket == nil {
+			return nil
+		}
+		var ids []string
+		for _, guid := range guids {
+			if id := bucket.Get([]byte(guid)); id != nil {
+				ids = append(ids, string(id))
+			}
+		}
+		var err error
+		items, err = a.getByIdsTx(tx, ids)
+
+		return err
+	})
+
+	return a.toEntities(items), err
+}
+
+// Найти дома по идентификаторам объектов
+func (a *EmbeddedHouseRepository) GetByIdList(ids []string) ([]*entity.HouseObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	items, err := a.getByIds(ids)
+
+	return a.toEntities(items), err
+}
+
+// Найти дома по GUID адресов
+func (a *EmbeddedHouseRepository) GetByAddressGuidList(guids []string) ([]*entity.HouseObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+	var items []dto.JsonHouseDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.parentBucketName))
+		if bucket == nil {
+			return nil
+		}
+		var ids []string
+		cursor := bucket.Cursor()
+		for _, guid := range guids {
+			prefix := []byte(guid + keySeparator)
+			for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+				ids = append(ids, string(k[len(prefix):]))
+			}
+		}
+		var err error
+		items, err = a.getByIdsTx(tx, ids)
+
+		return err
+	})
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].HouseFullNum < items[j].HouseFullNum
+	})
+
+	return a.toEntities(items), err
+}
+
+// Выгрузить дома по фильтру пачками
+func (a *EmbeddedHouseRepository) Export(filter entity.ExportFilter, handler func(items []*entity.HouseObject) error) error {
+	updatedSince := ""
+	if !filter.UpdatedSince.IsZero() {
+		updatedSince = filter.UpdatedSince.Format(util.TimeFormat)
+	}
+	if len(filter.AddressGuids) > 0 {
+		// Дома адресов выбираются по вторичному индексу GUID адреса
+		houses, err := a.GetByAddressGuidList(filter.AddressGuids)
+		if err != nil {
+			return err
+		}
+		var items []*entity.HouseObject
+		for _, house := range houses {
+			if house.BazisUpdateDate >= updatedSince {
+				items = append(items, house)
+			}
+		}
+		if len(items) == 0 {
+			return nil
+		}
+
+		return handler(items)
+	}
+
+	return a.embeddedClient.ScanBucket(a.bucketName, a.batchSize, func(values [][]byte) error {
+		var items []*entity.HouseObject
+		for _, value := range values {
+			var item dto.JsonHouseDto
+			if err := json.Unmarshal(value, &item); err != nil {
+				return err
+			}
+			if item.BazisUpdateDate < updatedSince {
+				continue
+			}
+			items = append(items, item.ToEntity())
+		}
+		if len(items) == 0 {
+			return nil
+		}
+
+		return handler(items)
+	})
+}
+
+// Найти дома по GUID адреса
+func (a *EmbeddedHouseRepository) GetByAddressGuid(guid string) ([]*entity.HouseObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+
+	return a.GetByAddressGuidList([]string{guid})
+}
+
+// Получить идентификаторы домов, обновленных после указанной даты
+func (a *EmbeddedHouseRepository) getUpdatedItems(start time.Time) ([]dto.JsonHouseDto, error) {
+	startDate := start.Format(util.TimeFormat)
+	var items []dto.JsonHouseDto
+	err := a.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			var item dto.JsonHouseDto
+			if err := json.Unmarshal(v, &item); err != nil {
+				return err
+			}
+			if item.BazisUpdateDate >= startDate {
+				items = append(items, dto.JsonHouseDto{ID: item.ID, AoGuid: item.AoGuid})
+			}
+
+			return nil
+		})
+	})
+
+	return items, err
+}
+
+// Получить GUID последних обновленных домов
+func (a *EmbeddedHouseRepository) GetLastUpdatedGuids(start time.Time) ([]string, error) {
+	items, err := a.getUpdatedItems(start)
+	if err != nil {
+		return nil, err
+	}
+	var guids []string
+	for _, item := range items {
+		guids = append(guids, item.AoGuid)
+	}
+
+	return util.UniqueStringSlice(guids), nil
+}
+
+// Найти ближайший дом в радиусе в метрах
+func (a *EmbeddedHouseRepository) GetNearest(lon float64, lat float64, radius int) (*entity.HouseObject, error) {
+	index, err := a.index()
+	if err != nil {
+		return nil, err
+	}
+	geoQuery := bleve.NewGeoDistanceQuery(lon, lat, strconv.Itoa(radius)+"m")
+	geoQuery.SetField("location")
+	request := bleve.NewSearchRequestOptions(geoQuery, 1, 0, false)
+	geoSort, err := search.NewSortGeoDistance("location", "m", lon, lat, false)
+	if err != nil {
+		return nil, err
+	}
+	request.SortByCustom(search.SortOrder{geoSort})
+	res, err := index.Search(request)
+	if err != nil || len(res.Hits) == 0 {
+		return nil, err
+	}
+	items, err := a.getByIds([]string{res.Hits[0].ID})
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0].ToEntity(), nil
+}
+
+// Найти дома по подстроке
+func (a *EmbeddedHouseRepository) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	limit := from + size
+	var items []*entity.HouseObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
+
+// Найти дома по варианту подстроки
+func (a *EmbeddedHouseRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error) {
+	match := embeddedHelper.NewMatchQuery("address_suggest", variant.Term)
+	if variant.Fuzzy {
+		match = embeddedHelper.NewFuzzyMatchQuery("address_suggest", variant.Term)
+	}
+	queries := a.prepareFilter([]query.Query{match}, filter...)
+	if queries == nil {
+		return nil, nil
+	}
+	index, err := a.index()
+	if err != nil {
+		return nil, err
+	}
+	request := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(queries...), int(size), 0, false)
+	request.SortBy([]string{"-_score", "full_address"})
+	res, err := index.Search(request)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, hit := range res.Hits {
+		ids = append(ids, hit.ID)
+	}
+	items, err := a.getByIds(ids)
+
+	return a.toEntities(items), err
+}
+
+// Подготовить фильтр для запроса
+func (a *EmbeddedHouseRepository) prepareFilter(queries []query.Query, filters ...entity.FilterObject) []query.Query {
+	for _, filter := range filters {
+		if len(filter.Level.Values) > 0 {
+			hasHouses := false
+			for _, value := range filter.Level.Values {
+				if value == 8 {
+					hasHouses = true
+					break
+				}
+			}
+			if !hasHouses {
+				return nil
+			}
+		}
+		if filter.Level.Min > 0 || filter.Level.Max > 0 {
+			if filter.Level.Min > 0 && filter.Level.Min > 8 {
+				return nil
+			}
+			if filter.Level.Max > 0 && filter.Level.Max < 8 {
+				return nil
+			}
+		}
+		if len(filter.ParentGuid.Values) > 0 {
+			queries = append(queries, embeddedHelper.NewTermsQuery("ao_guid", filter.ParentGuid.Values...))
+		}
+		if len(filter.KladrId.Values) > 0 {
+			return nil
+		}
+	}
+
+	return queries
+}
+
+// Обновить коллекцию домов
+func (a *EmbeddedHouseRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.JsonHouseDto)
+
+	// Цикл получения объекта дома из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.JsonHouseDto{}
+		saveItem.GetFromEntity(d.(entity.HouseObject))
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.HouseGuid] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.ID)
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add houses to storage")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add houses to storage")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("House import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *EmbeddedHouseRepository) update(updated map[string]dto.JsonHouseDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.GetByGuidList(updatedKeys)
+		for _, item := range items {
+			updateItem, ok := updated[item.HouseGuid]
+			if ok {
+				updateItem.UpdateFromExistItem(*item)
+				updated[item.HouseGuid] = updateItem
+			}
+		}
+	}
+	var items []dto.JsonHouseDto
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.save(items, deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add houses batch commit failed")
+	}
+}
+
+// Сохранить и удалить элементы в БД и поисковом индексе
+func (a *EmbeddedHouseRepository) save(items []dto.JsonHouseDto, deleted []string) error {
+	err := a.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(a.bucketName))
+		guidBucket := tx.Bucket([]byte(a.guidBucketName))
+		parentBucket := tx.Bucket([]byte(a.parentBucketName))
+		// Удаляет связи элемента с GUID дома и адреса
+		removeLinks := func(id string, keepParent string) error {
+			data := bucket.Get([]byte(id))
+			if data == nil {
+				return nil
+			}
+			var old dto.JsonHouseDto
+			if err := json.Unmarshal(data, &old); err != nil {
+				return err
+			}
+			if old.AoGuid != keepParent {
+				if err := parentBucket.Delete(a.parentKey(old.AoGuid, id)); err != nil {
+					return err
+				}
+			}
+			if keepParent == "" && string(guidBucket.Get([]byte(old.HouseGuid))) == id {
+				return guidBucket.Delete([]byte(old.HouseGuid))
+			}
+
+			return nil
+		}
+
+		for _, item := range items {
+			if err := removeLinks(item.ID, item.AoGuid); err != nil {
+				return err
+			}
+			data, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put([]byte(item.ID), data); err != nil {
+				return err
+			}
+			if err := guidBucket.Put([]byte(item.HouseGuid), []byte(item.ID)); err != nil {
+				return err
+			}
+			if err := parentBucket.Put(a.parentKey(item.AoGuid, item.ID), nil); err != nil {
+				return err
+			}
+		}
+		for _, id := range deleted {
+			if err := removeLinks(id, ""); err != nil {
+				return err
+			}
+			if err := bucket.Delete([]byte(id)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	index, err := a.index()
+	if err != nil {
+		return err
+	}
+	batch := index.NewBatch()
+	for _, item := range items {
+		if err := batch.Index(item.ID, item.ToSearchDocument()); err != nil {
+			return err
+		}
+	}
+	for _, id := range deleted {
+		batch.Delete(id)
+	}
+
+	return index.Batch(batch)
+}
+
+// Изменить сохраненные элементы по идентификаторам
+func (a *EmbeddedHouseRepository) modify(ids []string, modify func(item *dto.JsonHouseDto)) error {
+	items, err := a.getByIds(util.UniqueStringSlice(ids))
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	for i := range items {
+		modify(&items[i])
+	}
+
+	return a.save(items, nil)
+}
+
+// Обновить иерархию домов
+func (a *EmbeddedHouseRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	values := make(map[string]string)
+	var ids []string
+	for _, item := range items {
+		values[item.ObjectId] = item.ParentGuid
+		ids = append(ids, item.ObjectId)
+	}
+
+	return a.modify(ids, func(item *dto.JsonHouseDto) {
+		item.AoGuid = values[item.ID]
+		// Обновляет дату изменения для перестроения полного адреса при индексации
+		item.UpdateBazisDate()
+	})
+}
+
+// Обновить параметры домов
+func (a *EmbeddedHouseRepository) UpdateParams(items []entity.ParamObject) error {
+	params := make(map[string][]entity.ParamObject)
+	var ids []string
+	for _, item := range items {
+		switch item.TypeId {
+		case entity.ParamPostalCode, entity.ParamOkato, entity.ParamOktmo, entity.ParamCadNum:
+			params[item.ObjectId] = append(params[item.ObjectId], item)
+			ids = append(ids, item.ObjectId)
+		}
+	}
+
+	return a.modify(ids, func(item *dto.JsonHouseDto) {
+		for _, param := range params[item.ID] {
+			switch param.TypeId {
+			case entity.ParamPostalCode:
+				item.PostalCode = param.Value
+			case entity.ParamOkato:
+				item.Okato = param.Value
+			case entity.ParamOktmo:
+				item.Oktmo = param.Value
+			case entity.ParamCadNum:
+				item.CadNum = param.Value
+			}
+		}
+	})
+}
+
+// Подсчитать количество домов в БД по фильтру
+func (a *EmbeddedHouseRepository) CountAllData(filter interface{}) (int64, error) {
+	searchQuery, ok := filter.(query.Query)
+	if !ok {
+		return a.embeddedClient.CountAllData(a.bucketName)
+	}
+	index, err := a.index()
+	if err != nil {
+		return 0, err
+	}
+	res, err := index.Search(bleve.NewSearchRequestOptions(searchQuery, 0, 0, false))
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(res.Total), nil
+}
+
+// Индексация домов
+func (a *EmbeddedHouseRepository) Index(start time.Time, indexChan <-chan entity.IndexObject, GetIndexObjects repository.GetIndexObjects) error {
+	done := make(chan bool)
+	// Создает канал для сохранения объектов в БД
+	a.results = make(chan dto.JsonHouseDto, a.noOfWorkers)
+	var total int64
+	// Ищет элементы по дате
+	if indexChan == nil {
+		items, err := a.getUpdatedItems(start)
+		if err != nil {
+			return err
+		}
+		total = int64(len(items))
+		go a.getItemsByDate(items, GetIndexObjects)
+	}
+	// Обновляет элементы в БД
+	go a.saveIndexItems(total, done)
+	// Создает пул задач на обработку элементов
+	if indexChan != nil {
+		a.createWorkerPool(a.noOfWorkers, indexChan)
+	}
+	<-done
+
+	return nil
+}
+
+// Получить дома из канала адресов
+func (a *EmbeddedHouseRepository) getItemsByAddress(wg *sync.WaitGroup, indexChan <-chan entity.IndexObject) {
+	defer wg.Done()
+	indexObjectList := make(map[string]entity.IndexObject)
+	for d := range indexChan {
+		indexObjectList[d.AoGuid] = d
+		if len(indexObjectList) >= a.batchSize {
+			a.prepareIndexChanHouses(indexObjectList)
+		}
+	}
+	if len(indexObjectList) > 0 {
+		a.prepareIndexChanHouses(indexObjectList)
+	}
+}
+
+// Подготовить дома для индексации из канала адресов
+func (a *EmbeddedHouseRepository) prepareIndexChanHouses(indexObjectList map[string]entity.IndexObject) {
+	var guids []string
+	for k := range indexObjectList {
+		guids = append(guids, k)
+	}
+	// Получает список домов по GUID адресов
+	houses, err := a.GetByAddressGuidList(guids)
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err, "ao_guids": guids}).Fatal("Get houses failed")
+		for k := range indexObjectList {
+			delete(indexObjectList, k)
+		}
+		return
+	}
+	for _, house := range houses {
+		saveItem := dto.JsonHouseDto{}
+		indexObject, ok := indexObjectList[house.AoGuid]
+		if ok {
+			// Конвертирует объект дома в DTO
+			saveItem.GetFromEntity(*house)
+			// Заполняет данные для поиска из элемента канала
+			a.prepareItem(&saveItem, indexObject)
+			a.results <- saveItem
+		}
+	}
+	// Очищает список объектов
+	for k := range indexObjectList {
+		delete(indexObjectList, k)
+	}
+}
+
+// Получить обновленные дома из БД пачками
+func (a *EmbeddedHouseRepository) getItemsByDate(updated []dto.JsonHouseDto, GetIndexObjects repository.GetIndexObjects) {
+	defer close(a.results)
+	count := 0
+	for start := 0; start < len(updated); start += a.batchSize {
+		end := start + a.batchSize
+		if end > len(updated) {
+			end = len(updated)
+		}
+		var ids, guids []string
+		for _, item := range updated[start:end] {
+			ids = append(ids, item.ID)
+			guids = append(guids, item.AoGuid)
+		}
+		list, err := a.getByIds(ids)
+		if err != nil {
+			a.logger.Fatal(err.Error())
+		}
+		count += len(list)
+		a.prepareQueryHouses(list, guids, GetIndexObjects)
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": count}).Info("Houses update count")
+}
+
+// Подготовить пачку домов для индексации
+func (a *EmbeddedHouseRepository) prepareQueryHouses(list []dto.JsonHouseDto, guids []string, GetIndexObjects repository.GetIndexObjects) {
+	objectsList := GetIndexObjects(util.UniqueStringSlice(guids))
+	for _, item := range list {
+		object, ok := objectsList[item.AoGuid]
+		if ok {
+			a.prepareItem(&item, object)
+			a.results <- item
+		}
+	}
+}
+
+// Подготовить дома перед записью
+func (a *EmbeddedHouseRepository) prepareItem(item *dto.JsonHouseDto, object entity.IndexObject) {
+	// Формирует информацию об адресе объекта
+	suggest := "дом д. " + item.HouseNum
+	if item.StructNum != "" {
+		suggest += ", строение стр. " + item.StructNum
+	}
+	if item.BuildNum != "" {
+		suggest += ", корпус кор. " + item.BuildNum
+	}
+	item.AddressSuggest = object.AddressSuggest + ", " + suggest
+	item.FullAddress = object.FullAddress + ", " + item.HouseFullNum
+	// Устанавливает время обновления объекта
+	item.UpdateBazisDate()
+}
+
+// Создать пул задач на обработку элементов
+func (a *EmbeddedHouseRepository) createWorkerPool(noOfWorkers int, indexChan <-chan entity.IndexObject) {
+	var wg sync.WaitGroup
+	for i := 0; i < noOfWorkers; i++ {
+		wg.Add(1)
+		// Подготавливает элементы перед сохранением в БД
+		go a.getItemsByAddress(&wg, indexChan)
+	}
+	wg.Wait()
+	close(a.results)
+}
+
+// Обновить элементы в БД
+func (a *EmbeddedHouseRepository) saveIndexItems(total int64, done chan bool) {
+	var items []dto.JsonHouseDto
+	begin := time.Now()
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing houses", false)
+
+	for d := range a.results {
+		// Добавляет объект в очередь на сохранение
+		items = append(items, d)
+		bar.Increment()
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(items) >= a.batchSize {
+			a.saveIndexBatch(items)
+			items = nil
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(items) > 0 {
+		a.saveIndexBatch(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("House index execution time")
+	done <- true
+}
+
+// Сохранить пачку проиндексированных элементов
+func (a *EmbeddedHouseRepository) saveIndexBatch(items []dto.JsonHouseDto) {
+	if err := a.save(items, nil); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("House index batch commit failed")
+		os.Exit(1)
+	}
+}
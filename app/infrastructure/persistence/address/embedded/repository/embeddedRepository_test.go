@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"fmt"
+	cache "github.com/AeroAgency/golang-bigcache-lib"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/domain/address/service"
+	directoryEntity "github.com/GarinAG/gofias/domain/directory/entity"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	journalRepository "github.com/GarinAG/gofias/infrastructure/persistence/journal/embedded/repository"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/allegro/bigcache"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// Конфигурация встроенного хранилища во временной директории
+type testConfig struct {
+	path string
+}
+
+func (c testConfig) Init() error                                   { return nil }
+func (c testConfig) GetString(key string, def ...string) string    { return "" }
+func (c testConfig) GetBool(key string) bool                       { return false }
+func (c testConfig) GetInt(key string, def ...int) int             { return 0 }
+func (c testConfig) GetFloat64(key string, def ...float64) float64 { return 0 }
+func (c testConfig) GetConfig() interfaces.BaseConfig {
+	return interfaces.BaseConfig{Embedded: interfaces.EmbeddedConfig{Path: c.path}, BatchSize: 1000}
+}
+
+const testAddressXml = `<?xml version="1.0" encoding="utf-8"?><AddressObjects>
+<Object AOID="a1" AOGUID="r1" PARENTGUID="" FORMALNAME="Новосибирская" OFFNAME="Новосибирская" SHORTNAME="обл" AOLEVEL="1" REGIONCODE="54" ACTSTATUS="1" LIVESTATUS="1" CURRSTATUS="0" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<Object AOID="a2" AOGUID="c1" PARENTGUID="r1" FORMALNAME="Новосибирск" OFFNAME="Новосибирск" SHORTNAME="г" AOLEVEL="4" REGIONCODE="54" ACTSTATUS="1" LIVESTATUS="1" CURRSTATUS="0" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<Object AOID="a3" AOGUID="s1" PARENTGUID="c1" FORMALNAME="Ленина" OFFNAME="Ленина" SHORTNAME="ул" AOLEVEL="7" REGIONCODE="54" ACTSTATUS="1" LIVESTATUS="1" CURRSTATUS="0" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<Object AOID="a4" AOGUID="s2" PARENTGUID="c1" FORMALNAME="Мира" OFFNAME="Мира" SHORTNAME="ул" AOLEVEL="7" REGIONCODE="54" ACTSTATUS="1" LIVESTATUS="1" CURRSTATUS="0" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<Object AOID="a5" AOGUID="s3" PARENTGUID="c1" FORMALNAME="Старая" OFFNAME="Старая" SHORTNAME="ул" AOLEVEL="7" REGIONCODE="54" ACTSTATUS="0" LIVESTATUS="0" CURRSTATUS="1" STARTDATE="2000-01-01" ENDDATE="2010-01-01" UPDATEDATE="2010-01-01"/>
+</AddressObjects>`
+
+const testHouseXml = `<?xml version="1.0" encoding="utf-8"?><Houses>
+<House HOUSEID="h1" HOUSEGUID="hg1" AOGUID="s1" HOUSENUM="7" POSTALCODE="630000" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<House HOUSEID="h2" HOUSEGUID="hg2" AOGUID="s1" HOUSENUM="9" BUILDNUM="1" POSTALCODE="630000" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+<House HOUSEID="h3" HOUSEGUID="hg3" AOGUID="s2" HOUSENUM="7" POSTALCODE="630001" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>
+</Houses>`
+
+// Записать файл импорта во временную директорию
+func writeTestFile(t *testing.T, dir string, name string, data string) directoryEntity.File {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return directoryEntity.File{Path: path}
+}
+
+// Встроенное хранилище во временной директории с сервисами импорта
+type testStore struct {
+	dir           string
+	client        *embeddedHelper.Client
+	addressRepo   repository.AddressRepositoryInterface
+	houseRepo     repository.HouseRepositoryInterface
+	addressImport *service.AddressImportService
+	houseImport   *service.HouseImportService
+}
+
+// Создать хранилище во временной директории
+func newTestStore(t *testing.T) *testStore {
+	dir, err := ioutil.TempDir("", "gofias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger := testLogger{}
+	config := testConfig{path: filepath.Join(dir, "data")}
+	client := embeddedHelper.NewEmbeddedClient(config, logger)
+	bigCache, _ := bigcache.NewBigCache(bigcache.DefaultConfig(time.Minute))
+
+	addressRepo := NewEmbeddedAddressRepository(client, logger, 1000, "", 1, cache.NewBigCache(bigCache))
+	houseRepo := NewEmbeddedHouseRepository(client, logger, 1000, "", 1)
+	journal := journalService.NewJournalService(journalRepository.NewEmbeddedJournalRepository(client, config), logger)
+	regions := service.NewRegionFilter(addressRepo)
+	store := &testStore{
+		dir:           dir,
+		client:        client,
+		addressRepo:   addressRepo,
+		houseRepo:     houseRepo,
+		addressImport: service.NewAddressImportService(addressRepo, logger, journal, regions, nil),
+		houseImport:   service.NewHouseImportService(houseRepo, logger, journal, regions),
+	}
+	store.addressImport.IsFull = true
+	store.houseImport.IsFull = true
+
+	return store
+}
+
+// Закрыть хранилище и удалить временную директорию
+func (s *testStore) Close() {
+	s.client.Close()
+	os.RemoveAll(s.dir)
+}
+
+// Полный импорт файлов адресов и домов с индексацией, возвращает количество загруженных объектов
+func (s *testStore) importFiles(t *testing.T, addressXml string, houseXml string) int {
+	begin := time.Now()
+	var wg sync.WaitGroup
+	cnt := make(chan int)
+	wg.Add(2)
+	go s.addressImport.Import(writeTestFile(t, s.dir, "AS_ADDROBJ_20201010.XML", addressXml), &wg, cnt)
+	go s.houseImport.Import(writeTestFile(t, s.dir, "AS_HOUSE_20201010.XML", houseXml), &wg, cnt)
+	total := <-cnt + <-cnt
+	wg.Wait()
+
+	// Индексирует адреса и дома измененных адресов
+	indexChan := make(chan entity.IndexObject, 1)
+	getIndexObjects := func(guids []string) map[string]entity.IndexObject {
+		list := make(map[string]entity.IndexObject)
+		items, _ := s.addressRepo.GetAddressByGuidList(guids)
+		for _, item := range items {
+			list[item.AoGuid] = entity.IndexObject{AoGuid: item.AoGuid, FullAddress: item.FullAddress, AddressSuggest: item.AddressSuggest}
+		}
+
+		return list
+	}
+	wg.Add(2)
+	go s.addressImport.Index(true, begin, nil, &wg, indexChan)
+	go s.houseImport.Index(begin, &wg, indexChan, getIndexObjects)
+	wg.Wait()
+
+	return total
+}
+
+// Полный импорт файлов ФИАС во встроенное хранилище, индексация и поиск по адресам и домам
+func TestEmbeddedImportAndSearch(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+	addressRepo, houseRepo := store.addressRepo, store.houseRepo
+
+	if total := store.importFiles(t, testAddressXml, testHouseXml); total != 7 {
+		t.Errorf("got %d imported items, want 7", total)
+	}
+
+	addressTests := []struct {
+		term   string
+		aoGuid string
+	}{
+		{"новосибирск ленина", "s1"},
+		{"лен", "s1"},
+		{"мира", "s2"},
+		{"ktybyf", "s1"},
+		{"старая", ""},
+	}
+	for _, test := range addressTests {
+		items, err := addressRepo.GetAddressByTerm(test.term, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.aoGuid == "" {
+			if len(items) > 0 {
+				t.Errorf("address %q: got %q, want nothing", test.term, items[0].FullAddress)
+			}
+			continue
+		}
+		if len(items) == 0 || items[0].AoGuid != test.aoGuid {
+			var found []string
+			for _, item := range items {
+				found = append(found, item.FullAddress)
+			}
+			t.Errorf("address %q: got %v, want %q", test.term, found, test.aoGuid)
+		}
+	}
+
+	houseTests := []struct {
+		term      string
+		houseGuid string
+	}{
+		{"ленина 7", "hg1"},
+		{"новосибирск мира 7", "hg3"},
+		{"ленина 9 к1", "hg2"},
+	}
+	for _, test := range houseTests {
+		items, err := houseRepo.GetAddressByTerm(test.term, 10, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) == 0 || items[0].HouseGuid != test.houseGuid {
+			var found []string
+			for _, item := range items {
+				found = append(found, item.FullAddress)
+			}
+			t.Errorf("house %q: got %v, want %q", test.term, found, test.houseGuid)
+		}
+	}
+}
+
+// Постраничный поиск по подстроке проходит все адреса без повторов через границы окна ранжирования и вариантов подстроки
+func TestEmbeddedAddressPaging(t *testing.T) {
+	store := newTestStore(t)
+	defer store.Close()
+
+	object := `<Object AOID="%s" AOGUID="%s" PARENTGUID="%s" FORMALNAME="%s" OFFNAME="%s" SHORTNAME="%s" AOLEVEL="%d" REGIONCODE="54" ACTSTATUS="1" LIVESTATUS="1" CURRSTATUS="0" STARTDATE="2000-01-01" ENDDATE="2079-06-06" UPDATEDATE="2020-01-01"/>`
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?><AddressObjects>`)
+	b.WriteString(fmt.Sprintf(object, "a0", "r1", "", "Новосибирская", "Новосибирская", "обл", 1))
+	// Точные совпадения улиц в 60 городах и 3 улицы, найденные только с учетом опечатки
+	for i := 1; i <= 60; i++ {
+		city := fmt.Sprintf("Город%02d", i)
+		b.WriteString(fmt.Sprintf(object, "ac"+strconv.Itoa(i), "c"+strconv.Itoa(i), "r1", city, city, "г", 4))
+		b.WriteString(fmt.Sprintf(object, "as"+strconv.Itoa(i), "s"+strconv.Itoa(i), "c"+strconv.Itoa(i), "Ленина", "Ленина", "ул", 7))
+	}
+	for i := 1; i <= 3; i++ {
+		b.WriteString(fmt.Sprintf(object, "af"+strconv.Itoa(i), "f"+strconv.Itoa(i), "c"+strconv.Itoa(i), "Ленино", "Ленино", "ул", 7))
+	}
+	b.WriteString(`</AddressObjects>`)
+	store.importFiles(t, b.String(), `<?xml version="1.0" encoding="utf-8"?><Houses></Houses>`)
+
+	logger := testLogger{}
+	addressService := service.NewAddressService(store.addressRepo, service.NewRankingService(store.addressRepo, logger, testConfig{}), logger)
+	tests := []struct {
+		name    string
+		ranking entity.RankingOptions
+	}{
+		{"without ranking", entity.RankingOptions{}},
+		{"with ranking", entity.RankingOptions{Level: 1}},
+	}
+	for _, test := range tests {
+		var guids []string
+		seen := make(map[string]bool)
+		var cursor *entity.Cursor
+		for page := 0; page < 20; page++ {
+			ranking := test.ranking
+			items, next := addressService.GetAddressByTermPage("ленина", 7, cursor, &ranking)
+			for _, item := range items {
+				if seen[item.AoGuid] {
+					t.Errorf("%s: page %d: duplicate %s", test.name, page, item.AoGuid)
+				}
+				seen[item.AoGuid] = true
+				guids = append(guids, item.AoGuid)
+			}
+			if next == nil {
+				break
+			}
+			// Курсор передается клиенту токеном
+			decoded, err := entity.DecodeCursor(next.Encode())
+			if err != nil {
+				t.Fatalf("%s: page %d: %v", test.name, page, err)
+			}
+			cursor = decoded
+		}
+		if len(guids) != 63 {
+			t.Errorf("%s: got %d addresses, want 63", test.name, len(guids))
+			continue
+		}
+		for i, guid := range guids {
+			if (i < 60) != strings.HasPrefix(guid, "s") {
+				t.Errorf("%s: got %s at position %d", test.name, guid, i)
+			}
+		}
+	}
+}
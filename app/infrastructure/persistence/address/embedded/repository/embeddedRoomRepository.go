@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+// Репозиторий помещений во встроенном хранилище
+type EmbeddedRoomRepository struct {
+	*embeddedChildRepository
+}
+
+// Инициализация репозитория
+func NewEmbeddedRoomRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.RoomRepositoryInterface {
+	return &EmbeddedRoomRepository{
+		embeddedChildRepository: newEmbeddedChildRepository(embeddedClient, logger, batchSize, prefix+entity.RoomObject{}.TableName(), childObjects{
+			name:  "rooms",
+			title: "Room",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.JsonRoomDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.JsonRoomDto{}
+				saveItem.GetFromEntity(item.(entity.RoomObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты помещений
+func (a *EmbeddedRoomRepository) toEntities(items []dto.ChildObjectDto) []*entity.RoomObject {
+	var list []*entity.RoomObject
+	for _, item := range items {
+		list = append(list, item.(*dto.JsonRoomDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти помещение по GUID
+func (a *EmbeddedRoomRepository) GetByGuid(guid string) (*entity.RoomObject, error) {
+	items, err := a.GetByGuidList([]string{guid})
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить помещения по GUID
+func (a *EmbeddedRoomRepository) GetByGuidList(guids []string) ([]*entity.RoomObject, error) {
+	items, err := a.getByGuidList(guids)
+
+	return a.toEntities(items), err
+}
+
+// Найти помещения по GUID дома
+func (a *EmbeddedRoomRepository) GetByHouseGuid(guid string) ([]*entity.RoomObject, error) {
+	items, err := a.getByParentGuid(guid)
+
+	return a.toEntities(items), err
+}
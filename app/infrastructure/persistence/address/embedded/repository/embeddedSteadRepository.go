@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/dto"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+// Репозиторий участков во встроенном хранилище
+type EmbeddedSteadRepository struct {
+	*embeddedChildRepository
+}
+
+// Инициализация репозитория
+func NewEmbeddedSteadRepository(embeddedClient *embeddedHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.SteadRepositoryInterface {
+	return &EmbeddedSteadRepository{
+		embeddedChildRepository: newEmbeddedChildRepository(embeddedClient, logger, batchSize, prefix+entity.SteadObject{}.TableName(), childObjects{
+			name:  "steads",
+			title: "Stead",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.JsonSteadDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.JsonSteadDto{}
+				saveItem.GetFromEntity(item.(entity.SteadObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты участков
+func (a *EmbeddedSteadRepository) toEntities(items []dto.ChildObjectDto) []*entity.SteadObject {
+	var list []*entity.SteadObject
+	for _, item := range items {
+		list = append(list, item.(*dto.JsonSteadDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти участок по GUID
+func (a *EmbeddedSteadRepository) GetByGuid(guid string) (*entity.SteadObject, error) {
+	items, err := a.GetByGuidList([]string{guid})
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить участки по GUID
+func (a *EmbeddedSteadRepository) GetByGuidList(guids []string) ([]*entity.SteadObject, error) {
+	items, err := a.getByGuidList(guids)
+
+	return a.toEntities(items), err
+}
+
+// Найти участки по GUID адреса
+func (a *EmbeddedSteadRepository) GetByAddressGuid(guid string) ([]*entity.SteadObject, error) {
+	items, err := a.getByParentGuid(guid)
+
+	return a.toEntities(items), err
+}
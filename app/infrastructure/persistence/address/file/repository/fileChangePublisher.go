@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Публикация изменений в файл в формате JSON Lines
+type FileChangePublisher struct {
+	path string     // Путь к файлу журнала изменений
+	file *os.File   // Файл журнала изменений
+	mu   sync.Mutex // Блокировка записи в файл
+}
+
+// Инициализация публикации изменений, файл открывается на дозапись
+func NewFileChangePublisher(path string) (repository.ChangePublisherInterface, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileChangePublisher{path: path, file: file}, nil
+}
+
+// Опубликовать записи журнала изменений
+func (p *FileChangePublisher) Publish(items []entity.ChangeLogItem) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	encoder := json.NewEncoder(p.file)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+
+	return p.file.Sync()
+}
+
+// Получить идентификатор последней записи файла
+func (p *FileChangePublisher) GetLastPublished() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	line, err := p.readLastLine()
+	if err != nil || len(line) == 0 {
+		return "", err
+	}
+	var item entity.ChangeLogItem
+	if err := json.Unmarshal(line, &item); err != nil {
+		return "", err
+	}
+
+	return item.ID, nil
+}
+
+// Прочитать последнюю непустую строку файла, файл читается с конца блоками
+func (p *FileChangePublisher) readLastLine() ([]byte, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	const blockSize = 4096
+	var tail []byte
+	for offset := info.Size(); offset > 0; {
+		size := int64(blockSize)
+		if offset < size {
+			size = offset
+		}
+		offset -= size
+		block := make([]byte, size)
+		if _, err := file.ReadAt(block, offset); err != nil && err != io.EOF {
+			return nil, err
+		}
+		tail = append(block, tail...)
+		line := bytes.TrimRight(tail, "\n")
+		if pos := bytes.LastIndexByte(line, '\n'); pos >= 0 {
+			return line[pos+1:], nil
+		}
+	}
+
+	return bytes.TrimRight(tail, "\n"), nil
+}
+
+// Закрыть файл
+func (p *FileChangePublisher) Close() error {
+	return p.file.Close()
+}
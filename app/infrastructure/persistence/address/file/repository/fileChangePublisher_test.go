@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Последняя непустая строка читается, в том числе когда она длиннее блока чтения
+func TestReadLastLine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gofias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	long := strings.Repeat("x", 10000)
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"empty", "", ""},
+		{"single line", "a", "a"},
+		{"trailing newlines", "a\nb\n\n", "b"},
+		{"only newlines", "\n\n", ""},
+		{"long last line", "a\n" + long + "\n", long},
+		{"line on block boundary", strings.Repeat("y", 4095) + "\nb\n", "b"},
+		{"long first line", long + "\nb", "b"},
+	}
+	for i, test := range tests {
+		path := filepath.Join(dir, strings.Repeat("f", i+1)+".jsonl")
+		if err := ioutil.WriteFile(path, []byte(test.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		publisher := &FileChangePublisher{path: path}
+		line, err := publisher.readLastLine()
+		if err != nil {
+			t.Errorf("%s: got error %v", test.name, err)
+			continue
+		}
+		if string(line) != test.want {
+			t.Errorf("%s: got %d bytes %.20q, want %d bytes %.20q", test.name, len(line), line, len(test.want), test.want)
+		}
+	}
+}
+
+// Опубликованные записи дописываются в файл, после повторного открытия публикация продолжается после последней записи
+func TestFileChangePublisher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gofias")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "changes", "changes.jsonl")
+
+	publisher, err := NewFileChangePublisher(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last, err := publisher.GetLastPublished(); last != "" || err != nil {
+		t.Errorf("got last %q %v for new file", last, err)
+	}
+	first := []entity.ChangeLogItem{
+		entity.NewChangeLogItem(1, entity.ChangeObjectAddress, "a1", entity.ChangeInserted, "54"),
+		entity.NewChangeLogItem(1, entity.ChangeObjectHouse, "h1", entity.ChangeInserted, "54"),
+	}
+	if err := publisher.Publish(first); err != nil {
+		t.Fatal(err)
+	}
+	publisher.Close()
+
+	publisher, err = NewFileChangePublisher(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer publisher.Close()
+	if last, err := publisher.GetLastPublished(); last != first[1].ID || err != nil {
+		t.Errorf("got last %q %v, want %q", last, err, first[1].ID)
+	}
+	second := entity.NewChangeLogItem(2, entity.ChangeObjectAddress, "a1", entity.ChangeUpdated, "54")
+	if err := publisher.Publish([]entity.ChangeLogItem{second}); err != nil {
+		t.Fatal(err)
+	}
+	if last, err := publisher.GetLastPublished(); last != second.ID || err != nil {
+		t.Errorf("got last %q %v, want %q", last, err, second.ID)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	want := []string{
+		`{"id":"0000000001_address_a1","version_id":1,"object_type":"address","guid":"a1","change_type":"inserted","region_code":"54"}`,
+		`{"id":"0000000001_house_h1","version_id":1,"object_type":"house","guid":"h1","change_type":"inserted","region_code":"54"}`,
+		`{"id":"0000000002_address_a1","version_id":2,"object_type":"address","guid":"a1","change_type":"updated","region_code":"54"}`,
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(lines), len(want))
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d: got %s, want %s", i, lines[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"strings"
+	"time"
+)
+
+// Объект адреса в PostgreSQL
+type PgAddressDto struct {
+	ID                string `gorm:"column:ao_id"`
+	AoGuid            string `gorm:"column:ao_guid"`
+	ParentGuid        string `gorm:"column:parent_guid"`
+	MunParentGuid     string `gorm:"column:mun_parent_guid"`
+	FormalName        string `gorm:"column:formal_name"`
+	ShortName         string `gorm:"column:short_name"`
+	AoLevel           int    `gorm:"column:ao_level"`
+	OffName           string `gorm:"column:off_name"`
+	FullName          string `gorm:"column:full_name"`
+	Code              string `gorm:"column:code"`
+	RegionCode        string `gorm:"column:region_code"`
+	PostalCode        string `gorm:"column:postal_code"`
+	Okato             string `gorm:"column:okato"`
+	Oktmo             string `gorm:"column:oktmo"`
+	ActStatus         string `gorm:"column:act_status"`
+	LiveStatus        string `gorm:"column:live_status"`
+	CurrStatus        string `gorm:"column:curr_status"`
+	StartDate         string `gorm:"column:start_date"`
+	EndDate           string `gorm:"column:end_date"`
+	UpdateDate        string `gorm:"column:update_date"`
+	CentStatus        int    `gorm:"column:cent_status"`
+	Population        int    `gorm:"column:population"`
+	RegionGuid        string `gorm:"column:district_guid"`
+	RegionKladr       string `gorm:"column:district_kladr"`
+	Region            string `gorm:"column:district"`
+	RegionType        string `gorm:"column:district_type"`
+	RegionFull        string `gorm:"column:district_full"`
+	AreaGuid          string `gorm:"column:area_guid"`
+	AreaKladr         string `gorm:"column:area_kladr"`
+	Area              string `gorm:"column:area"`
+	AreaType          string `gorm:"column:area_type"`
+	AreaFull          string `gorm:"column:area_full"`
+	CityGuid          string `gorm:"column:city_guid"`
+	CityKladr         string `gorm:"column:city_kladr"`
+	City              string `gorm:"column:city"`
+	CityType          string `gorm:"column:city_type"`
+	CityFull          string `gorm:"column:city_full"`
+	SettlementGuid    string `gorm:"column:settlement_guid"`
+	SettlementKladr   string `gorm:"column:settlement_kladr"`
+	Settlement        string `gorm:"column:settlement"`
+	SettlementType    string `gorm:"column:settlement_type"`
+	SettlementFull    string `gorm:"column:settlement_full"`
+	StreetGuid        string `gorm:"column:street_guid"`
+	StreetKladr       string `gorm:"column:street_kladr"`
+	Street            string `gorm:"column:street"`
+	StreetType        string `gorm:"column:street_type"`
+	StreetFull        string `gorm:"column:street_full"`
+	AddressSuggest    string `gorm:"column:address_suggest"`
+	FullAddress       string `gorm:"column:full_address"`
+	MunAddressSuggest string `gorm:"column:mun_address_suggest"`
+	MunFullAddress    string `gorm:"column:mun_full_address"`
+	Location          string `gorm:"column:location"`
+	BazisUpdateDate   string `gorm:"column:bazis_update_date"`
+}
+
+// Конвертирует объект адреса PostgreSQL в объект адрес
+func (item *PgAddressDto) ToEntity() *entity.AddressObject {
+	address := entity.AddressObject{}
+	model.Copy(&address, item)
+
+	return &address
+}
+
+// Конвертирует объект адреса в объект адреса PostgreSQL
+func (item *PgAddressDto) GetFromEntity(entity entity.AddressObject) {
+	model.Copy(item, entity)
+	item.FormalName = strings.Trim(entity.FormalName, " -.,")
+	item.ShortName = strings.Trim(entity.ShortName, " -.,")
+	item.OffName = strings.Trim(entity.OffName, " -.,")
+	if item.FullName == "" {
+		item.FullName = util.PrepareFullName(item.ShortName, item.FormalName)
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.FullName
+	}
+	if item.AddressSuggest == "" {
+		item.AddressSuggest = util.PrepareSuggest("", item.ShortName, item.FormalName)
+	}
+	if item.MunFullAddress == "" {
+		item.MunFullAddress = item.FullAddress
+	}
+	if item.MunAddressSuggest == "" {
+		item.MunAddressSuggest = item.AddressSuggest
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *PgAddressDto) IsActive() bool {
+	if item.CurrStatus != "0" ||
+		item.ActStatus != "1" ||
+		item.LiveStatus != "1" {
+
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *PgAddressDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Заполняет объект адреса PostgreSQL из данных адреса
+func (item *PgAddressDto) UpdateFromExistItem(entity entity.AddressObject) {
+	// Объекты ГАР не содержат иерархию и параметры, они сохраняются из БД
+	if item.ParentGuid == "" {
+		item.ParentGuid = entity.ParentGuid
+	}
+	if item.MunParentGuid == "" {
+		item.MunParentGuid = entity.MunParentGuid
+	}
+	if item.Code == "" {
+		item.Code = entity.Code
+	}
+	if item.PostalCode == "" {
+		item.PostalCode = entity.PostalCode
+	}
+	if item.Okato == "" {
+		item.Okato = entity.Okato
+	}
+	if item.Oktmo == "" {
+		item.Oktmo = entity.Oktmo
+	}
+	if item.CentStatus == 0 {
+		item.CentStatus = entity.CentStatus
+	}
+	if entity.FullAddress != "" {
+		item.FullAddress = entity.FullAddress
+	}
+	if entity.AddressSuggest != "" {
+		item.AddressSuggest = entity.AddressSuggest
+	}
+	if entity.MunFullAddress != "" {
+		item.MunFullAddress = entity.MunFullAddress
+	}
+	if entity.MunAddressSuggest != "" {
+		item.MunAddressSuggest = entity.MunAddressSuggest
+	}
+	if entity.RegionGuid != "" {
+		item.RegionGuid = entity.RegionGuid
+	}
+	if entity.Region != "" {
+		item.Region = entity.Region
+	}
+	if entity.RegionType != "" {
+		item.RegionType = entity.RegionType
+	}
+	if entity.RegionFull != "" {
+		item.RegionFull = entity.RegionFull
+	}
+	if entity.AreaGuid != "" {
+		item.AreaGuid = entity.AreaGuid
+	}
+	if entity.Area != "" {
+		item.Area = entity.Area
+	}
+	if entity.AreaType != "" {
+		item.AreaType = entity.AreaType
+	}
+	if entity.AreaFull != "" {
+		item.AreaFull = entity.AreaFull
+	}
+	if entity.CityGuid != "" {
+		item.CityGuid = entity.CityGuid
+	}
+	if entity.City != "" {
+		item.City = entity.City
+	}
+	if entity.CityType != "" {
+		item.CityType = entity.CityType
+	}
+	if entity.CityFull != "" {
+		item.CityFull = entity.CityFull
+	}
+	if entity.SettlementGuid != "" {
+		item.SettlementGuid = entity.SettlementGuid
+	}
+	if entity.Settlement != "" {
+		item.Settlement = entity.Settlement
+	}
+	if entity.SettlementType != "" {
+		item.SettlementType = entity.SettlementType
+	}
+	if entity.SettlementFull != "" {
+		item.SettlementFull = entity.SettlementFull
+	}
+	if entity.Street != "" {
+		item.Street = entity.Street
+	}
+	if entity.StreetType != "" {
+		item.StreetType = entity.StreetType
+	}
+	if entity.StreetFull != "" {
+		item.StreetFull = entity.StreetFull
+	}
+	if entity.Location != "" {
+		item.Location = entity.Location
+	}
+	if entity.Population > 0 {
+		item.Population = entity.Population
+	}
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"gopkg.in/jeevatkm/go-model.v1"
+)
+
+// Объект версии адреса в PostgreSQL
+type PgAddressHistoryDto struct {
+	ID         string `gorm:"column:ao_id"`
+	AoGuid     string `gorm:"column:ao_guid"`
+	ParentGuid string `gorm:"column:parent_guid"`
+	FormalName string `gorm:"column:formal_name"`
+	ShortName  string `gorm:"column:short_name"`
+	OffName    string `gorm:"column:off_name"`
+	AoLevel    int    `gorm:"column:ao_level"`
+	Code       string `gorm:"column:code"`
+	RegionCode string `gorm:"column:region_code"`
+	PostalCode string `gorm:"column:postal_code"`
+	Okato      string `gorm:"column:okato"`
+	Oktmo      string `gorm:"column:oktmo"`
+	ActStatus  string `gorm:"column:act_status"`
+	LiveStatus string `gorm:"column:live_status"`
+	CurrStatus string `gorm:"column:curr_status"`
+	StartDate  string `gorm:"column:start_date"`
+	EndDate    string `gorm:"column:end_date"`
+	UpdateDate string `gorm:"column:update_date"`
+	PrevId     string `gorm:"column:prev_id"`
+	NextId     string `gorm:"column:next_id"`
+}
+
+// Конвертирует объект версии адреса PostgreSQL в объект адреса
+func (item *PgAddressHistoryDto) ToEntity() *entity.AddressObject {
+	address := entity.AddressObject{}
+	model.Copy(&address, item)
+
+	return &address
+}
+
+// Конвертирует объект адреса в объект версии адреса PostgreSQL
+func (item *PgAddressHistoryDto) GetFromEntity(entity entity.AddressObject) {
+	model.Copy(item, entity)
+}
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/address/entity"
+)
+
+// Объект границы в PostgreSQL
+type PgBoundaryDto struct {
+	ID         string `gorm:"column:id"`
+	AoGuid     string `gorm:"column:ao_guid"`
+	AoLevel    int    `gorm:"column:ao_level"`
+	AdminLevel int    `gorm:"column:admin_level"`
+	Name       string `gorm:"column:name"`
+	Shape      string `gorm:"column:shape"`
+}
+
+// Конвертирует объект границы PostgreSQL в границу
+func (item *PgBoundaryDto) ToEntity() *entity.Boundary {
+	boundary := entity.Boundary{
+		ID:         item.ID,
+		AoGuid:     item.AoGuid,
+		AoLevel:    item.AoLevel,
+		AdminLevel: item.AdminLevel,
+		Name:       item.Name,
+	}
+	// Контур в формате GeoJSON выбирается не всегда
+	if item.Shape != "" {
+		var shape struct {
+			Coordinates entity.MultiPolygon `json:"coordinates"`
+		}
+		if err := json.Unmarshal([]byte(item.Shape), &shape); err == nil {
+			boundary.Polygons = shape.Coordinates
+		}
+	}
+
+	return &boundary
+}
+
+// Конвертирует границу в объект границы PostgreSQL
+func (item *PgBoundaryDto) GetFromEntity(entity entity.Boundary) error {
+	shape, err := json.Marshal(map[string]interface{}{
+		"type":        "MultiPolygon",
+		"coordinates": entity.Polygons,
+	})
+	if err != nil {
+		return err
+	}
+	item.ID = entity.ID
+	item.AoGuid = entity.AoGuid
+	item.AoLevel = entity.AoLevel
+	item.AdminLevel = entity.AdminLevel
+	item.Name = entity.Name
+	item.Shape = string(shape)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"gopkg.in/jeevatkm/go-model.v1"
+)
+
+// Объект записи журнала изменений в PostgreSQL
+type PgChangeLogDto struct {
+	ID         string `gorm:"column:id"`
+	VersionId  int    `gorm:"column:version_id"`
+	ObjectType string `gorm:"column:object_type"`
+	Guid       string `gorm:"column:guid"`
+	ChangeType string `gorm:"column:change_type"`
+	RegionCode string `gorm:"column:region_code"`
+}
+
+// Конвертирует объект записи журнала PostgreSQL в запись журнала изменений
+func (item *PgChangeLogDto) ToEntity() *entity.ChangeLogItem {
+	change := entity.ChangeLogItem{}
+	model.Copy(&change, item)
+
+	return &change
+}
+
+// Конвертирует запись журнала изменений в объект записи журнала PostgreSQL
+func (item *PgChangeLogDto) GetFromEntity(entity entity.ChangeLogItem) {
+	model.Copy(item, entity)
+}
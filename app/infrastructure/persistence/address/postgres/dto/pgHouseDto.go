@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"time"
+)
+
+// Объект дома в PostgreSQL
+type PgHouseDto struct {
+	ID              string `gorm:"column:house_id"`
+	HouseGuid       string `gorm:"column:house_guid"`
+	AoGuid          string `gorm:"column:ao_guid"`
+	HouseNum        string `gorm:"column:house_num"`
+	HouseFullNum    string `gorm:"column:house_full_num"`
+	FullAddress     string `gorm:"column:full_address"`
+	AddressSuggest  string `gorm:"column:address_suggest"`
+	PostalCode      string `gorm:"column:postal_code"`
+	Okato           string `gorm:"column:okato"`
+	Oktmo           string `gorm:"column:oktmo"`
+	StartDate       string `gorm:"column:start_date"`
+	EndDate         string `gorm:"column:end_date"`
+	UpdateDate      string `gorm:"column:update_date"`
+	DivType         string `gorm:"column:div_type"`
+	BuildNum        string `gorm:"column:build_num"`
+	StructNum       string `gorm:"column:str_num"`
+	Counter         string `gorm:"column:counter"`
+	CadNum          string `gorm:"column:cad_num"`
+	Location        string `gorm:"column:location"`
+	BazisUpdateDate string `gorm:"column:bazis_update_date"`
+}
+
+// Конвертирует объект дома PostgreSQL в объект дома
+func (item *PgHouseDto) ToEntity() *entity.HouseObject {
+	house := entity.HouseObject{}
+	model.Copy(&house, item)
+
+	return &house
+}
+
+// Конвертирует объект дома в объект дома PostgreSQL
+func (item *PgHouseDto) GetFromEntity(entity entity.HouseObject) {
+	model.Copy(item, entity)
+
+	if item.HouseFullNum == "" {
+		fullNum := "д. " + entity.HouseNum
+		if entity.StructNum != "" {
+			fullNum += ", стр. " + entity.StructNum
+		}
+		if entity.BuildNum != "" {
+			fullNum += ", кор. " + entity.BuildNum
+		}
+
+		item.HouseFullNum = fullNum
+	}
+	if item.AddressSuggest == "" {
+		suggest := "дом (д.) " + entity.HouseNum
+		if entity.StructNum != "" {
+			suggest += ", строение (стр.) " + entity.StructNum
+		}
+		if entity.BuildNum != "" {
+			suggest += ", корпус (кор.) " + entity.BuildNum
+		}
+
+		item.AddressSuggest = suggest
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.HouseFullNum
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *PgHouseDto) IsActive() bool {
+	end, err := time.Parse("2006-01-02", item.EndDate)
+	if err != nil || end.Unix() <= time.Now().Unix() {
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *PgHouseDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Заполняет объект дома PostgreSQL из данных дома
+func (item *PgHouseDto) UpdateFromExistItem(entity entity.HouseObject) {
+	// Объекты ГАР не содержат иерархию и параметры, они сохраняются из БД
+	if item.AoGuid == "" {
+		item.AoGuid = entity.AoGuid
+	}
+	if item.PostalCode == "" {
+		item.PostalCode = entity.PostalCode
+	}
+	if item.Okato == "" {
+		item.Okato = entity.Okato
+	}
+	if item.Oktmo == "" {
+		item.Oktmo = entity.Oktmo
+	}
+	if item.CadNum == "" {
+		item.CadNum = entity.CadNum
+	}
+	if entity.FullAddress != "" {
+		item.FullAddress = entity.FullAddress
+	}
+	if entity.AddressSuggest != "" {
+		item.AddressSuggest = entity.AddressSuggest
+	}
+	if entity.Location != "" {
+		item.Location = entity.Location
+	}
+}
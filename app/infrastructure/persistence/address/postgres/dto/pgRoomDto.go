@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"time"
+)
+
+// Объект помещения в PostgreSQL
+type PgRoomDto struct {
+	ID              string `gorm:"column:room_id"`
+	RoomGuid        string `gorm:"column:room_guid"`
+	HouseGuid       string `gorm:"column:house_guid"`
+	RegionCode      string `gorm:"column:region_code"`
+	FlatNumber      string `gorm:"column:flat_number"`
+	FlatType        string `gorm:"column:flat_type"`
+	RoomNumber      string `gorm:"column:room_number"`
+	RoomType        string `gorm:"column:room_type"`
+	RoomFullNum     string `gorm:"column:room_full_num"`
+	FullAddress     string `gorm:"column:full_address"`
+	PostalCode      string `gorm:"column:postal_code"`
+	CadNum          string `gorm:"column:cad_num"`
+	RoomCadNum      string `gorm:"column:room_cad_num"`
+	StartDate       string `gorm:"column:start_date"`
+	EndDate         string `gorm:"column:end_date"`
+	UpdateDate      string `gorm:"column:update_date"`
+	BazisUpdateDate string `gorm:"column:bazis_update_date"`
+}
+
+// Конвертирует объект помещения PostgreSQL в объект помещения
+func (item *PgRoomDto) ToEntity() *entity.RoomObject {
+	room := entity.RoomObject{}
+	model.Copy(&room, item)
+
+	return &room
+}
+
+// Конвертирует объект помещения в объект помещения PostgreSQL
+func (item *PgRoomDto) GetFromEntity(entity entity.RoomObject) {
+	model.Copy(item, entity)
+
+	if item.RoomFullNum == "" {
+		item.RoomFullNum = entity.GetFullNum()
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.RoomFullNum
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *PgRoomDto) IsActive() bool {
+	end, err := time.Parse("2006-01-02", item.EndDate)
+	if err != nil || end.Unix() <= time.Now().Unix() {
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *PgRoomDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Получить идентификатор объекта
+func (item *PgRoomDto) GetId() string {
+	return item.ID
+}
+
+// Получить GUID объекта
+func (item *PgRoomDto) GetGuid() string {
+	return item.RoomGuid
+}
+
+// Получить GUID родительского объекта
+func (item *PgRoomDto) GetParentGuid() string {
+	return item.HouseGuid
+}
+
+// Получить полный номер объекта
+func (item *PgRoomDto) GetFullNum() string {
+	return item.RoomFullNum
+}
+
+// Получить полный адрес объекта
+func (item *PgRoomDto) GetFullAddress() string {
+	return item.FullAddress
+}
+
+// Формирует полный адрес помещения по адресу дома
+func (item *PgRoomDto) UpdateFullAddress(parentAddress string) {
+	item.FullAddress = parentAddress + ", " + item.RoomFullNum
+}
+
+// Заполняет объект помещения PostgreSQL из данных помещения
+func (item *PgRoomDto) UpdateFromExistItem(exist ChildObjectDto) {
+	if exist.GetFullAddress() != "" {
+		item.FullAddress = exist.GetFullAddress()
+	}
+}
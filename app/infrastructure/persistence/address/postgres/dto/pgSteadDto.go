@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/util"
+	"gopkg.in/jeevatkm/go-model.v1"
+	"time"
+)
+
+// Объект земельного участка в PostgreSQL
+type PgSteadDto struct {
+	ID              string `gorm:"column:stead_id"`
+	SteadGuid       string `gorm:"column:stead_guid"`
+	ParentGuid      string `gorm:"column:parent_guid"`
+	RegionCode      string `gorm:"column:region_code"`
+	Number          string `gorm:"column:number"`
+	SteadFullNum    string `gorm:"column:stead_full_num"`
+	FullAddress     string `gorm:"column:full_address"`
+	PostalCode      string `gorm:"column:postal_code"`
+	Okato           string `gorm:"column:okato"`
+	Oktmo           string `gorm:"column:oktmo"`
+	CadNum          string `gorm:"column:cad_num"`
+	DivType         string `gorm:"column:div_type"`
+	StartDate       string `gorm:"column:start_date"`
+	EndDate         string `gorm:"column:end_date"`
+	UpdateDate      string `gorm:"column:update_date"`
+	BazisUpdateDate string `gorm:"column:bazis_update_date"`
+}
+
+// Конвертирует объект участка PostgreSQL в объект участка
+func (item *PgSteadDto) ToEntity() *entity.SteadObject {
+	stead := entity.SteadObject{}
+	model.Copy(&stead, item)
+
+	return &stead
+}
+
+// Конвертирует объект участка в объект участка PostgreSQL
+func (item *PgSteadDto) GetFromEntity(entity entity.SteadObject) {
+	model.Copy(item, entity)
+
+	if item.SteadFullNum == "" {
+		item.SteadFullNum = entity.GetFullNum()
+	}
+	if item.FullAddress == "" {
+		item.FullAddress = item.SteadFullNum
+	}
+
+	item.UpdateBazisDate()
+}
+
+// Проверяет активность объекта
+func (item *PgSteadDto) IsActive() bool {
+	end, err := time.Parse("2006-01-02", item.EndDate)
+	if err != nil || end.Unix() <= time.Now().Unix() {
+		return false
+	}
+
+	return true
+}
+
+// Устанавливает время обновления объекта
+func (item *PgSteadDto) UpdateBazisDate() {
+	item.BazisUpdateDate = time.Now().Format(util.TimeFormat)
+}
+
+// Получить идентификатор объекта
+func (item *PgSteadDto) GetId() string {
+	return item.ID
+}
+
+// Получить GUID объекта
+func (item *PgSteadDto) GetGuid() string {
+	return item.SteadGuid
+}
+
+// Получить GUID родительского объекта
+func (item *PgSteadDto) GetParentGuid() string {
+	return item.ParentGuid
+}
+
+// Получить полный номер объекта
+func (item *PgSteadDto) GetFullNum() string {
+	return item.SteadFullNum
+}
+
+// Получить полный адрес объекта
+func (item *PgSteadDto) GetFullAddress() string {
+	return item.FullAddress
+}
+
+// Формирует полный адрес участка по родительскому адресу
+func (item *PgSteadDto) UpdateFullAddress(parentAddress string) {
+	item.FullAddress = parentAddress + ", " + item.SteadFullNum
+}
+
+// Заполняет объект участка PostgreSQL из данных участка
+func (item *PgSteadDto) UpdateFromExistItem(exist ChildObjectDto) {
+	if exist.GetFullAddress() != "" {
+		item.FullAddress = exist.GetFullAddress()
+	}
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"sync"
+	"time"
+)
+
+const (
+	// Структура таблицы истории адресов в PostgreSQL
+	addressHistoryTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  ao_id text PRIMARY KEY,
+	  ao_guid text NOT NULL DEFAULT '',
+	  parent_guid text NOT NULL DEFAULT '',
+	  formal_name text NOT NULL DEFAULT '',
+	  short_name text NOT NULL DEFAULT '',
+	  off_name text NOT NULL DEFAULT '',
+	  ao_level integer NOT NULL DEFAULT 0,
+	  code text NOT NULL DEFAULT '',
+	  region_code text NOT NULL DEFAULT '',
+	  postal_code text NOT NULL DEFAULT '',
+	  okato text NOT NULL DEFAULT '',
+	  oktmo text NOT NULL DEFAULT '',
+	  act_status text NOT NULL DEFAULT '',
+	  live_status text NOT NULL DEFAULT '',
+	  curr_status text NOT NULL DEFAULT '',
+	  start_date text NOT NULL DEFAULT '',
+	  end_date text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  prev_id text NOT NULL DEFAULT '',
+	  next_id text NOT NULL DEFAULT ''
+	);
+	CREATE INDEX IF NOT EXISTS %[1]s_ao_guid_idx ON %[1]s (ao_guid);
+	`
+)
+
+// Репозиторий истории адресов в PostgreSQL
+type PgAddressHistoryRepository struct {
+	logger    interfaces.LoggerInterface // Логгер
+	batchSize int                        // Размер пачки для обновления
+	pgClient  *pgHelper.Client           // Клиент PostgreSQL
+	tableName string                     // Название таблицы
+}
+
+// Инициализация репозитория
+func NewPgAddressHistoryRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, tableName string) repository.AddressHistoryRepositoryInterface {
+	return &PgAddressHistoryRepository{
+		pgClient:  pgClient,
+		logger:    logger,
+		batchSize: batchSize,
+		tableName: tableName,
+	}
+}
+
+// Инициализация таблицы
+func (a *PgAddressHistoryRepository) Init() error {
+	return a.pgClient.CreateTable(fmt.Sprintf(addressHistoryTableSettings, a.tableName))
+}
+
+// Получить название таблицы
+func (a *PgAddressHistoryRepository) GetIndexName() string {
+	return a.tableName
+}
+
+// Удалить таблицу
+func (a *PgAddressHistoryRepository) Clear() error {
+	return a.pgClient.DropTable(a.tableName)
+}
+
+// Получить все версии адреса по GUID
+func (a *PgAddressHistoryRepository) GetByGuid(guid string) ([]*entity.AddressObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+	var list []dto.PgAddressHistoryDto
+	if err := a.pgClient.DB.Table(a.tableName).Where("ao_guid = ?", guid).Order("start_date").Find(&list).Error; err != nil {
+		return nil, err
+	}
+
+	var items []*entity.AddressObject
+	// Конвертирует DTO в объекты адресов
+	for _, item := range list {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Получить версию адреса по идентификатору записи
+func (a *PgAddressHistoryRepository) GetById(id string) (*entity.AddressObject, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var list []dto.PgAddressHistoryDto
+	if err := a.pgClient.DB.Table(a.tableName).Where("ao_id = ?", id).Limit(1).Find(&list).Error; err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return list[0].ToEntity(), nil
+}
+
+// Сохранить коллекцию версий адресов
+func (a *PgAddressHistoryRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	updated := make(map[string]dto.PgAddressHistoryDto)
+
+	// Цикл получения объекта адреса из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			a.update(updated)
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.PgAddressHistoryDto{}
+		saveItem.GetFromEntity(d.(entity.AddressObject))
+		updated[saveItem.ID] = saveItem
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated) >= a.batchSize {
+			a.update(updated)
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	a.update(updated)
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address history import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *PgAddressHistoryRepository) update(updated map[string]dto.PgAddressHistoryDto) {
+	if len(updated) == 0 {
+		return
+	}
+	var items []interface{}
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.pgClient.Upsert(a.tableName, "ao_id", items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add address history batch commit failed")
+	}
+}
@@ -0,0 +1,975 @@
+package repository
+
+import (
+	"fmt"
+	cache "github.com/AeroAgency/golang-bigcache-lib"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"github.com/jinzhu/gorm"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Структура таблицы в PostgreSQL
+	addrTableSettings = `
+	CREATE EXTENSION IF NOT EXISTS pg_trgm;
+	CREATE EXTENSION IF NOT EXISTS postgis;
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  ao_id text PRIMARY KEY,
+	  ao_guid text NOT NULL DEFAULT '',
+	  parent_guid text NOT NULL DEFAULT '',
+	  mun_parent_guid text NOT NULL DEFAULT '',
+	  formal_name text NOT NULL DEFAULT '',
+	  short_name text NOT NULL DEFAULT '',
+	  ao_level integer NOT NULL DEFAULT 0,
+	  off_name text NOT NULL DEFAULT '',
+	  full_name text NOT NULL DEFAULT '',
+	  code text NOT NULL DEFAULT '',
+	  region_code text NOT NULL DEFAULT '',
+	  postal_code text NOT NULL DEFAULT '',
+	  okato text NOT NULL DEFAULT '',
+	  oktmo text NOT NULL DEFAULT '',
+	  act_status text NOT NULL DEFAULT '',
+	  live_status text NOT NULL DEFAULT '',
+	  curr_status text NOT NULL DEFAULT '',
+	  start_date text NOT NULL DEFAULT '',
+	  end_date text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  cent_status integer NOT NULL DEFAULT 0,
+	  population integer NOT NULL DEFAULT 0,
+	  district_guid text NOT NULL DEFAULT '',
+	  district_kladr text NOT NULL DEFAULT '',
+	  district text NOT NULL DEFAULT '',
+	  district_type text NOT NULL DEFAULT '',
+	  district_full text NOT NULL DEFAULT '',
+	  area_guid text NOT NULL DEFAULT '',
+	  area_kladr text NOT NULL DEFAULT '',
+	  area text NOT NULL DEFAULT '',
+	  area_type text NOT NULL DEFAULT '',
+	  area_full text NOT NULL DEFAULT '',
+	  city_guid text NOT NULL DEFAULT '',
+	  city_kladr text NOT NULL DEFAULT '',
+	  city text NOT NULL DEFAULT '',
+	  city_type text NOT NULL DEFAULT '',
+	  city_full text NOT NULL DEFAULT '',
+	  settlement_guid text NOT NULL DEFAULT '',
+	  settlement_kladr text NOT NULL DEFAULT '',
+	  settlement text NOT NULL DEFAULT '',
+	  settlement_type text NOT NULL DEFAULT '',
+	  settlement_full text NOT NULL DEFAULT '',
+	  street_guid text NOT NULL DEFAULT '',
+	  street_kladr text NOT NULL DEFAULT '',
+	  street text NOT NULL DEFAULT '',
+	  street_type text NOT NULL DEFAULT '',
+	  street_full text NOT NULL DEFAULT '',
+	  address_suggest text NOT NULL DEFAULT '',
+	  full_address text NOT NULL DEFAULT '',
+	  mun_address_suggest text NOT NULL DEFAULT '',
+	  mun_full_address text NOT NULL DEFAULT '',
+	  location text NOT NULL DEFAULT '',
+	  geo geography(Point, 4326) GENERATED ALWAYS AS (
+	    ST_SetSRID(ST_MakePoint(
+	      NULLIF(split_part(location, ',', 2), '')::float8,
+	      NULLIF(split_part(location, ',', 1), '')::float8
+	    ), 4326)::geography
+	  ) STORED,
+	  bazis_update_date text NOT NULL DEFAULT ''
+	);
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS cent_status integer NOT NULL DEFAULT 0;
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS population integer NOT NULL DEFAULT 0;
+	CREATE INDEX IF NOT EXISTS %[1]s_ao_guid_idx ON %[1]s (ao_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_parent_guid_idx ON %[1]s (parent_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_mun_parent_guid_idx ON %[1]s (mun_parent_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_ao_level_idx ON %[1]s (ao_level);
+	CREATE INDEX IF NOT EXISTS %[1]s_code_idx ON %[1]s (code);
+	CREATE INDEX IF NOT EXISTS %[1]s_postal_code_idx ON %[1]s (postal_code);
+	CREATE INDEX IF NOT EXISTS %[1]s_bazis_update_date_idx ON %[1]s (bazis_update_date);
+	CREATE INDEX IF NOT EXISTS %[1]s_formal_name_trgm_idx ON %[1]s USING gin (formal_name gin_trgm_ops);
+	CREATE INDEX IF NOT EXISTS %[1]s_full_name_trgm_idx ON %[1]s USING gin (full_name gin_trgm_ops);
+	CREATE INDEX IF NOT EXISTS %[1]s_address_suggest_trgm_idx ON %[1]s USING gin (address_suggest gin_trgm_ops);
+	CREATE INDEX IF NOT EXISTS %[1]s_mun_address_suggest_trgm_idx ON %[1]s USING gin (mun_address_suggest gin_trgm_ops);
+	CREATE INDEX IF NOT EXISTS %[1]s_geo_idx ON %[1]s USING gist (geo);
+	`
+	// Максимальная глубина иерархии при формировании адреса
+	maxHierarchyDepth = 15
+	// Выражение точки по координатам
+	pointExpr = "ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography"
+)
+
+// Репозиторий адресов в PostgreSQL
+type PgAddressRepository struct {
+	logger      interfaces.LoggerInterface // Логгер
+	batchSize   int                        // Размер пачки для обновления
+	pgClient    *pgHelper.Client           // Клиент PostgreSQL
+	tableName   string                     // Название таблицы
+	jobs        chan dto.PgAddressDto      // Список задач для индексации
+	results     chan dto.PgAddressDto      // Список объектов индексации
+	noOfWorkers int                        // Количество обработчиков индексации
+	indexCache  cache.CacheInterface       // Кэш объектов индексации
+}
+
+// Инициализация репозитория
+func NewPgAddressRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string, noOfWorkers int, cache cache.CacheInterface) repository.AddressRepositoryInterface {
+	if noOfWorkers == 0 {
+		noOfWorkers = 5
+	}
+
+	return &PgAddressRepository{
+		logger:      logger,
+		pgClient:    pgClient,
+		batchSize:   batchSize,
+		tableName:   prefix + entity.AddressObject{}.TableName(),
+		noOfWorkers: noOfWorkers,
+		indexCache:  cache,
+	}
+}
+
+// Инициализация таблицы
+func (a *PgAddressRepository) Init() error {
+	return a.pgClient.CreateTable(fmt.Sprintf(addrTableSettings, a.tableName))
+}
+
+// Получить название таблицы
+func (a *PgAddressRepository) GetIndexName() string {
+	return a.tableName
+}
+
+// Удалить таблицу
+func (a *PgAddressRepository) Clear() error {
+	return a.pgClient.DropTable(a.tableName)
+}
+
+// Получить запрос к таблице
+func (a *PgAddressRepository) table() *gorm.DB {
+	return a.pgClient.DB.Table(a.tableName)
+}
+
+// Добавить в запрос поиск по словам подстроки
+func (a *PgAddressRepository) whereTerm(db *gorm.DB, field string, term string) *gorm.DB {
+	patterns := pgHelper.PrepareTermPatterns(term)
+	if len(patterns) == 0 {
+		return nil
+	}
+	for _, pattern := range patterns {
+		db = db.Where(field+" ILIKE ?", pattern)
+	}
+
+	return db
+}
+
+// Получить список адресов по запросу
+func (a *PgAddressRepository) find(db *gorm.DB) ([]*entity.AddressObject, error) {
+	if db == nil {
+		return nil, nil
+	}
+	var list []dto.PgAddressDto
+	if err := db.Find(&list).Error; err != nil {
+		return nil, err
+	}
+
+	var items []*entity.AddressObject
+	// Конвертирует DTO в объекты адресов
+	for _, item := range list {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Получить первый адрес по запросу
+func (a *PgAddressRepository) findOne(db *gorm.DB) (*entity.AddressObject, error) {
+	if db == nil {
+		return nil, nil
+	}
+	items, err := a.find(db.Limit(1))
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Найти адрес по названию
+func (a *PgAddressRepository) GetByFormalName(term string) (*entity.AddressObject, error) {
+	return a.findOne(a.whereTerm(a.table(), "formal_name", term))
+}
+
+// Найти адреса по GUID
+func (a *PgAddressRepository) GetAddressByGuidList(guids []string) ([]*entity.AddressObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where("ao_guid IN (?)", guids))
+}
+
+// Найти адреса по идентификаторам объектов
+func (a *PgAddressRepository) GetAddressByIdList(ids []string) ([]*entity.AddressObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where("ao_id IN (?)", ids))
+}
+
+// Найти адрес по GUID
+func (a *PgAddressRepository) GetByGuid(guid string) (*entity.AddressObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+
+	return a.findOne(a.table().Where("ao_guid = ?", guid))
+}
+
+// Найти город по названию
+func (a *PgAddressRepository) GetCityByFormalName(term string) (*entity.AddressObject, error) {
+	db := a.table().
+		Where("short_name = ?", "г").
+		Where("ao_level IN (?)", []int{1, 4}).
+		Order("ao_level")
+
+	return a.findOne(a.whereTerm(db, "full_name", term))
+}
+
+// Подсчитать количество адресов по фильтру
+func (a *PgAddressRepository) CountAllData(query interface{}) (int64, error) {
+	condition, _ := query.(*pgHelper.Condition)
+
+	return a.pgClient.CountAllData(a.tableName, condition)
+}
+
+// Получить список всех городов
+func (a *PgAddressRepository) GetCities() ([]*entity.AddressObject, error) {
+	return a.find(a.table().
+		Where("short_name = ?", "г").
+		Where("ao_level IN (?)", []int{1, 4}).
+		Order("ao_level"))
+}
+
+// Найти города по подстроке
+func (a *PgAddressRepository) GetCitiesByTerm(term string, size int64, from int64) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	db := a.whereTerm(a.table(), "address_suggest", term)
+	if db == nil {
+		return nil, nil
+	}
+
+	return a.find(db.
+		Where("ao_level IN (?)", []int{1, 4}).
+		Order("ao_level").
+		Order("full_address").
+		Offset(from).
+		Limit(size))
+}
+
+// Найти адрес по подстроке
+func (a *PgAddressRepository) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	limit := from + size
+	var items []*entity.AddressObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
+
+// Найти адрес по варианту подстроки
+func (a *PgAddressRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	var db *gorm.DB
+	if variant.Fuzzy {
+		db = pgHelper.WhereFuzzyTerm(a.table(), suggestField, variant.Term)
+	} else {
+		db = a.whereTerm(a.table(), suggestField, variant.Term)
+	}
+	if db == nil {
+		return nil, nil
+	}
+
+	return a.find(a.prepareFilter(db, filter...).
+		Order("ao_level").
+		Order(gorm.Expr("similarity("+suggestField+", ?) DESC", variant.Term)).
+		Order(fullAddressField).
+		Limit(size))
+}
+
+// Найти страницу адресов по последнему варианту подстроки после курсора, исключая адреса предыдущих вариантов
+func (a *PgAddressRepository) GetAddressByVariantAfter(variants []util.TermVariant, size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	suggestField := "address_suggest"
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		suggestField = "mun_address_suggest"
+		fullAddressField = "mun_full_address"
+	}
+	last := variants[len(variants)-1]
+	condition, args := pgHelper.PrepareTermCondition(suggestField, last.Term, last.Fuzzy)
+	if condition == "" {
+		return nil, nil
+	}
+	db := a.table().Where(condition, args...)
+	for _, variant := range variants[:len(variants)-1] {
+		if condition, args := pgHelper.PrepareTermCondition(suggestField, variant.Term, variant.Fuzzy); condition != "" {
+			db = db.Where("NOT "+condition, args...)
+		}
+	}
+
+	return a.searchAfter(a.prepareFilter(db, filter...), size, after, fullAddressField)
+}
+
+// Получить страницу городов после курсора
+func (a *PgAddressRepository) GetCitiesAfter(size int64, after *entity.Cursor) ([]*entity.AddressObject, error) {
+	db := a.table().
+		Where("short_name = ?", "г").
+		Where("ao_level IN (?)", []int{1, 4})
+
+	return a.searchAfter(db, size, after, "full_address")
+}
+
+// Получить страницу адресов по фильтру после курсора
+func (a *PgAddressRepository) GetListAfter(size int64, after *entity.Cursor, filter ...entity.FilterObject) ([]*entity.AddressObject, error) {
+	fullAddressField := "full_address"
+	if a.isMunHierarchy(filter...) {
+		fullAddressField = "mun_full_address"
+	}
+
+	return a.searchAfter(a.prepareFilter(a.table(), filter...), size, after, fullAddressField)
+}
+
+// Выполнить постраничную выборку адресов после курсора
+func (a *PgAddressRepository) searchAfter(db *gorm.DB, size int64, after *entity.Cursor, fullAddressField string) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	if after.HasPosition() {
+		db = db.Where("(ao_level, "+fullAddressField+", ao_id) > (?, ?, ?)", after.Level, after.FullAddress, after.ID)
+	}
+
+	return a.find(db.
+		Order("ao_level").
+		Order(fullAddressField).
+		Order("ao_id").
+		Limit(size))
+}
+
+// Выгрузить адреса по фильтру пачками
+func (a *PgAddressRepository) Export(filter entity.ExportFilter, handler func(items []*entity.AddressObject) error) error {
+	db := a.table()
+	if len(filter.Regions) > 0 {
+		db = db.Where("region_code IN (?)", filter.Regions)
+	}
+	if len(filter.Levels) > 0 {
+		db = db.Where("ao_level IN (?)", filter.Levels)
+	}
+	if !filter.UpdatedSince.IsZero() {
+		db = db.Where("bazis_update_date >= ?", filter.UpdatedSince.Format(util.TimeFormat))
+	}
+	last := ""
+	// Получает данные из БД пачками по первичному ключу
+	for {
+		items, err := a.find(db.Where("ao_id > ?", last).Order("ao_id").Limit(a.batchSize))
+		if err != nil || len(items) == 0 {
+			return err
+		}
+		if err := handler(items); err != nil {
+			return err
+		}
+		last = items[len(items)-1].ID
+	}
+}
+
+// Подготовить фильтр для запроса
+func (a *PgAddressRepository) prepareFilter(db *gorm.DB, filters ...entity.FilterObject) *gorm.DB {
+	for _, filter := range filters {
+		if len(filter.Level.Values) > 0 {
+			db = db.Where("ao_level IN (?)", filter.Level.Values)
+		}
+		if filter.Level.Min > 0 {
+			db = db.Where("ao_level >= ?", filter.Level.Min)
+		}
+		if filter.Level.Max > 0 {
+			db = db.Where("ao_level <= ?", filter.Level.Max)
+		}
+		if len(filter.ParentGuid.Values) > 0 {
+			parentField := "parent_guid"
+			if filter.Hierarchy == entity.MunHierarchy {
+				parentField = "mun_parent_guid"
+			}
+			db = db.Where(parentField+" IN (?)", filter.ParentGuid.Values)
+		}
+		if len(filter.KladrId.Values) > 0 {
+			db = db.Where("code IN (?)", filter.KladrId.Values)
+		}
+	}
+
+	return db
+}
+
+// Проверить, запрошена ли муниципальная иерархия
+func (a *PgAddressRepository) isMunHierarchy(filters ...entity.FilterObject) bool {
+	for _, filter := range filters {
+		if filter.Hierarchy == entity.MunHierarchy {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Найти адрес по почтовому индексу
+func (a *PgAddressRepository) GetAddressByPostal(term string, size int64, from int64) ([]*entity.AddressObject, error) {
+	if size == 0 {
+		size = 100
+	}
+
+	return a.find(a.table().
+		Where("postal_code = ?", term).
+		Order("ao_level").
+		Order("full_address").
+		Offset(from).
+		Limit(size))
+}
+
+// Найти ближайший город по координатам
+func (a *PgAddressRepository) GetNearestCity(lon float64, lat float64) (*entity.AddressObject, error) {
+	return a.findOne(a.table().
+		Where("ST_DWithin(geo, "+pointExpr+", ?)", lon, lat, 20000).
+		Where("ao_level <= ?", 6).
+		Order(gorm.Expr("geo <-> "+pointExpr, lon, lat)))
+}
+
+// Найти ближайший адрес по координатам
+func (a *PgAddressRepository) GetNearestAddress(lon float64, lat float64, term string) (*entity.AddressObject, error) {
+	db := a.whereTerm(a.table(), "address_suggest", term)
+	if db == nil {
+		return nil, nil
+	}
+
+	return a.findOne(db.
+		Where("ST_DWithin(geo, "+pointExpr+", ?)", lon, lat, 5000).
+		Order(gorm.Expr("geo <-> "+pointExpr, lon, lat)))
+}
+
+// Обновить коллекцию адресов
+func (a *PgAddressRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	begin := time.Now()
+	var total uint64
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.PgAddressDto)
+
+	// Цикл получения объекта адреса из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.PgAddressDto{}
+		saveItem.GetFromEntity(d.(entity.AddressObject))
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.AoGuid] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.ID)
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add addresses to table")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add addresses to table")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *PgAddressRepository) update(updated map[string]dto.PgAddressDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.GetAddressByGuidList(updatedKeys)
+		for _, item := range items {
+			updateItem, ok := updated[item.AoGuid]
+			if ok {
+				updateItem.UpdateFromExistItem(*item)
+				updated[item.AoGuid] = updateItem
+			}
+		}
+	}
+	var items []interface{}
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.pgClient.Upsert(a.tableName, "ao_id", items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add addresses batch commit failed")
+	}
+	if err := a.pgClient.Delete(a.tableName, "ao_id", deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Delete addresses batch commit failed")
+	}
+}
+
+// Обновить иерархию адресов
+func (a *PgAddressRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	admItems := make(map[string]string)
+	munItems := make(map[string]string)
+	dates := make(map[string]string)
+	updateDate := time.Now().Format(util.TimeFormat)
+	for _, item := range items {
+		if item.Type == entity.MunHierarchy {
+			munItems[item.ObjectId] = item.ParentGuid
+		} else {
+			admItems[item.ObjectId] = item.ParentGuid
+		}
+		dates[item.ObjectId] = updateDate
+	}
+	if err := a.pgClient.UpdateColumn(a.tableName, "ao_id", "parent_guid", admItems); err != nil {
+		return err
+	}
+	if err := a.pgClient.UpdateColumn(a.tableName, "ao_id", "mun_parent_guid", munItems); err != nil {
+		return err
+	}
+
+	// Обновляет дату изменения для перестроения полного адреса при индексации
+	return a.pgClient.UpdateColumn(a.tableName, "ao_id", "bazis_update_date", dates)
+}
+
+// Обновить параметры адресов
+func (a *PgAddressRepository) UpdateParams(items []entity.ParamObject) error {
+	fields := make(map[string]map[string]string)
+	for _, item := range items {
+		var field string
+		switch item.TypeId {
+		case entity.ParamPostalCode:
+			field = "postal_code"
+		case entity.ParamOkato:
+			field = "okato"
+		case entity.ParamOktmo:
+			field = "oktmo"
+		case entity.ParamKladr:
+			field = "code"
+		default:
+			continue
+		}
+		if fields[field] == nil {
+			fields[field] = make(map[string]string)
+		}
+		fields[field][item.ObjectId] = item.Value
+	}
+	for field, values := range fields {
+		if err := a.pgClient.UpdateColumn(a.tableName, "ao_id", field, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Индексация адресов
+func (a *PgAddressRepository) Index(isFull bool, start time.Time, guids []string, regions []string, indexChan chan<- entity.IndexObject) error {
+	done := make(chan bool)
+	// Создает канал для работы с объектами
+	a.jobs = make(chan dto.PgAddressDto, a.noOfWorkers)
+	// Создает канал для сохранения объектов в БД
+	a.results = make(chan dto.PgAddressDto, a.noOfWorkers)
+	// Подготавливает фильтр для получения элементов
+	condition := a.prepareIndexQuery(isFull, start, guids, regions)
+	// Получает общее количество элементов по фильтру
+	queryCount := a.calculateIndexCount(condition)
+	// Получает элементы из БД для переиндексации
+	go a.getIndexItems(condition)
+	// Обновляет элементы в БД
+	go a.saveIndexItems(done, time.Now(), queryCount, indexChan)
+	// Создает пул задач на обработку элементов
+	a.createWorkerPool(a.noOfWorkers)
+	<-done
+
+	return nil
+}
+
+// Подготовить фильтр для получения элементов
+func (a *PgAddressRepository) prepareIndexQuery(isFull bool, start time.Time, guids []string, regions []string) *pgHelper.Condition {
+	var conditions []string
+	var args []interface{}
+	if isFull {
+		// Индексирует все элементы в таблице
+		a.logger.Info("Full indexing...")
+	} else {
+		a.logger.Info("Indexing...")
+		conditions = append(conditions, "ao_level > 1")
+		if len(guids) > 0 {
+			// Добавляет фильтр на ограничение выборки по списку GUID
+			conditions = append(conditions, "ao_guid IN (?)")
+			args = append(args, guids)
+		} else {
+			// Добавляет фильтр на ограничение выборки по дате начала импорта
+			conditions = append(conditions, "bazis_update_date >= ?")
+			args = append(args, start.Format(util.TimeFormat))
+		}
+	}
+	// Добавляет фильтр на ограничение выборки по регионам
+	if len(regions) > 0 {
+		conditions = append(conditions, "region_code IN (?)")
+		args = append(args, regions)
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	return &pgHelper.Condition{
+		Query: strings.Join(conditions, " AND "),
+		Args:  args,
+	}
+}
+
+// Получить общее количество элементов по фильтру
+func (a *PgAddressRepository) calculateIndexCount(condition *pgHelper.Condition) int64 {
+	// Получает общее количество элементов
+	addTotalCount, err := a.CountAllData(nil)
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+	// Получает количество элементов по фильтру
+	queryCount, err := a.CountAllData(condition)
+	if err != nil {
+		a.logger.Error(err.Error())
+	}
+
+	a.logger.WithFields(interfaces.LoggerFields{"count": addTotalCount}).Info("Total address count")
+	a.logger.WithFields(interfaces.LoggerFields{"count": queryCount}).Info("Number of indexed addresses")
+
+	return queryCount
+}
+
+// Получить элементы из БД для переиндексации
+func (a *PgAddressRepository) getIndexItems(condition *pgHelper.Condition) {
+	defer close(a.jobs)
+	db := a.table()
+	if condition != nil {
+		db = db.Where(condition.Query, condition.Args...)
+	}
+	rows, err := db.Order("ao_level").Rows()
+	if err != nil {
+		a.logger.Error(err.Error())
+		return
+	}
+	defer rows.Close()
+	count := 0
+
+	// Получает данные из БД построчно
+	for rows.Next() {
+		var item dto.PgAddressDto
+		if err := a.pgClient.DB.ScanRows(rows, &item); err != nil {
+			a.logger.Fatal(err.Error())
+		}
+		count++
+		// Добавляет элемент в пул задач
+		a.jobs <- item
+	}
+	if err := rows.Err(); err != nil {
+		a.logger.Error(err.Error())
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": count}).Info("Address update count")
+}
+
+// Создать пул задач на обработку элементов
+func (a *PgAddressRepository) createWorkerPool(noOfWorkers int) {
+	var wg sync.WaitGroup
+	for i := 0; i < noOfWorkers; i++ {
+		wg.Add(1)
+		// Подготавливает элементы перед сохранением в БД
+		go a.prepareItemsBeforeSave(&wg)
+	}
+	wg.Wait()
+	close(a.results)
+}
+
+// Подготовить элементы перед сохранением в БД
+func (a *PgAddressRepository) prepareItemsBeforeSave(wg *sync.WaitGroup) {
+	for address := range a.jobs {
+		// Устанавливает время обновления объекта
+		address.UpdateBazisDate()
+		dtoItem := dto.PgAddressDto{}
+		guid := address.ParentGuid
+		// Формирует информацию об адресе объекта
+		address.FullName = util.PrepareFullName(address.ShortName, address.FormalName)
+		address.FullAddress = address.FullName
+		address.AddressSuggest = util.PrepareSuggest("", address.ShortName, address.FormalName)
+
+		// Ищет родительские объекты и дополняет адрес текущего объекта
+		if guid != "" {
+			search := a.getParent(guid)
+			if search != nil {
+				// Конвертирует объект адреса в DTO
+				dtoItem.GetFromEntity(*search)
+				// Дополняет адрес текущего объекта
+				if address.AoLevel == 4 {
+					// Пропускаем район в названии
+					address.FullAddress = dtoItem.RegionFull + ", " + address.FullAddress
+				} else {
+					address.FullAddress = dtoItem.FullAddress + ", " + address.FullAddress
+				}
+				address.AddressSuggest = dtoItem.AddressSuggest + ", " + address.AddressSuggest
+				// Формирует информацию о регионе объекта
+				if dtoItem.Region != "" {
+					address.RegionGuid = dtoItem.RegionGuid
+					address.RegionKladr = dtoItem.RegionKladr
+					address.Region = dtoItem.Region
+					address.RegionType = dtoItem.RegionType
+					address.RegionFull = dtoItem.RegionFull
+				}
+				// Формирует информацию о районе объекта
+				if dtoItem.Area != "" {
+					address.AreaGuid = dtoItem.AreaGuid
+					address.AreaKladr = dtoItem.AreaKladr
+					address.Area = dtoItem.Area
+					address.AreaType = dtoItem.AreaType
+					address.AreaFull = dtoItem.AreaFull
+				}
+				// Формирует информацию о городе объекта
+				if dtoItem.City != "" {
+					address.CityGuid = dtoItem.CityGuid
+					address.CityKladr = dtoItem.CityKladr
+					address.City = dtoItem.City
+					address.CityType = dtoItem.CityType
+					address.CityFull = dtoItem.CityFull
+				}
+				// Устанавливает населенный пункт объекта
+				if dtoItem.Settlement != "" {
+					address.SettlementGuid = dtoItem.SettlementGuid
+					address.SettlementKladr = dtoItem.SettlementKladr
+					address.Settlement = dtoItem.Settlement
+					address.SettlementType = dtoItem.SettlementType
+					address.SettlementFull = dtoItem.SettlementFull
+				}
+			}
+		}
+
+		if address.AoLevel <= 2 {
+			address.RegionGuid = address.AoGuid
+			address.RegionKladr = address.Code
+			address.Region = strings.TrimSpace(address.FormalName)
+			address.RegionType = strings.TrimSpace(address.ShortName)
+			address.RegionFull = util.PrepareFullName(address.RegionType, address.Region)
+		} else if address.AoLevel == 3 {
+			address.AreaGuid = address.AoGuid
+			address.AreaKladr = address.Code
+			address.Area = strings.TrimSpace(address.FormalName)
+			address.AreaType = strings.TrimSpace(address.ShortName)
+			if address.RegionFull != "" {
+				address.AreaFull = address.RegionFull + ", "
+			}
+			address.AreaFull += util.PrepareFullName(address.AreaType, address.Area)
+		} else if address.AoLevel == 4 {
+			address.CityGuid = address.AoGuid
+			address.CityKladr = address.Code
+			address.City = strings.TrimSpace(address.FormalName)
+			address.CityType = strings.TrimSpace(address.ShortName)
+			if address.AreaFull != "" {
+				address.CityFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.CityFull = address.RegionFull + ", "
+			}
+			address.CityFull += util.PrepareFullName(address.CityType, address.City)
+		} else if address.AoLevel == 5 || address.AoLevel == 6 {
+			address.SettlementGuid = address.AoGuid
+			address.SettlementKladr = address.Code
+			address.Settlement = strings.TrimSpace(address.FormalName)
+			address.SettlementType = strings.TrimSpace(address.ShortName)
+			address.SettlementFull = ""
+			if address.CityFull != "" {
+				address.SettlementFull = address.CityFull + ", "
+			} else if address.AreaFull != "" {
+				address.SettlementFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.SettlementFull = address.RegionFull + ", "
+			}
+			address.SettlementFull += util.PrepareFullName(address.SettlementType, address.Settlement)
+		} else if address.AoLevel == 7 {
+			address.StreetGuid = address.AoGuid
+			address.StreetKladr = address.Code
+			address.StreetType = strings.TrimSpace(address.ShortName)
+			address.Street = strings.TrimSpace(address.FormalName)
+			address.StreetFull = ""
+			if address.SettlementFull != "" {
+				address.StreetFull = address.SettlementFull + ", "
+			} else if address.CityFull != "" {
+				address.StreetFull = address.CityFull + ", "
+			} else if address.AreaFull != "" {
+				address.StreetFull = address.AreaFull + ", "
+			} else if address.RegionFull != "" {
+				address.StreetFull = address.RegionFull + ", "
+			}
+			address.StreetFull += util.PrepareFullName(address.StreetType, address.Street)
+		}
+
+		// Формирует адрес объекта в муниципальном делении
+		a.prepareMunAddress(&address)
+
+		a.results <- address
+	}
+
+	wg.Done()
+}
+
+// Получить родительский объект из кэша или БД
+func (a *PgAddressRepository) getParent(guid string) *entity.AddressObject {
+	searchObject := entity.AddressObject{}
+	// Ищет родительский объект в кэше
+	searchResult := a.indexCache.Get(guid, &searchObject)
+	if searchResult != nil {
+		return searchResult.(*entity.AddressObject)
+	}
+	search, _ := a.GetByGuid(guid)
+
+	return search
+}
+
+// Сформировать адрес объекта в муниципальном делении
+func (a *PgAddressRepository) prepareMunAddress(address *dto.PgAddressDto) {
+	address.MunFullAddress = address.FullName
+	address.MunAddressSuggest = util.PrepareSuggest("", address.ShortName, address.FormalName)
+	guid := address.MunParentGuid
+	if guid == "" {
+		guid = address.ParentGuid
+	}
+
+	// Поднимается по цепочке родителей, у объектов без муниципальной иерархии используется административная
+	for depth := 0; guid != "" && depth < maxHierarchyDepth; depth++ {
+		parent := a.getParent(guid)
+		if parent == nil {
+			break
+		}
+		fullName := parent.FullName
+		if fullName == "" {
+			fullName = util.PrepareFullName(parent.ShortName, parent.FormalName)
+		}
+		address.MunFullAddress = fullName + ", " + address.MunFullAddress
+		address.MunAddressSuggest = util.PrepareSuggest("", parent.ShortName, parent.FormalName) + ", " + address.MunAddressSuggest
+
+		guid = parent.MunParentGuid
+		if guid == "" {
+			guid = parent.ParentGuid
+		}
+	}
+}
+
+// Обновить элементы в БД
+func (a *PgAddressRepository) saveIndexItems(done chan bool, begin time.Time, total int64, indexChan chan<- entity.IndexObject) {
+	var items []interface{}
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing addresses", false)
+
+	for d := range a.results {
+		// Добавляет объект в индексацию домов, если данный объект является улицей
+		if d.AoLevel == 7 && indexChan != nil {
+			indexChan <- entity.IndexObject{
+				AoGuid:         d.AoGuid,
+				FullAddress:    d.FullAddress,
+				AddressSuggest: d.AddressSuggest,
+			}
+		} else if d.AoLevel <= 6 {
+			a.indexCache.Set(d.AoGuid, d.ToEntity())
+		}
+
+		// Добавляет объект в очередь на сохранение
+		items = append(items, d)
+		bar.Increment()
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(items) >= a.batchSize {
+			a.saveIndexBatch(items)
+			items = nil
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(items) > 0 {
+		a.saveIndexBatch(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("Address index execution time")
+	done <- true
+	if indexChan != nil {
+		close(indexChan)
+	}
+}
+
+// Сохранить пачку проиндексированных элементов и очистить кэш
+func (a *PgAddressRepository) saveIndexBatch(items []interface{}) {
+	if err := a.pgClient.Upsert(a.tableName, "ao_id", items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Address index batch commit failed")
+		os.Exit(1)
+	}
+	a.indexCache.Clear()
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура таблицы границ административных объектов в PostgreSQL
+	boundaryTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  id text PRIMARY KEY,
+	  ao_guid text NOT NULL DEFAULT '',
+	  ao_level integer NOT NULL DEFAULT 0,
+	  admin_level integer NOT NULL DEFAULT 0,
+	  name text NOT NULL DEFAULT '',
+	  shape text NOT NULL DEFAULT ''
+	);
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS geo geography(MultiPolygon, 4326) GENERATED ALWAYS AS (
+	  ST_SetSRID(ST_GeomFromGeoJSON(NULLIF(shape, '')), 4326)::geography
+	) STORED;
+	CREATE INDEX IF NOT EXISTS %[1]s_ao_guid_idx ON %[1]s (ao_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_geo_idx ON %[1]s USING gist (geo);
+	`
+)
+
+// Репозиторий границ административных объектов в PostgreSQL
+type PgBoundaryRepository struct {
+	logger    interfaces.LoggerInterface // Логгер
+	batchSize int                        // Размер пачки для обновления
+	pgClient  *pgHelper.Client           // Клиент PostgreSQL
+	tableName string                     // Название таблицы
+}
+
+// Инициализация репозитория
+func NewPgBoundaryRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.BoundaryRepositoryInterface {
+	return &PgBoundaryRepository{
+		pgClient:  pgClient,
+		logger:    logger,
+		batchSize: batchSize,
+		tableName: prefix + entity.Boundary{}.TableName(),
+	}
+}
+
+// Инициализация таблицы
+func (b *PgBoundaryRepository) Init() error {
+	return b.pgClient.CreateTable(fmt.Sprintf(boundaryTableSettings, b.tableName))
+}
+
+// Получить название таблицы
+func (b *PgBoundaryRepository) GetIndexName() string {
+	return b.tableName
+}
+
+// Удалить таблицу
+func (b *PgBoundaryRepository) Clear() error {
+	return b.pgClient.DropTable(b.tableName)
+}
+
+// Сохранить границы
+func (b *PgBoundaryRepository) Save(items []entity.Boundary) error {
+	var saveItems []interface{}
+	for _, item := range items {
+		saveItem := dto.PgBoundaryDto{}
+		if err := saveItem.GetFromEntity(item); err != nil {
+			return err
+		}
+		saveItems = append(saveItems, saveItem)
+	}
+
+	return b.pgClient.Upsert(b.tableName, "id", saveItems)
+}
+
+// Получить границы, содержащие точку
+func (b *PgBoundaryRepository) GetByPoint(lat float64, lon float64) ([]*entity.Boundary, error) {
+	var list []dto.PgBoundaryDto
+	err := b.pgClient.DB.Table(b.tableName).
+		Select("id, ao_guid, ao_level, admin_level, name").
+		Where("ST_Covers(geo, "+pointExpr+")", lon, lat).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.Boundary
+	// Конвертирует DTO в границы
+	for _, item := range list {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура таблицы журнала изменений в PostgreSQL
+	changeLogTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  id text PRIMARY KEY,
+	  version_id integer NOT NULL DEFAULT 0,
+	  object_type text NOT NULL DEFAULT '',
+	  guid text NOT NULL DEFAULT '',
+	  change_type text NOT NULL DEFAULT '',
+	  region_code text NOT NULL DEFAULT ''
+	);
+	CREATE INDEX IF NOT EXISTS %[1]s_version_id_idx ON %[1]s (version_id);
+	`
+)
+
+// Репозиторий журнала изменений в PostgreSQL
+type PgChangeLogRepository struct {
+	logger    interfaces.LoggerInterface // Логгер
+	batchSize int                        // Размер пачки для обновления
+	pgClient  *pgHelper.Client           // Клиент PostgreSQL
+	tableName string                     // Название таблицы
+}
+
+// Инициализация репозитория
+func NewPgChangeLogRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.ChangeLogRepositoryInterface {
+	return &PgChangeLogRepository{
+		pgClient:  pgClient,
+		logger:    logger,
+		batchSize: batchSize,
+		tableName: prefix + entity.ChangeLogItem{}.TableName(),
+	}
+}
+
+// Инициализация таблицы
+func (c *PgChangeLogRepository) Init() error {
+	return c.pgClient.CreateTable(fmt.Sprintf(changeLogTableSettings, c.tableName))
+}
+
+// Получить название таблицы
+func (c *PgChangeLogRepository) GetIndexName() string {
+	return c.tableName
+}
+
+// Удалить таблицу
+func (c *PgChangeLogRepository) Clear() error {
+	return c.pgClient.DropTable(c.tableName)
+}
+
+// Сохранить записи журнала изменений
+func (c *PgChangeLogRepository) Save(items []entity.ChangeLogItem) error {
+	var saveItems []interface{}
+	for _, item := range items {
+		saveItem := dto.PgChangeLogDto{}
+		saveItem.GetFromEntity(item)
+		saveItems = append(saveItems, saveItem)
+	}
+
+	return c.pgClient.Upsert(c.tableName, "id", saveItems)
+}
+
+// Получить записи журнала изменений версий после указанной, начиная с записи после afterId
+func (c *PgChangeLogRepository) GetChanges(sinceVersion int, afterId string, size int64) ([]*entity.ChangeLogItem, error) {
+	var list []dto.PgChangeLogDto
+	err := c.pgClient.DB.Table(c.tableName).
+		Where("version_id > ? AND id > ?", sinceVersion, afterId).
+		Order("id").
+		Limit(size).
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*entity.ChangeLogItem
+	// Конвертирует DTO в записи журнала изменений
+	for _, item := range list {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
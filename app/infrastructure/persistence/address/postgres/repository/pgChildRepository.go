@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"github.com/jinzhu/gorm"
+	"sync"
+	"time"
+)
+
+// Описание объектов, полный адрес которых формируется по адресу родителя: помещений или участков
+type childObjects struct {
+	name         string                                    // Название объектов в логах во множественном числе
+	title        string                                    // Название объекта в логах
+	settings     string                                    // Структура таблицы
+	idField      string                                    // Поле идентификатора объекта
+	guidField    string                                    // Поле GUID объекта
+	parentField  string                                    // Поле GUID родительского объекта
+	fullNumField string                                    // Поле полного номера объекта
+	newDto       func() dto.ChildObjectDto                 // Создать пустой DTO
+	fromEntity   func(item interface{}) dto.ChildObjectDto // Конвертировать объект из канала импорта в DTO
+}
+
+// Общий репозиторий помещений и участков в PostgreSQL
+type pgChildRepository struct {
+	logger    interfaces.LoggerInterface // Логгер
+	batchSize int                        // Размер пачки для обновления
+	pgClient  *pgHelper.Client           // Клиент PostgreSQL
+	tableName string                     // Название таблицы
+	objects   childObjects               // Описание хранимых объектов
+}
+
+// Инициализация общего репозитория
+func newPgChildRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, tableName string, objects childObjects) *pgChildRepository {
+	return &pgChildRepository{
+		pgClient:  pgClient,
+		logger:    logger,
+		batchSize: batchSize,
+		tableName: tableName,
+		objects:   objects,
+	}
+}
+
+// Инициализация таблицы
+func (a *pgChildRepository) Init() error {
+	return a.pgClient.CreateTable(fmt.Sprintf(a.objects.settings, a.tableName))
+}
+
+// Получить название таблицы
+func (a *pgChildRepository) GetIndexName() string {
+	return a.tableName
+}
+
+// Удалить таблицу
+func (a *pgChildRepository) Clear() error {
+	return a.pgClient.DropTable(a.tableName)
+}
+
+// Получить запрос к таблице
+func (a *pgChildRepository) table() *gorm.DB {
+	return a.pgClient.DB.Table(a.tableName)
+}
+
+// Получить список объектов по запросу
+func (a *pgChildRepository) find(db *gorm.DB) ([]dto.ChildObjectDto, error) {
+	rows, err := db.Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []dto.ChildObjectDto
+	for rows.Next() {
+		item := a.objects.newDto()
+		if err := a.pgClient.DB.ScanRows(rows, item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// Найти объект по GUID
+func (a *pgChildRepository) getByGuid(guid string) (dto.ChildObjectDto, error) {
+	items, err := a.find(a.table().Where(a.objects.guidField+" = ?", guid).Limit(1))
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить объекты по GUID
+func (a *pgChildRepository) getByGuidList(guids []string) ([]dto.ChildObjectDto, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where(a.objects.guidField+" IN (?)", guids))
+}
+
+// Найти объекты по GUID родителя
+func (a *pgChildRepository) getByParentGuid(guid string) ([]dto.ChildObjectDto, error) {
+	if guid == "" {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where(a.objects.parentField+" = ?", guid).Order(a.objects.fullNumField))
+}
+
+// Обновить коллекцию объектов
+func (a *pgChildRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.ChildObjectDto)
+
+	// Цикл получения объекта из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := a.objects.fromEntity(d)
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.GetGuid()] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.GetId())
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to table")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add " + a.objects.name + " to table")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *pgChildRepository) update(updated map[string]dto.ChildObjectDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.getByGuidList(updatedKeys)
+		for _, item := range items {
+			if updateItem, ok := updated[item.GetGuid()]; ok {
+				updateItem.UpdateFromExistItem(item)
+			}
+		}
+	}
+	var items []interface{}
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.pgClient.Upsert(a.tableName, a.objects.idField, items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add " + a.objects.name + " batch commit failed")
+	}
+	if err := a.pgClient.Delete(a.tableName, a.objects.idField, deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Delete " + a.objects.name + " batch commit failed")
+	}
+}
+
+// Подсчитать количество объектов в БД по фильтру
+func (a *pgChildRepository) CountAllData(query interface{}) (int64, error) {
+	condition, _ := query.(*pgHelper.Condition)
+
+	return a.pgClient.CountAllData(a.tableName, condition)
+}
+
+// Индексация объектов
+func (a *pgChildRepository) Index(start time.Time, GetIndexObjects repository.GetIndexObjects) error {
+	begin := time.Now()
+	condition := &pgHelper.Condition{Query: "bazis_update_date >= ?", Args: []interface{}{start.Format(util.TimeFormat)}}
+	total, err := a.CountAllData(condition)
+	if err != nil {
+		return err
+	}
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing "+a.objects.name, false)
+	count := 0
+	lastId := ""
+
+	// Получает объекты пачками по возрастанию идентификатора
+	for {
+		list, err := a.find(a.table().
+			Where(condition.Query, condition.Args...).
+			Where(a.objects.idField+" > ?", lastId).
+			Order(a.objects.idField).
+			Limit(a.batchSize))
+		if err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			break
+		}
+		lastId = list[len(list)-1].GetId()
+		var guids []string
+		for _, item := range list {
+			guids = append(guids, item.GetParentGuid())
+		}
+
+		// Формирует полный адрес объекта по адресу родителя
+		var items []interface{}
+		objectsList := GetIndexObjects(util.UniqueStringSlice(guids))
+		for _, item := range list {
+			bar.Increment()
+			object, ok := objectsList[item.GetParentGuid()]
+			if !ok {
+				continue
+			}
+			item.UpdateFullAddress(object.FullAddress)
+			item.UpdateBazisDate()
+			items = append(items, item)
+		}
+		if err := a.pgClient.Upsert(a.tableName, a.objects.idField, items); err != nil {
+			a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal(a.objects.title + " index batch commit failed")
+		}
+		count += len(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"count": count, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info(a.objects.title + " index execution time")
+
+	return nil
+}
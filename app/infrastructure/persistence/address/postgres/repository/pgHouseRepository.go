@@ -0,0 +1,632 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"github.com/dustin/go-humanize"
+	"github.com/jinzhu/gorm"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// Структура таблицы в PostgreSQL
+	houseTableSettings = `
+	CREATE EXTENSION IF NOT EXISTS pg_trgm;
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  house_id text PRIMARY KEY,
+	  house_guid text NOT NULL DEFAULT '',
+	  ao_guid text NOT NULL DEFAULT '',
+	  house_num text NOT NULL DEFAULT '',
+	  house_full_num text NOT NULL DEFAULT '',
+	  full_address text NOT NULL DEFAULT '',
+	  address_suggest text NOT NULL DEFAULT '',
+	  postal_code text NOT NULL DEFAULT '',
+	  okato text NOT NULL DEFAULT '',
+	  oktmo text NOT NULL DEFAULT '',
+	  start_date text NOT NULL DEFAULT '',
+	  end_date text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  div_type text NOT NULL DEFAULT '',
+	  build_num text NOT NULL DEFAULT '',
+	  str_num text NOT NULL DEFAULT '',
+	  counter text NOT NULL DEFAULT '',
+	  cad_num text NOT NULL DEFAULT '',
+	  location text NOT NULL DEFAULT '',
+	  bazis_update_date text NOT NULL DEFAULT ''
+	);
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS geo geography(Point, 4326) GENERATED ALWAYS AS (
+	  ST_SetSRID(ST_MakePoint(
+	    NULLIF(split_part(location, ',', 2), '')::float8,
+	    NULLIF(split_part(location, ',', 1), '')::float8
+	  ), 4326)::geography
+	) STORED;
+	CREATE INDEX IF NOT EXISTS %[1]s_house_guid_idx ON %[1]s (house_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_ao_guid_idx ON %[1]s (ao_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_bazis_update_date_idx ON %[1]s (bazis_update_date);
+	CREATE INDEX IF NOT EXISTS %[1]s_address_suggest_trgm_idx ON %[1]s USING gin (address_suggest gin_trgm_ops);
+	CREATE INDEX IF NOT EXISTS %[1]s_geo_idx ON %[1]s USING gist (geo);
+	`
+)
+
+// Репозиторий домов в PostgreSQL
+type PgHouseRepository struct {
+	logger      interfaces.LoggerInterface // Логгер
+	batchSize   int                        // Размер пачки для обновления
+	pgClient    *pgHelper.Client           // Клиент PostgreSQL
+	tableName   string                     // Название таблицы
+	results     chan dto.PgHouseDto        // Список объектов индексации
+	noOfWorkers int                        // Количество обработчиков индексации
+}
+
+// Инициализация репозитория
+func NewPgHouseRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string, noOfWorkers int) repository.HouseRepositoryInterface {
+	if noOfWorkers == 0 {
+		noOfWorkers = 10
+	}
+
+	return &PgHouseRepository{
+		pgClient:    pgClient,
+		logger:      logger,
+		batchSize:   batchSize,
+		tableName:   prefix + entity.HouseObject{}.TableName(),
+		noOfWorkers: noOfWorkers,
+	}
+}
+
+// Инициализация таблицы
+func (a *PgHouseRepository) Init() error {
+	return a.pgClient.CreateTable(fmt.Sprintf(houseTableSettings, a.tableName))
+}
+
+// Получить название таблицы
+func (a *PgHouseRepository) GetIndexName() string {
+	return a.tableName
+}
+
+// Удалить таблицу
+func (a *PgHouseRepository) Clear() error {
+	return a.pgClient.DropTable(a.tableName)
+}
+
+// Получить запрос к таблице
+func (a *PgHouseRepository) table() *gorm.DB {
+	return a.pgClient.DB.Table(a.tableName)
+}
+
+// Получить список домов по запросу
+func (a *PgHouseRepository) find(db *gorm.DB) ([]*entity.HouseObject, error) {
+	var list []dto.PgHouseDto
+	if err := db.Find(&list).Error; err != nil {
+		return nil, err
+	}
+
+	var items []*entity.HouseObject
+	// Конвертирует DTO в объекты домов
+	for _, item := range list {
+		items = append(items, item.ToEntity())
+	}
+
+	return items, nil
+}
+
+// Найти дом по GUID
+func (a *PgHouseRepository) GetByGuid(guid string) (*entity.HouseObject, error) {
+	items, err := a.find(a.table().Where("house_guid = ?", guid).Limit(1))
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Найти ближайший дом в радиусе в метрах
+func (a *PgHouseRepository) GetNearest(lon float64, lat float64, radius int) (*entity.HouseObject, error) {
+	items, err := a.find(a.table().
+		Where("ST_DWithin(geo, "+pointExpr+", ?)", lon, lat, radius).
+		Order(gorm.Expr("geo <-> "+pointExpr, lon, lat)).
+		Limit(1))
+	if err != nil || len(items) == 0 {
+		return nil, err
+	}
+
+	return items[0], nil
+}
+
+// Получить дома по GUID
+func (a *PgHouseRepository) GetByGuidList(guids []string) ([]*entity.HouseObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where("house_guid IN (?)", guids))
+}
+
+// Найти дома по идентификаторам объектов
+func (a *PgHouseRepository) GetByIdList(ids []string) ([]*entity.HouseObject, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where("house_id IN (?)", ids))
+}
+
+// Найти дома по GUID адресов
+func (a *PgHouseRepository) GetByAddressGuidList(guids []string) ([]*entity.HouseObject, error) {
+	if len(guids) == 0 {
+		return nil, nil
+	}
+
+	return a.find(a.table().Where("ao_guid IN (?)", guids).Order("house_full_num"))
+}
+
+// Выгрузить дома по фильтру пачками
+func (a *PgHouseRepository) Export(filter entity.ExportFilter, handler func(items []*entity.HouseObject) error) error {
+	db := a.table()
+	if len(filter.AddressGuids) > 0 {
+		db = db.Where("ao_guid IN (?)", filter.AddressGuids)
+	}
+	if !filter.UpdatedSince.IsZero() {
+		db = db.Where("bazis_update_date >= ?", filter.UpdatedSince.Format(util.TimeFormat))
+	}
+	last := ""
+	// Получает данные из БД пачками по первичному ключу
+	for {
+		items, err := a.find(db.Where("house_id > ?", last).Order("house_id").Limit(a.batchSize))
+		if err != nil || len(items) == 0 {
+			return err
+		}
+		if err := handler(items); err != nil {
+			return err
+		}
+		last = items[len(items)-1].ID
+	}
+}
+
+// Найти дома по GUID адреса
+func (a *PgHouseRepository) GetByAddressGuid(guid string) ([]*entity.HouseObject, error) {
+	if guid == "" {
+		return nil, nil
+	}
+
+	return a.GetByAddressGuidList([]string{guid})
+}
+
+// Получить GUID последних обновленных домов
+func (a *PgHouseRepository) GetLastUpdatedGuids(start time.Time) ([]string, error) {
+	var guids []string
+	err := a.table().
+		Where("bazis_update_date >= ?", start.Format(util.TimeFormat)).
+		Pluck("DISTINCT ao_guid", &guids).Error
+
+	return guids, err
+}
+
+// Найти дома по подстроке
+func (a *PgHouseRepository) GetAddressByTerm(term string, size int64, from int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error) {
+	if size == 0 {
+		size = 100
+	}
+	limit := from + size
+	var items []*entity.HouseObject
+	exists := make(map[string]bool)
+	// Варианты запроса перебираются от точного к нечеткому, поэтому точные совпадения выводятся первыми
+	for _, variant := range util.PrepareTermVariants(term) {
+		found, err := a.searchByTerm(variant, limit, filter...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range found {
+			if !exists[item.ID] {
+				exists[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if int64(len(items)) >= limit {
+			break
+		}
+	}
+	if int64(len(items)) <= from {
+		return nil, nil
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+
+	return items[from:], nil
+}
+
+// Найти дома по варианту подстроки
+func (a *PgHouseRepository) searchByTerm(variant util.TermVariant, size int64, filter ...entity.FilterObject) ([]*entity.HouseObject, error) {
+	var db *gorm.DB
+	if variant.Fuzzy {
+		db = pgHelper.WhereFuzzyTerm(a.table(), "address_suggest", variant.Term)
+	} else if patterns := pgHelper.PrepareTermPatterns(variant.Term); len(patterns) > 0 {
+		db = a.table()
+		for _, pattern := range patterns {
+			db = db.Where("address_suggest ILIKE ?", pattern)
+		}
+	}
+	if db == nil {
+		return nil, nil
+	}
+	db = a.prepareFilter(db, filter...)
+	if db == nil {
+		return nil, nil
+	}
+
+	return a.find(db.
+		Order(gorm.Expr("similarity(address_suggest, ?) DESC", variant.Term)).
+		Order("full_address").
+		Limit(size))
+}
+
+// Подготовить фильтр для запроса
+func (a *PgHouseRepository) prepareFilter(db *gorm.DB, filters ...entity.FilterObject) *gorm.DB {
+	for _, filter := range filters {
+		if len(filter.Level.Values) > 0 {
+			hasHouses := false
+			for _, value := range filter.Level.Values {
+				if value == 8 {
+					hasHouses = true
+					break
+				}
+			}
+			if !hasHouses {
+				return nil
+			}
+		}
+		if filter.Level.Min > 0 || filter.Level.Max > 0 {
+			if filter.Level.Min > 0 && filter.Level.Min > 8 {
+				return nil
+			}
+			if filter.Level.Max > 0 && filter.Level.Max < 8 {
+				return nil
+			}
+		}
+		if len(filter.ParentGuid.Values) > 0 {
+			db = db.Where("ao_guid IN (?)", filter.ParentGuid.Values)
+		}
+		if len(filter.KladrId.Values) > 0 {
+			return nil
+		}
+	}
+
+	return db
+}
+
+// Обновить коллекцию домов
+func (a *PgHouseRepository) InsertUpdateCollection(wg *sync.WaitGroup, channel <-chan interface{}, count chan<- int, isFull bool) {
+	defer wg.Done()
+	var total uint64
+	begin := time.Now()
+	step := 1
+	var deleted []string
+	updated := make(map[string]dto.PgHouseDto)
+
+	// Цикл получения объекта дома из канала
+	for d := range channel {
+		if d == nil {
+			break
+		}
+		// Сохраняет накопленные элементы и фиксирует контрольную точку разбора файла
+		if checkpoint, ok := d.(util.CheckpointObject); ok {
+			if len(updated)+len(deleted) > 0 {
+				a.update(updated, deleted, isFull)
+				deleted = nil
+			}
+			checkpoint.Commit()
+			continue
+		}
+		total++
+		saveItem := dto.PgHouseDto{}
+		saveItem.GetFromEntity(d.(entity.HouseObject))
+		// Проверяет активность объекта
+		if saveItem.IsActive() {
+			// Добавляет объект в очередь на сохранение
+			updated[saveItem.HouseGuid] = saveItem
+		} else {
+			// Добавляет объект в очередь на удаление
+			deleted = append(deleted, saveItem.ID)
+		}
+
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(updated)+len(deleted) >= a.batchSize {
+			a.update(updated, deleted, isFull)
+			deleted = nil
+			if total%uint64(100000) == 0 && !util.CanPrintProcess {
+				a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add houses to table")
+				step++
+			}
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(updated)+len(deleted) > 0 {
+		a.update(updated, deleted, isFull)
+		deleted = nil
+	}
+	if !util.CanPrintProcess {
+		a.logger.WithFields(interfaces.LoggerFields{"step": step, "count": total}).Info("Add houses to table")
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": total, "execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("House import execution time")
+	count <- int(total)
+}
+
+// Сохраняет данные в БД
+func (a *PgHouseRepository) update(updated map[string]dto.PgHouseDto, deleted []string, isFull bool) {
+	// Дополняет элементы полями из БД
+	if len(updated) > 0 && !isFull {
+		var updatedKeys []string
+		for k := range updated {
+			updatedKeys = append(updatedKeys, k)
+		}
+
+		items, _ := a.GetByGuidList(updatedKeys)
+		for _, item := range items {
+			updateItem, ok := updated[item.HouseGuid]
+			if ok {
+				updateItem.UpdateFromExistItem(*item)
+				updated[item.HouseGuid] = updateItem
+			}
+		}
+	}
+	var items []interface{}
+	for k, item := range updated {
+		items = append(items, item)
+		delete(updated, k)
+	}
+
+	if err := a.pgClient.Upsert(a.tableName, "house_id", items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Add houses batch commit failed")
+	}
+	if err := a.pgClient.Delete(a.tableName, "house_id", deleted); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Delete houses batch commit failed")
+	}
+}
+
+// Обновить иерархию домов
+func (a *PgHouseRepository) UpdateHierarchy(items []entity.HierarchyObject) error {
+	values := make(map[string]string)
+	dates := make(map[string]string)
+	updateDate := time.Now().Format(util.TimeFormat)
+	for _, item := range items {
+		values[item.ObjectId] = item.ParentGuid
+		dates[item.ObjectId] = updateDate
+	}
+	if err := a.pgClient.UpdateColumn(a.tableName, "house_id", "ao_guid", values); err != nil {
+		return err
+	}
+
+	// Обновляет дату изменения для перестроения полного адреса при индексации
+	return a.pgClient.UpdateColumn(a.tableName, "house_id", "bazis_update_date", dates)
+}
+
+// Обновить параметры домов
+func (a *PgHouseRepository) UpdateParams(items []entity.ParamObject) error {
+	fields := make(map[string]map[string]string)
+	for _, item := range items {
+		var field string
+		switch item.TypeId {
+		case entity.ParamPostalCode:
+			field = "postal_code"
+		case entity.ParamOkato:
+			field = "okato"
+		case entity.ParamOktmo:
+			field = "oktmo"
+		case entity.ParamCadNum:
+			field = "cad_num"
+		default:
+			continue
+		}
+		if fields[field] == nil {
+			fields[field] = make(map[string]string)
+		}
+		fields[field][item.ObjectId] = item.Value
+	}
+	for field, values := range fields {
+		if err := a.pgClient.UpdateColumn(a.tableName, "house_id", field, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Подсчитать количество домов в БД по фильтру
+func (a *PgHouseRepository) CountAllData(query interface{}) (int64, error) {
+	condition, _ := query.(*pgHelper.Condition)
+
+	return a.pgClient.CountAllData(a.tableName, condition)
+}
+
+// Индексация домов
+func (a *PgHouseRepository) Index(start time.Time, indexChan <-chan entity.IndexObject, GetIndexObjects repository.GetIndexObjects) error {
+	done := make(chan bool)
+	// Создает канал для сохранения объектов в БД
+	a.results = make(chan dto.PgHouseDto, a.noOfWorkers)
+	var total int64
+	// Ищет элементы по дате
+	if indexChan == nil {
+		condition := &pgHelper.Condition{
+			Query: "bazis_update_date >= ?",
+			Args:  []interface{}{start.Format(util.TimeFormat)},
+		}
+		total, _ = a.CountAllData(condition)
+		go a.getItemsByQuery(condition, GetIndexObjects)
+	}
+	// Обновляет элементы в БД
+	go a.saveIndexItems(total, done)
+	// Создает пул задач на обработку элементов
+	if indexChan != nil {
+		a.createWorkerPool(a.noOfWorkers, indexChan)
+	}
+	<-done
+
+	return nil
+}
+
+// Получить дома из канала адресов
+func (a *PgHouseRepository) getItemsByAddress(wg *sync.WaitGroup, indexChan <-chan entity.IndexObject) {
+	defer wg.Done()
+	indexObjectList := make(map[string]entity.IndexObject)
+	for d := range indexChan {
+		indexObjectList[d.AoGuid] = d
+		if len(indexObjectList) >= a.batchSize {
+			a.prepareIndexChanHouses(indexObjectList)
+		}
+	}
+	if len(indexObjectList) > 0 {
+		a.prepareIndexChanHouses(indexObjectList)
+	}
+}
+
+// Подготовить дома для индексации из канала адресов
+func (a *PgHouseRepository) prepareIndexChanHouses(indexObjectList map[string]entity.IndexObject) {
+	var guids []string
+	for k := range indexObjectList {
+		guids = append(guids, k)
+	}
+	// Получает список домов по GUID адресов
+	houses, err := a.GetByAddressGuidList(guids)
+	if err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err, "ao_guids": guids}).Fatal("Get houses failed")
+		for k := range indexObjectList {
+			delete(indexObjectList, k)
+		}
+		return
+	}
+	for _, house := range houses {
+		saveItem := dto.PgHouseDto{}
+		indexObject, ok := indexObjectList[house.AoGuid]
+		if ok {
+			// Конвертирует объект дома в DTO
+			saveItem.GetFromEntity(*house)
+			// Заполняет данные для поиска из элемента канала
+			a.prepareItem(&saveItem, indexObject)
+			a.results <- saveItem
+		}
+	}
+	// Очищает список объектов
+	for k := range indexObjectList {
+		delete(indexObjectList, k)
+	}
+}
+
+// Получить дома по фильтру
+func (a *PgHouseRepository) getItemsByQuery(condition *pgHelper.Condition, GetIndexObjects repository.GetIndexObjects) {
+	defer close(a.results)
+	rows, err := a.table().Where(condition.Query, condition.Args...).Rows()
+	if err != nil {
+		a.logger.Error(err.Error())
+		return
+	}
+	defer rows.Close()
+	count := 0
+	var list []dto.PgHouseDto
+	var guids []string
+
+	// Получает данные из БД построчно и обрабатывает пачками
+	for rows.Next() {
+		var item dto.PgHouseDto
+		if err := a.pgClient.DB.ScanRows(rows, &item); err != nil {
+			a.logger.Fatal(err.Error())
+		}
+		count++
+		guids = append(guids, item.AoGuid)
+		list = append(list, item)
+		if len(list) >= a.batchSize {
+			a.prepareQueryHouses(list, guids, GetIndexObjects)
+			list = nil
+			guids = nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		a.logger.Error(err.Error())
+	}
+	if len(list) > 0 {
+		a.prepareQueryHouses(list, guids, GetIndexObjects)
+	}
+	a.logger.WithFields(interfaces.LoggerFields{"count": count}).Info("Houses update count")
+}
+
+// Подготовить пачку домов для индексации
+func (a *PgHouseRepository) prepareQueryHouses(list []dto.PgHouseDto, guids []string, GetIndexObjects repository.GetIndexObjects) {
+	objectsList := GetIndexObjects(util.UniqueStringSlice(guids))
+	for _, item := range list {
+		object, ok := objectsList[item.AoGuid]
+		if ok {
+			a.prepareItem(&item, object)
+			a.results <- item
+		}
+	}
+}
+
+// Подготовить дома перед записью
+func (a *PgHouseRepository) prepareItem(item *dto.PgHouseDto, object entity.IndexObject) {
+	// Формирует информацию об адресе объекта
+	suggest := "дом д. " + item.HouseNum
+	if item.StructNum != "" {
+		suggest += ", строение стр. " + item.StructNum
+	}
+	if item.BuildNum != "" {
+		suggest += ", корпус кор. " + item.BuildNum
+	}
+	item.AddressSuggest = object.AddressSuggest + ", " + suggest
+	item.FullAddress = object.FullAddress + ", " + item.HouseFullNum
+	// Устанавливает время обновления объекта
+	item.UpdateBazisDate()
+}
+
+// Создать пул задач на обработку элементов
+func (a *PgHouseRepository) createWorkerPool(noOfWorkers int, indexChan <-chan entity.IndexObject) {
+	var wg sync.WaitGroup
+	for i := 0; i < noOfWorkers; i++ {
+		wg.Add(1)
+		// Подготавливает элементы перед сохранением в БД
+		go a.getItemsByAddress(&wg, indexChan)
+	}
+	wg.Wait()
+	close(a.results)
+}
+
+// Обновить элементы в БД
+func (a *PgHouseRepository) saveIndexItems(total int64, done chan bool) {
+	var items []interface{}
+	begin := time.Now()
+	// Инициализация прогресс-бара
+	bar := util.StartNewProgress(int(total), "Indexing houses", false)
+
+	for d := range a.results {
+		// Добавляет объект в очередь на сохранение
+		items = append(items, d)
+		bar.Increment()
+		// Отправляет запросы в БД при превышении размера пачки
+		if len(items) >= a.batchSize {
+			a.saveIndexBatch(items)
+			items = nil
+		}
+	}
+
+	// Отправляет оставшиеся запросы в БД
+	if len(items) > 0 {
+		a.saveIndexBatch(items)
+	}
+	bar.Finish()
+	a.logger.WithFields(interfaces.LoggerFields{"execTime": humanize.RelTime(begin, time.Now(), "", "")}).Info("House index execution time")
+	done <- true
+}
+
+// Сохранить пачку проиндексированных элементов
+func (a *PgHouseRepository) saveIndexBatch(items []interface{}) {
+	if err := a.pgClient.Upsert(a.tableName, "house_id", items); err != nil {
+		a.logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("House index batch commit failed")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура таблицы помещений в PostgreSQL
+	roomTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  room_id text PRIMARY KEY,
+	  room_guid text NOT NULL DEFAULT '',
+	  house_guid text NOT NULL DEFAULT '',
+	  region_code text NOT NULL DEFAULT '',
+	  flat_number text NOT NULL DEFAULT '',
+	  flat_type text NOT NULL DEFAULT '',
+	  room_number text NOT NULL DEFAULT '',
+	  room_type text NOT NULL DEFAULT '',
+	  room_full_num text NOT NULL DEFAULT '',
+	  full_address text NOT NULL DEFAULT '',
+	  postal_code text NOT NULL DEFAULT '',
+	  cad_num text NOT NULL DEFAULT '',
+	  room_cad_num text NOT NULL DEFAULT '',
+	  start_date text NOT NULL DEFAULT '',
+	  end_date text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  bazis_update_date text NOT NULL DEFAULT ''
+	);
+	CREATE INDEX IF NOT EXISTS %[1]s_room_guid_idx ON %[1]s (room_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_house_guid_idx ON %[1]s (house_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_bazis_update_date_idx ON %[1]s (bazis_update_date);
+	`
+)
+
+// Репозиторий помещений в PostgreSQL
+type PgRoomRepository struct {
+	*pgChildRepository
+}
+
+// Инициализация репозитория
+func NewPgRoomRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.RoomRepositoryInterface {
+	return &PgRoomRepository{
+		pgChildRepository: newPgChildRepository(pgClient, logger, batchSize, prefix+entity.RoomObject{}.TableName(), childObjects{
+			name:         "rooms",
+			title:        "Room",
+			settings:     roomTableSettings,
+			idField:      "room_id",
+			guidField:    "room_guid",
+			parentField:  "house_guid",
+			fullNumField: "room_full_num",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.PgRoomDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.PgRoomDto{}
+				saveItem.GetFromEntity(item.(entity.RoomObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты помещений
+func (a *PgRoomRepository) toEntities(items []dto.ChildObjectDto) []*entity.RoomObject {
+	var list []*entity.RoomObject
+	for _, item := range items {
+		list = append(list, item.(*dto.PgRoomDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти помещение по GUID
+func (a *PgRoomRepository) GetByGuid(guid string) (*entity.RoomObject, error) {
+	item, err := a.getByGuid(guid)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item.(*dto.PgRoomDto).ToEntity(), nil
+}
+
+// Получить помещения по GUID
+func (a *PgRoomRepository) GetByGuidList(guids []string) ([]*entity.RoomObject, error) {
+	items, err := a.getByGuidList(guids)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
+
+// Найти помещения по GUID дома
+func (a *PgRoomRepository) GetByHouseGuid(guid string) ([]*entity.RoomObject, error) {
+	items, err := a.getByParentGuid(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
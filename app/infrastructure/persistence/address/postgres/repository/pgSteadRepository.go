@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура таблицы участков в PostgreSQL
+	steadTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  stead_id text PRIMARY KEY,
+	  stead_guid text NOT NULL DEFAULT '',
+	  parent_guid text NOT NULL DEFAULT '',
+	  region_code text NOT NULL DEFAULT '',
+	  number text NOT NULL DEFAULT '',
+	  stead_full_num text NOT NULL DEFAULT '',
+	  full_address text NOT NULL DEFAULT '',
+	  postal_code text NOT NULL DEFAULT '',
+	  okato text NOT NULL DEFAULT '',
+	  oktmo text NOT NULL DEFAULT '',
+	  cad_num text NOT NULL DEFAULT '',
+	  div_type text NOT NULL DEFAULT '',
+	  start_date text NOT NULL DEFAULT '',
+	  end_date text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  bazis_update_date text NOT NULL DEFAULT ''
+	);
+	CREATE INDEX IF NOT EXISTS %[1]s_stead_guid_idx ON %[1]s (stead_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_parent_guid_idx ON %[1]s (parent_guid);
+	CREATE INDEX IF NOT EXISTS %[1]s_bazis_update_date_idx ON %[1]s (bazis_update_date);
+	`
+)
+
+// Репозиторий участков в PostgreSQL
+type PgSteadRepository struct {
+	*pgChildRepository
+}
+
+// Инициализация репозитория
+func NewPgSteadRepository(pgClient *pgHelper.Client, logger interfaces.LoggerInterface, batchSize int, prefix string) repository.SteadRepositoryInterface {
+	return &PgSteadRepository{
+		pgChildRepository: newPgChildRepository(pgClient, logger, batchSize, prefix+entity.SteadObject{}.TableName(), childObjects{
+			name:         "steads",
+			title:        "Stead",
+			settings:     steadTableSettings,
+			idField:      "stead_id",
+			guidField:    "stead_guid",
+			parentField:  "parent_guid",
+			fullNumField: "stead_full_num",
+			newDto: func() dto.ChildObjectDto {
+				return &dto.PgSteadDto{}
+			},
+			fromEntity: func(item interface{}) dto.ChildObjectDto {
+				saveItem := &dto.PgSteadDto{}
+				saveItem.GetFromEntity(item.(entity.SteadObject))
+
+				return saveItem
+			},
+		}),
+	}
+}
+
+// Конвертирует DTO в объекты участков
+func (a *PgSteadRepository) toEntities(items []dto.ChildObjectDto) []*entity.SteadObject {
+	var list []*entity.SteadObject
+	for _, item := range items {
+		list = append(list, item.(*dto.PgSteadDto).ToEntity())
+	}
+
+	return list
+}
+
+// Найти участок по GUID
+func (a *PgSteadRepository) GetByGuid(guid string) (*entity.SteadObject, error) {
+	item, err := a.getByGuid(guid)
+	if err != nil || item == nil {
+		return nil, err
+	}
+
+	return item.(*dto.PgSteadDto).ToEntity(), nil
+}
+
+// Получить участки по GUID
+func (a *PgSteadRepository) GetByGuidList(guids []string) ([]*entity.SteadObject, error) {
+	items, err := a.getByGuidList(guids)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
+
+// Найти участки по GUID адреса
+func (a *PgSteadRepository) GetByAddressGuid(guid string) ([]*entity.SteadObject, error) {
+	items, err := a.getByParentGuid(guid)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntities(items), nil
+}
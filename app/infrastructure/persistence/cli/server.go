@@ -25,6 +25,7 @@ type App struct {
 	DirectoryService *directoryService.DirectoryService // Сервис управления файлами
 	FiasApiService   *fiasApiService.FiasApiService     // Сервис ФИАС
 	OsmService       *osmService.OsmService             // Сервис OSM
+	NormalizeService *service.NormalizeService          // Сервис нормализации адресов
 }
 
 // Инициализация приложения
@@ -52,6 +53,7 @@ func NewApp(ctn *registry.Container) *App {
 		VersionService:   ctn.Resolve("versionService").(*versionService.VersionService),
 		FiasApiService:   ctn.Resolve("fiasApiService").(*fiasApiService.FiasApiService),
 		OsmService:       ctn.Resolve("osmService").(*osmService.OsmService),
+		NormalizeService: ctn.Resolve("normalizeService").(*service.NormalizeService),
 	}
 }
 
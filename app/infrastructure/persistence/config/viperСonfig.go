@@ -35,6 +35,7 @@ func (config *ViperConfig) Init() error {
 func (config *ViperConfig) initConfig() {
 	config.baseConfig = interfaces.BaseConfig{
 		ProjectPrefix: config.GetString("project.prefix"),
+		Storage:       config.GetString("storage.type", "elastic"),
 		Elastic: interfaces.ElasticConfig{
 			Scheme:   config.GetString("elastic.scheme", "http"),
 			Host:     config.GetString("elastic.host", "localhost"),
@@ -43,10 +44,41 @@ func (config *ViperConfig) initConfig() {
 			User:     config.GetString("elastic.username"),
 			Password: config.GetString("elastic.password"),
 		},
+		Postgres: interfaces.PostgresConfig{
+			Host:     config.GetString("postgres.host", "localhost"),
+			Port:     config.GetString("postgres.port", "5432"),
+			User:     config.GetString("postgres.username", "postgres"),
+			Password: config.GetString("postgres.password"),
+			Database: config.GetString("postgres.database", "fias"),
+			SslMode:  config.GetString("postgres.sslmode", "disable"),
+		},
+		Embedded: interfaces.EmbeddedConfig{
+			Path: config.GetString("embedded.path", "./data/"),
+		},
 		BatchSize:         config.GetInt("batch.size", 5000),
 		DirectoryFilePath: config.GetString("directory.filePath", "/tmp/fias/"),
 		ProcessPrint:      config.GetBool("process.print"),
 		FiasApiUrl:        config.GetString("fiasApi.url", "https://fias.nalog.ru/WebServices/Public/"),
+		Download: interfaces.DownloadConfig{
+			Retries:    config.GetInt("download.retries", 5),
+			RetryDelay: config.GetInt("download.retryDelay", 5),
+			Timeout:    config.GetInt("download.timeout", 60),
+			Proxy:      config.GetString("download.proxy"),
+		},
+		History: interfaces.HistoryConfig{
+			Enable: config.GetBool("history.enable"),
+		},
+		Changes: interfaces.ChangesConfig{
+			Enable:    config.GetBool("changes.enable"),
+			Publisher: config.GetString("changes.publisher"),
+			Path:      config.GetString("changes.path", "./changes/changes.jsonl"),
+		},
+		Ranking: interfaces.RankingConfig{
+			Enable:     config.GetBool("ranking.enable"),
+			Level:      config.GetFloat64("ranking.level", 1),
+			Center:     config.GetFloat64("ranking.center", 1),
+			Population: config.GetFloat64("ranking.population", 1),
+		},
 		LoggerConsole: interfaces.LoggerConfig{
 			Enable: config.GetBool("logger.console.enable"),
 			Level:  config.GetString("logger.console.level", "debug"),
@@ -76,7 +108,10 @@ func (config *ViperConfig) initConfig() {
 			Addresses: config.GetInt("workers.addresses", 4),
 		},
 		Osm: interfaces.OsmConfig{
-			Url: config.GetString("osm.url", "http://download.geofabrik.de/russia-latest.osm.pbf"),
+			Url:            config.GetString("osm.url", "http://download.geofabrik.de/russia-latest.osm.pbf"),
+			Boundaries:     config.GetBool("osm.boundaries"),
+			MatchThreshold: config.GetFloat64("osm.matchThreshold", 0.75),
+			ReportPath:     config.GetString("osm.reportPath", "./reports/"),
 		},
 	}
 }
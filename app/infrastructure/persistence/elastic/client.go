@@ -5,6 +5,7 @@ import (
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/olivere/elastic/v7"
 	"io"
+	"net/http"
 )
 
 // Объект-обёртка клиента эластика
@@ -147,6 +148,35 @@ func (e *Client) ScrollData(scrollService *elastic.ScrollService, batch int) ([]
 	return totals, nil
 }
 
+// Получить данные через ScrollApi пачками и передать каждую пачку в обработчик
+func (e *Client) ScrollBatches(scrollService *elastic.ScrollService, batch int, handler func(hits []*elastic.SearchHit) error) error {
+	ctx := context.Background()
+	// Ограничивает размер пачки при поиске
+	if batch > 10000 {
+		batch = 10000
+	}
+	scrollService.Scroll("10m").Size(batch)
+	// Принудительно закрывает сервис выборки элементов
+	defer scrollService.Clear(ctx)
+
+	// Получает данные из эластика пачками
+	for {
+		res, err := scrollService.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.Hits.Hits) == 0 {
+			return nil
+		}
+		if err := handler(res.Hits.Hits); err != nil {
+			return err
+		}
+	}
+}
+
 // Подсчитать количество элементов в БД по фильтру
 func (e *Client) CountAllData(index string, query elastic.Query) (int64, error) {
 	cnt := e.Client.Count(index)
@@ -180,3 +210,16 @@ Loop:
 
 	return errorDetail
 }
+
+// Получает ошибку частичного обновления пачки, пропуская отсутствующие документы
+func (e *Client) GetBulkUpdateError(bulk *elastic.BulkResponse) *elastic.ErrorDetails {
+	for _, resItems := range bulk.Items {
+		for _, resItem := range resItems {
+			if resItem.Error != nil && resItem.Status != http.StatusNotFound {
+				return resItem.Error
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package embedded
+
+import (
+	"bytes"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/analysis/token/edgengram"
+	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/numeric"
+	"github.com/blevesearch/bleve/search/query"
+	bolt "go.etcd.io/bbolt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// Название файла БД
+	dbFileName = "fias.db"
+	// Анализатор для поиска по началу слов
+	EdgeNgramAnalyzer = "edge_ngram_analyzer"
+	// Анализатор поисковых запросов
+	KeywordAnalyzer = "keyword_analyzer"
+)
+
+// Объект-обёртка встроенного хранилища: bbolt для данных и bleve для полнотекстового поиска
+type Client struct {
+	DB      *bolt.DB               // Подключение к БД
+	path    string                 // Путь к каталогу с файлами БД
+	indexes map[string]bleve.Index // Открытые поисковые индексы
+	mu      sync.Mutex             // Блокировка открытия индексов
+}
+
+// Инициализация объекта
+func NewEmbeddedClient(configInterface interfaces.ConfigInterface, logger interfaces.LoggerInterface) *Client {
+	path := configInterface.GetConfig().Embedded.Path
+	if err := os.MkdirAll(path, 0755); err != nil {
+		logger.WithFields(interfaces.LoggerFields{"error": err, "path": path}).Fatal("Embedded storage directory creation failed")
+		panic(err)
+	}
+
+	// Открытие файла БД, файл блокируется для других процессов
+	db, err := bolt.Open(filepath.Join(path, dbFileName), 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		logger.WithFields(interfaces.LoggerFields{"error": err, "path": path}).Fatal("Embedded storage open failed")
+		panic(err)
+	}
+
+	return &Client{
+		DB:      db,
+		path:    path,
+		indexes: make(map[string]bleve.Index),
+	}
+}
+
+// Создание бакетов
+func (c *Client) CreateBucket(names ...string) error {
+	return c.DB.Update(func(tx *bolt.Tx) error {
+		for _, name := range names {
+			if _, err := tx.CreateBucketIfNotExists([]byte(name)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Удаление бакетов
+func (c *Client) DropBucket(names ...string) error {
+	return c.DB.Update(func(tx *bolt.Tx) error {
+		for _, name := range names {
+			if tx.Bucket([]byte(name)) == nil {
+				continue
+			}
+			if err := tx.DeleteBucket([]byte(name)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Подсчитать количество элементов в бакете
+func (c *Client) CountAllData(name string) (int64, error) {
+	var count int64
+	err := c.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(name))
+		if bucket != nil {
+			count = int64(bucket.Stats().KeyN)
+		}
+
+		return nil
+	})
+
+	return count, err
+}
+
+// Перебрать значения бакета пачками, обработчик пачки вызывается вне транзакции чтения
+func (c *Client) ScanBucket(name string, batch int, handler func(values [][]byte) error) error {
+	if batch <= 0 {
+		batch = 1000
+	}
+	var last []byte
+	for {
+		var values [][]byte
+		err := c.DB.View(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket([]byte(name))
+			if bucket == nil {
+				return nil
+			}
+			cursor := bucket.Cursor()
+			k, v := cursor.First()
+			// Продолжает перебор после последнего ключа предыдущей пачки
+			if last != nil {
+				k, v = cursor.Seek(last)
+				if k != nil && bytes.Equal(k, last) {
+					k, v = cursor.Next()
+				}
+			}
+			for ; k != nil && len(values) < batch; k, v = cursor.Next() {
+				values = append(values, append([]byte(nil), v...))
+				last = append(last[:0:0], k...)
+			}
+
+			return nil
+		})
+		if err != nil || len(values) == 0 {
+			return err
+		}
+		if err := handler(values); err != nil {
+			return err
+		}
+	}
+}
+
+// Открыть или создать поисковый индекс
+func (c *Client) OpenIndex(name string, indexMapping mapping.IndexMapping) (bleve.Index, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if index, ok := c.indexes[name]; ok {
+		return index, nil
+	}
+	path := c.indexPath(name)
+	index, err := bleve.Open(path)
+	if err == bleve.ErrorIndexPathDoesNotExist {
+		index, err = bleve.NewUsing(path, indexMapping, scorch.Name, scorch.Name, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.indexes[name] = index
+
+	return index, nil
+}
+
+// Удалить поисковый индекс
+func (c *Client) DropIndex(name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if index, ok := c.indexes[name]; ok {
+		if err := index.Close(); err != nil {
+			return err
+		}
+		delete(c.indexes, name)
+	}
+
+	return os.RemoveAll(c.indexPath(name))
+}
+
+// Закрыть БД и поисковые индексы
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, index := range c.indexes {
+		index.Close()
+		delete(c.indexes, name)
+	}
+
+	return c.DB.Close()
+}
+
+// Получить путь к каталогу поискового индекса
+func (c *Client) indexPath(name string) string {
+	return filepath.Join(c.path, name+".bleve")
+}
+
+// Создать структуру поискового индекса с анализаторами для поиска по подстроке
+func NewIndexMapping() *mapping.IndexMappingImpl {
+	indexMapping := bleve.NewIndexMapping()
+	_ = indexMapping.AddCustomTokenFilter("edge_ngram_filter", map[string]interface{}{
+		"type": edgengram.Name,
+		"min":  1.0,
+		"max":  40.0,
+	})
+	_ = indexMapping.AddCustomAnalyzer(EdgeNgramAnalyzer, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name, "edge_ngram_filter"},
+	})
+	_ = indexMapping.AddCustomAnalyzer(KeywordAnalyzer, map[string]interface{}{
+		"type":          custom.Name,
+		"tokenizer":     unicode.Name,
+		"token_filters": []string{lowercase.Name},
+	})
+	indexMapping.DefaultAnalyzer = KeywordAnalyzer
+	indexMapping.DefaultMapping.Dynamic = false
+
+	return indexMapping
+}
+
+// Создать поле для поиска по началу слов
+func NewSuggestFieldMapping() *mapping.FieldMapping {
+	field := bleve.NewTextFieldMapping()
+	field.Analyzer = EdgeNgramAnalyzer
+	field.Store = false
+	field.IncludeInAll = false
+	field.IncludeTermVectors = false
+	field.DocValues = false
+
+	return field
+}
+
+// Создать текстовое поле для поиска по словам
+func NewTextFieldMapping() *mapping.FieldMapping {
+	field := bleve.NewTextFieldMapping()
+	field.Analyzer = KeywordAnalyzer
+	field.Store = false
+	field.IncludeInAll = false
+	field.IncludeTermVectors = false
+	field.DocValues = false
+
+	return field
+}
+
+// Создать поле для точного поиска и сортировки
+func NewKeywordFieldMapping() *mapping.FieldMapping {
+	field := bleve.NewTextFieldMapping()
+	field.Analyzer = keyword.Name
+	field.Store = false
+	field.IncludeInAll = false
+	field.IncludeTermVectors = false
+
+	return field
+}
+
+// Создать числовое поле
+func NewNumericFieldMapping() *mapping.FieldMapping {
+	field := bleve.NewNumericFieldMapping()
+	field.Store = false
+	field.IncludeInAll = false
+
+	return field
+}
+
+// Создать поле координат
+func NewGeoPointFieldMapping() *mapping.FieldMapping {
+	field := bleve.NewGeoPointFieldMapping()
+	field.Store = false
+	field.IncludeInAll = false
+
+	return field
+}
+
+// Создать запрос поиска по всем словам подстроки
+func NewMatchQuery(field string, term string) query.Query {
+	match := bleve.NewMatchQuery(term)
+	match.SetField(field)
+	match.Analyzer = KeywordAnalyzer
+	match.SetOperator(query.MatchQueryOperatorAnd)
+
+	return match
+}
+
+// Создать запрос поиска по всем словам подстроки с учетом опечаток
+func NewFuzzyMatchQuery(field string, term string) query.Query {
+	match := bleve.NewMatchQuery(term)
+	match.SetField(field)
+	match.Analyzer = KeywordAnalyzer
+	match.SetOperator(query.MatchQueryOperatorAnd)
+	match.SetFuzziness(1)
+	match.SetPrefix(1)
+
+	return match
+}
+
+// Получить значение сортировки числового поля для выборки после курсора
+func NumericSortValue(value float64) string {
+	return string(numeric.MustNewPrefixCodedInt64(numeric.Float64ToInt64(value), 0))
+}
+
+// Создать запрос поиска по точному совпадению одного из значений
+func NewTermsQuery(field string, values ...string) query.Query {
+	var queries []query.Query
+	for _, value := range values {
+		term := bleve.NewTermQuery(value)
+		term.SetField(field)
+		queries = append(queries, term)
+	}
+
+	return bleve.NewDisjunctionQuery(queries...)
+}
+
+// Создать запрос поиска по диапазону чисел
+func NewRangeQuery(field string, min *float64, max *float64) query.Query {
+	inclusive := true
+	rangeQuery := bleve.NewNumericRangeInclusiveQuery(min, max, &inclusive, &inclusive)
+	rangeQuery.SetField(field)
+
+	return rangeQuery
+}
+
+// Создать запрос поиска по одному из чисел
+func NewNumbersQuery(field string, values ...float64) query.Query {
+	var queries []query.Query
+	for i := range values {
+		queries = append(queries, NewRangeQuery(field, &values[i], &values[i]))
+	}
+
+	return bleve.NewDisjunctionQuery(queries...)
+}
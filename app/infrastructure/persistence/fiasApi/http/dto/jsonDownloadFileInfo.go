@@ -6,4 +6,6 @@ type JsonDownloadFileInfo struct {
 	TextVersion        string `json:"TextVersion"`
 	FiasCompleteXmlUrl string ` json:"FiasCompleteXmlUrl"`
 	FiasDeltaXmlUrl    string `json:"FiasDeltaXmlUrl"`
+	GarXmlFullUrl      string `json:"GarXMLFullURL"`
+	GarXmlDeltaUrl     string `json:"GarXMLDeltaURL"`
 }
@@ -6,7 +6,9 @@ import (
 	"github.com/GarinAG/gofias/domain/fiasApi/repository"
 	"github.com/GarinAG/gofias/infrastructure/persistence/fiasApi/http/dto"
 	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
 	"net/http"
+	"time"
 )
 
 const (
@@ -32,7 +34,11 @@ func (f *HttpFiasApiRepository) GetAllDownloadFileInfo() ([]entity.DownloadFileI
 	var jsonFiles []dto.JsonDownloadFileInfo
 
 	url := f.config.GetConfig().FiasApiUrl + httpFiasApiAllFiles
-	res, err := f.getHttpClient().Get(url)
+	client, err := f.getHttpClient()
+	if err != nil {
+		return files, err
+	}
+	res, err := client.Get(url)
 	if err != nil {
 		return files, err
 	}
@@ -54,7 +60,11 @@ func (f *HttpFiasApiRepository) GetLastDownloadFileInfo() (entity.DownloadFileIn
 	var file entity.DownloadFileInfo
 	var jsonFile dto.JsonDownloadFileInfo
 	url := f.config.GetConfig().FiasApiUrl + httpFiasApiLastFile
-	res, err := f.getHttpClient().Get(url)
+	client, err := f.getHttpClient()
+	if err != nil {
+		return file, err
+	}
+	res, err := client.Get(url)
 	if err != nil {
 		return file, err
 	}
@@ -70,11 +80,15 @@ func (f *HttpFiasApiRepository) GetLastDownloadFileInfo() (entity.DownloadFileIn
 }
 
 // Инициализация http-клиента
-func (f *HttpFiasApiRepository) getHttpClient() *http.Client {
-	return &http.Client{Transport: &http.Transport{
-		MaxIdleConns:        20,
-		MaxIdleConnsPerHost: 20,
-	}}
+func (f *HttpFiasApiRepository) getHttpClient() (*http.Client, error) {
+	downloadConfig := f.config.GetConfig().Download
+	timeout := time.Duration(downloadConfig.Timeout) * time.Second
+	transport, err := util.NewHttpTransport(downloadConfig.Proxy, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: transport, Timeout: timeout}, nil
 }
 
 // Конвертирует DTO в объект версии
@@ -84,6 +98,8 @@ func (f *HttpFiasApiRepository) convertToEntity(item dto.JsonDownloadFileInfo) e
 		TextVersion:        item.TextVersion,
 		FiasCompleteXmlUrl: item.FiasCompleteXmlUrl,
 		FiasDeltaXmlUrl:    item.FiasDeltaXmlUrl,
+		GarXmlFullUrl:      item.GarXmlFullUrl,
+		GarXmlDeltaUrl:     item.GarXmlDeltaUrl,
 	}
 }
 
@@ -94,5 +110,7 @@ func (f *HttpFiasApiRepository) convertToDto(item entity.DownloadFileInfo) dto.J
 		TextVersion:        item.TextVersion,
 		FiasCompleteXmlUrl: item.FiasCompleteXmlUrl,
 		FiasDeltaXmlUrl:    item.FiasDeltaXmlUrl,
+		GarXmlFullUrl:      item.GarXmlFullUrl,
+		GarXmlDeltaUrl:     item.GarXmlDeltaUrl,
 	}
 }
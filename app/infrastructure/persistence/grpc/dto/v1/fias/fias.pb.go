@@ -32,12 +32,59 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type Hierarchy int32
+
+const (
+	Hierarchy_ADM Hierarchy = 0
+	Hierarchy_MUN Hierarchy = 1
+)
+
+// Enum value maps for Hierarchy.
+var (
+	Hierarchy_name = map[int32]string{
+		0: "ADM",
+		1: "MUN",
+	}
+	Hierarchy_value = map[string]int32{
+		"ADM": 0,
+		"MUN": 1,
+	}
+)
+
+func (x Hierarchy) Enum() *Hierarchy {
+	p := new(Hierarchy)
+	*p = x
+	return p
+}
+
+func (x Hierarchy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Hierarchy) Descriptor() protoreflect.EnumDescriptor {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_enumTypes[0].Descriptor()
+}
+
+func (Hierarchy) Type() protoreflect.EnumType {
+	return &file_app_interfaces_grpc_proto_v1_fias_fias_proto_enumTypes[0]
+}
+
+func (x Hierarchy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Hierarchy.Descriptor instead.
+func (Hierarchy) EnumDescriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{0}
+}
+
 type GuidRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Guid string `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	Guid      string    `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	Hierarchy Hierarchy `protobuf:"varint,2,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
 func (x *GuidRequest) Reset() {
@@ -79,18 +126,24 @@ func (x *GuidRequest) GetGuid() string {
 	return ""
 }
 
-type TermRequest struct {
+func (x *GuidRequest) GetHierarchy() Hierarchy {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return Hierarchy_ADM
+}
+
+type GuidDateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
-	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	From int64  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	Guid string `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid,omitempty"`
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
 }
 
-func (x *TermRequest) Reset() {
-	*x = TermRequest{}
+func (x *GuidDateRequest) Reset() {
+	*x = GuidDateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -98,13 +151,13 @@ func (x *TermRequest) Reset() {
 	}
 }
 
-func (x *TermRequest) String() string {
+func (x *GuidDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TermRequest) ProtoMessage() {}
+func (*GuidDateRequest) ProtoMessage() {}
 
-func (x *TermRequest) ProtoReflect() protoreflect.Message {
+func (x *GuidDateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -116,45 +169,36 @@ func (x *TermRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TermRequest.ProtoReflect.Descriptor instead.
-func (*TermRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GuidDateRequest.ProtoReflect.Descriptor instead.
+func (*GuidDateRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *TermRequest) GetTerm() string {
+func (x *GuidDateRequest) GetGuid() string {
 	if x != nil {
-		return x.Term
+		return x.Guid
 	}
 	return ""
 }
 
-func (x *TermRequest) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *TermRequest) GetFrom() int64 {
+func (x *GuidDateRequest) GetDate() string {
 	if x != nil {
-		return x.From
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-type TermFilterRequest struct {
+type NormalizeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Term   string        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
-	Size   int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	From   int64         `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
-	Filter *FilterObject `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Term      string    `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Hierarchy Hierarchy `protobuf:"varint,2,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
-func (x *TermFilterRequest) Reset() {
-	*x = TermFilterRequest{}
+func (x *NormalizeRequest) Reset() {
+	*x = NormalizeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -162,13 +206,13 @@ func (x *TermFilterRequest) Reset() {
 	}
 }
 
-func (x *TermFilterRequest) String() string {
+func (x *NormalizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TermFilterRequest) ProtoMessage() {}
+func (*NormalizeRequest) ProtoMessage() {}
 
-func (x *TermFilterRequest) ProtoReflect() protoreflect.Message {
+func (x *NormalizeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -180,51 +224,36 @@ func (x *TermFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TermFilterRequest.ProtoReflect.Descriptor instead.
-func (*TermFilterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use NormalizeRequest.ProtoReflect.Descriptor instead.
+func (*NormalizeRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TermFilterRequest) GetTerm() string {
+func (x *NormalizeRequest) GetTerm() string {
 	if x != nil {
 		return x.Term
 	}
 	return ""
 }
 
-func (x *TermFilterRequest) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *TermFilterRequest) GetFrom() int64 {
-	if x != nil {
-		return x.From
-	}
-	return 0
-}
-
-func (x *TermFilterRequest) GetFilter() *FilterObject {
+func (x *NormalizeRequest) GetHierarchy() Hierarchy {
 	if x != nil {
-		return x.Filter
+		return x.Hierarchy
 	}
-	return nil
+	return Hierarchy_ADM
 }
 
-type SimpleTermFilterRequest struct {
+type ResolveCurrentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Term   string        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
-	Size   int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
-	Filter *FilterObject `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Id        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hierarchy Hierarchy `protobuf:"varint,2,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
-func (x *SimpleTermFilterRequest) Reset() {
-	*x = SimpleTermFilterRequest{}
+func (x *ResolveCurrentRequest) Reset() {
+	*x = ResolveCurrentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,13 +261,13 @@ func (x *SimpleTermFilterRequest) Reset() {
 	}
 }
 
-func (x *SimpleTermFilterRequest) String() string {
+func (x *ResolveCurrentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleTermFilterRequest) ProtoMessage() {}
+func (*ResolveCurrentRequest) ProtoMessage() {}
 
-func (x *SimpleTermFilterRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolveCurrentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -250,42 +279,36 @@ func (x *SimpleTermFilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleTermFilterRequest.ProtoReflect.Descriptor instead.
-func (*SimpleTermFilterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveCurrentRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCurrentRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SimpleTermFilterRequest) GetTerm() string {
+func (x *ResolveCurrentRequest) GetId() string {
 	if x != nil {
-		return x.Term
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SimpleTermFilterRequest) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *SimpleTermFilterRequest) GetFilter() *FilterObject {
+func (x *ResolveCurrentRequest) GetHierarchy() Hierarchy {
 	if x != nil {
-		return x.Filter
+		return x.Hierarchy
 	}
-	return nil
+	return Hierarchy_ADM
 }
 
-type AddressListResponse struct {
+type PageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*Address `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Size      int64  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *AddressListResponse) Reset() {
-	*x = AddressListResponse{}
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,13 +316,13 @@ func (x *AddressListResponse) Reset() {
 	}
 }
 
-func (x *AddressListResponse) String() string {
+func (x *PageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressListResponse) ProtoMessage() {}
+func (*PageRequest) ProtoMessage() {}
 
-func (x *AddressListResponse) ProtoReflect() protoreflect.Message {
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -311,30 +334,39 @@ func (x *AddressListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressListResponse.ProtoReflect.Descriptor instead.
-func (*AddressListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PageRequest.ProtoReflect.Descriptor instead.
+func (*PageRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddressListResponse) GetItems() []*Address {
+func (x *PageRequest) GetSize() int64 {
 	if x != nil {
-		return x.Items
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-type FilterObject struct {
+func (x *PageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListChildrenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Level      *NumberFilter `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	ParentGuid *StringFilter `protobuf:"bytes,2,opt,name=parent_guid,json=parentGuid,proto3" json:"parent_guid,omitempty"`
-	KladrId    *StringFilter `protobuf:"bytes,3,opt,name=kladr_id,json=kladrId,proto3" json:"kladr_id,omitempty"`
+	ParentGuid string    `protobuf:"bytes,1,opt,name=parent_guid,json=parentGuid,proto3" json:"parent_guid,omitempty"`
+	Level      int64     `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Size       int64     `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	PageToken  string    `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Hierarchy  Hierarchy `protobuf:"varint,5,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
-func (x *FilterObject) Reset() {
-	*x = FilterObject{}
+func (x *ListChildrenRequest) Reset() {
+	*x = ListChildrenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -342,13 +374,13 @@ func (x *FilterObject) Reset() {
 	}
 }
 
-func (x *FilterObject) String() string {
+func (x *ListChildrenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterObject) ProtoMessage() {}
+func (*ListChildrenRequest) ProtoMessage() {}
 
-func (x *FilterObject) ProtoReflect() protoreflect.Message {
+func (x *ListChildrenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,42 +392,59 @@ func (x *FilterObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterObject.ProtoReflect.Descriptor instead.
-func (*FilterObject) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChildrenRequest.ProtoReflect.Descriptor instead.
+func (*ListChildrenRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *FilterObject) GetLevel() *NumberFilter {
+func (x *ListChildrenRequest) GetParentGuid() string {
+	if x != nil {
+		return x.ParentGuid
+	}
+	return ""
+}
+
+func (x *ListChildrenRequest) GetLevel() int64 {
 	if x != nil {
 		return x.Level
 	}
-	return nil
+	return 0
 }
 
-func (x *FilterObject) GetParentGuid() *StringFilter {
+func (x *ListChildrenRequest) GetSize() int64 {
 	if x != nil {
-		return x.ParentGuid
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-func (x *FilterObject) GetKladrId() *StringFilter {
+func (x *ListChildrenRequest) GetPageToken() string {
 	if x != nil {
-		return x.KladrId
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type StringFilter struct {
+func (x *ListChildrenRequest) GetHierarchy() Hierarchy {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return Hierarchy_ADM
+}
+
+type ExportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	RegionCodes  []string  `protobuf:"bytes,1,rep,name=region_codes,json=regionCodes,proto3" json:"region_codes,omitempty"`
+	Levels       []int64   `protobuf:"varint,2,rep,packed,name=levels,proto3" json:"levels,omitempty"`
+	UpdatedSince string    `protobuf:"bytes,3,opt,name=updated_since,json=updatedSince,proto3" json:"updated_since,omitempty"`
+	Hierarchy    Hierarchy `protobuf:"varint,4,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
-func (x *StringFilter) Reset() {
-	*x = StringFilter{}
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,13 +452,13 @@ func (x *StringFilter) Reset() {
 	}
 }
 
-func (x *StringFilter) String() string {
+func (x *ExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StringFilter) ProtoMessage() {}
+func (*ExportRequest) ProtoMessage() {}
 
-func (x *StringFilter) ProtoReflect() protoreflect.Message {
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,30 +470,53 @@ func (x *StringFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StringFilter.ProtoReflect.Descriptor instead.
-func (*StringFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StringFilter) GetValues() []string {
+func (x *ExportRequest) GetRegionCodes() []string {
 	if x != nil {
-		return x.Values
+		return x.RegionCodes
 	}
 	return nil
 }
 
-type NumberFilter struct {
+func (x *ExportRequest) GetLevels() []int64 {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetUpdatedSince() string {
+	if x != nil {
+		return x.UpdatedSince
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetHierarchy() Hierarchy {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return Hierarchy_ADM
+}
+
+type ReverseGeocodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Values []float32 `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
-	Min    float32   `protobuf:"fixed32,2,opt,name=min,proto3" json:"min,omitempty"`
-	Max    float32   `protobuf:"fixed32,3,opt,name=max,proto3" json:"max,omitempty"`
+	Lat       float64   `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon       float64   `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	Radius    int64     `protobuf:"varint,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Level     int64     `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Hierarchy Hierarchy `protobuf:"varint,5,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
 }
 
-func (x *NumberFilter) Reset() {
-	*x = NumberFilter{}
+func (x *ReverseGeocodeRequest) Reset() {
+	*x = ReverseGeocodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,13 +524,13 @@ func (x *NumberFilter) Reset() {
 	}
 }
 
-func (x *NumberFilter) String() string {
+func (x *ReverseGeocodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumberFilter) ProtoMessage() {}
+func (*ReverseGeocodeRequest) ProtoMessage() {}
 
-func (x *NumberFilter) ProtoReflect() protoreflect.Message {
+func (x *ReverseGeocodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,86 +542,58 @@ func (x *NumberFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumberFilter.ProtoReflect.Descriptor instead.
-func (*NumberFilter) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReverseGeocodeRequest.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *NumberFilter) GetValues() []float32 {
+func (x *ReverseGeocodeRequest) GetLat() float64 {
 	if x != nil {
-		return x.Values
+		return x.Lat
 	}
-	return nil
+	return 0
 }
 
-func (x *NumberFilter) GetMin() float32 {
+func (x *ReverseGeocodeRequest) GetLon() float64 {
 	if x != nil {
-		return x.Min
+		return x.Lon
 	}
 	return 0
 }
 
-func (x *NumberFilter) GetMax() float32 {
+func (x *ReverseGeocodeRequest) GetRadius() int64 {
 	if x != nil {
-		return x.Max
+		return x.Radius
 	}
 	return 0
 }
 
-type Address struct {
+func (x *ReverseGeocodeRequest) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ReverseGeocodeRequest) GetHierarchy() Hierarchy {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return Hierarchy_ADM
+}
+
+type ChangesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	FiasId            string  `protobuf:"bytes,2,opt,name=FiasId,proto3" json:"FiasId,omitempty"`
-	FiasLevel         string  `protobuf:"bytes,3,opt,name=FiasLevel,proto3" json:"FiasLevel,omitempty"`
-	ParentFiasId      string  `protobuf:"bytes,4,opt,name=ParentFiasId,proto3" json:"ParentFiasId,omitempty"`
-	ShortName         string  `protobuf:"bytes,5,opt,name=ShortName,proto3" json:"ShortName,omitempty"`
-	FormalName        string  `protobuf:"bytes,6,opt,name=FormalName,proto3" json:"FormalName,omitempty"`
-	PostalCode        string  `protobuf:"bytes,7,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
-	FullName          string  `protobuf:"bytes,8,opt,name=FullName,proto3" json:"FullName,omitempty"`
-	FullAddress       string  `protobuf:"bytes,9,opt,name=FullAddress,proto3" json:"FullAddress,omitempty"`
-	KladrId           string  `protobuf:"bytes,10,opt,name=KladrId,proto3" json:"KladrId,omitempty"`
-	RegionFiasId      string  `protobuf:"bytes,11,opt,name=RegionFiasId,proto3" json:"RegionFiasId,omitempty"`
-	RegionKladrId     string  `protobuf:"bytes,12,opt,name=RegionKladrId,proto3" json:"RegionKladrId,omitempty"`
-	Region            string  `protobuf:"bytes,13,opt,name=Region,proto3" json:"Region,omitempty"`
-	RegionType        string  `protobuf:"bytes,14,opt,name=RegionType,proto3" json:"RegionType,omitempty"`
-	RegionFull        string  `protobuf:"bytes,15,opt,name=RegionFull,proto3" json:"RegionFull,omitempty"`
-	AreaFiasId        string  `protobuf:"bytes,16,opt,name=AreaFiasId,proto3" json:"AreaFiasId,omitempty"`
-	AreaKladrId       string  `protobuf:"bytes,17,opt,name=AreaKladrId,proto3" json:"AreaKladrId,omitempty"`
-	Area              string  `protobuf:"bytes,18,opt,name=Area,proto3" json:"Area,omitempty"`
-	AreaType          string  `protobuf:"bytes,19,opt,name=AreaType,proto3" json:"AreaType,omitempty"`
-	AreaFull          string  `protobuf:"bytes,20,opt,name=AreaFull,proto3" json:"AreaFull,omitempty"`
-	CityFiasId        string  `protobuf:"bytes,21,opt,name=CityFiasId,proto3" json:"CityFiasId,omitempty"`
-	CityKladrId       string  `protobuf:"bytes,22,opt,name=CityKladrId,proto3" json:"CityKladrId,omitempty"`
-	City              string  `protobuf:"bytes,23,opt,name=City,proto3" json:"City,omitempty"`
-	CityType          string  `protobuf:"bytes,24,opt,name=CityType,proto3" json:"CityType,omitempty"`
-	CityFull          string  `protobuf:"bytes,25,opt,name=CityFull,proto3" json:"CityFull,omitempty"`
-	SettlementFiasId  string  `protobuf:"bytes,26,opt,name=SettlementFiasId,proto3" json:"SettlementFiasId,omitempty"`
-	SettlementKladrId string  `protobuf:"bytes,27,opt,name=SettlementKladrId,proto3" json:"SettlementKladrId,omitempty"`
-	Settlement        string  `protobuf:"bytes,28,opt,name=Settlement,proto3" json:"Settlement,omitempty"`
-	SettlementType    string  `protobuf:"bytes,29,opt,name=SettlementType,proto3" json:"SettlementType,omitempty"`
-	SettlementFull    string  `protobuf:"bytes,30,opt,name=SettlementFull,proto3" json:"SettlementFull,omitempty"`
-	StreetFiasId      string  `protobuf:"bytes,31,opt,name=StreetFiasId,proto3" json:"StreetFiasId,omitempty"`
-	StreetKladrId     string  `protobuf:"bytes,32,opt,name=StreetKladrId,proto3" json:"StreetKladrId,omitempty"`
-	Street            string  `protobuf:"bytes,33,opt,name=Street,proto3" json:"Street,omitempty"`
-	StreetType        string  `protobuf:"bytes,34,opt,name=StreetType,proto3" json:"StreetType,omitempty"`
-	StreetFull        string  `protobuf:"bytes,35,opt,name=StreetFull,proto3" json:"StreetFull,omitempty"`
-	HouseFiasId       string  `protobuf:"bytes,36,opt,name=HouseFiasId,proto3" json:"HouseFiasId,omitempty"`
-	HouseKladrId      string  `protobuf:"bytes,37,opt,name=HouseKladrId,proto3" json:"HouseKladrId,omitempty"`
-	House             string  `protobuf:"bytes,38,opt,name=House,proto3" json:"House,omitempty"`
-	HouseType         string  `protobuf:"bytes,39,opt,name=HouseType,proto3" json:"HouseType,omitempty"`
-	HouseFull         string  `protobuf:"bytes,40,opt,name=HouseFull,proto3" json:"HouseFull,omitempty"`
-	GeoLat            float32 `protobuf:"fixed32,41,opt,name=GeoLat,proto3" json:"GeoLat,omitempty"`
-	GeoLon            float32 `protobuf:"fixed32,42,opt,name=GeoLon,proto3" json:"GeoLon,omitempty"`
-	Okato             string  `protobuf:"bytes,43,opt,name=Okato,proto3" json:"Okato,omitempty"`
-	Oktmo             string  `protobuf:"bytes,44,opt,name=Oktmo,proto3" json:"Oktmo,omitempty"`
-	UpdatedDate       string  `protobuf:"bytes,45,opt,name=UpdatedDate,proto3" json:"UpdatedDate,omitempty"`
+	SinceVersion int64  `protobuf:"varint,1,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
+	Size         int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	PageToken    string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
+func (x *ChangesRequest) Reset() {
+	*x = ChangesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,13 +601,13 @@ func (x *Address) Reset() {
 	}
 }
 
-func (x *Address) String() string {
+func (x *ChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*ChangesRequest) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
+func (x *ChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,360 +619,532 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangesRequest.ProtoReflect.Descriptor instead.
+func (*ChangesRequest) Descriptor() ([]byte, []int) {
 	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Address) GetID() string {
+func (x *ChangesRequest) GetSinceVersion() int64 {
 	if x != nil {
-		return x.ID
+		return x.SinceVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetFiasId() string {
+func (x *ChangesRequest) GetSize() int64 {
 	if x != nil {
-		return x.FiasId
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetFiasLevel() string {
+func (x *ChangesRequest) GetPageToken() string {
 	if x != nil {
-		return x.FiasLevel
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Address) GetParentFiasId() string {
-	if x != nil {
-		return x.ParentFiasId
-	}
-	return ""
+type TermRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	From int64  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
 }
 
-func (x *Address) GetShortName() string {
-	if x != nil {
-		return x.ShortName
+func (x *TermRequest) Reset() {
+	*x = TermRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Address) GetFormalName() string {
-	if x != nil {
-		return x.FormalName
-	}
-	return ""
+func (x *TermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Address) GetPostalCode() string {
-	if x != nil {
-		return x.PostalCode
+func (*TermRequest) ProtoMessage() {}
+
+func (x *TermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Address) GetFullName() string {
-	if x != nil {
-		return x.FullName
-	}
-	return ""
+// Deprecated: Use TermRequest.ProtoReflect.Descriptor instead.
+func (*TermRequest) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Address) GetFullAddress() string {
+func (x *TermRequest) GetTerm() string {
 	if x != nil {
-		return x.FullAddress
+		return x.Term
 	}
 	return ""
 }
 
-func (x *Address) GetKladrId() string {
+func (x *TermRequest) GetSize() int64 {
 	if x != nil {
-		return x.KladrId
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetRegionFiasId() string {
+func (x *TermRequest) GetFrom() int64 {
 	if x != nil {
-		return x.RegionFiasId
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetRegionKladrId() string {
-	if x != nil {
-		return x.RegionKladrId
-	}
-	return ""
+type TermFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term      string        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Size      int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	From      int64         `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	Filter    *FilterObject `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Hierarchy Hierarchy     `protobuf:"varint,5,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
+	Ranking   *Ranking      `protobuf:"bytes,6,opt,name=ranking,proto3" json:"ranking,omitempty"`
+	PageToken string        `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *Address) GetRegion() string {
-	if x != nil {
-		return x.Region
+func (x *TermFilterRequest) Reset() {
+	*x = TermFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Address) GetRegionType() string {
-	if x != nil {
-		return x.RegionType
-	}
-	return ""
+func (x *TermFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Address) GetRegionFull() string {
-	if x != nil {
-		return x.RegionFull
+func (*TermFilterRequest) ProtoMessage() {}
+
+func (x *TermFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Address) GetAreaFiasId() string {
-	if x != nil {
-		return x.AreaFiasId
-	}
-	return ""
+// Deprecated: Use TermFilterRequest.ProtoReflect.Descriptor instead.
+func (*TermFilterRequest) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Address) GetAreaKladrId() string {
+func (x *TermFilterRequest) GetTerm() string {
 	if x != nil {
-		return x.AreaKladrId
+		return x.Term
 	}
 	return ""
 }
 
-func (x *Address) GetArea() string {
+func (x *TermFilterRequest) GetSize() int64 {
 	if x != nil {
-		return x.Area
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetAreaType() string {
+func (x *TermFilterRequest) GetFrom() int64 {
 	if x != nil {
-		return x.AreaType
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetAreaFull() string {
+func (x *TermFilterRequest) GetFilter() *FilterObject {
 	if x != nil {
-		return x.AreaFull
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *Address) GetCityFiasId() string {
+func (x *TermFilterRequest) GetHierarchy() Hierarchy {
 	if x != nil {
-		return x.CityFiasId
+		return x.Hierarchy
 	}
-	return ""
+	return Hierarchy_ADM
 }
 
-func (x *Address) GetCityKladrId() string {
+func (x *TermFilterRequest) GetRanking() *Ranking {
 	if x != nil {
-		return x.CityKladrId
+		return x.Ranking
 	}
-	return ""
+	return nil
 }
 
-func (x *Address) GetCity() string {
+func (x *TermFilterRequest) GetPageToken() string {
 	if x != nil {
-		return x.City
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *Address) GetCityType() string {
-	if x != nil {
-		return x.CityType
-	}
-	return ""
+type SimpleTermFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Term    string        `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Size    int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Filter  *FilterObject `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Ranking *Ranking      `protobuf:"bytes,4,opt,name=ranking,proto3" json:"ranking,omitempty"`
 }
 
-func (x *Address) GetCityFull() string {
-	if x != nil {
-		return x.CityFull
+func (x *SimpleTermFilterRequest) Reset() {
+	*x = SimpleTermFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Address) GetSettlementFiasId() string {
-	if x != nil {
-		return x.SettlementFiasId
-	}
-	return ""
+func (x *SimpleTermFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Address) GetSettlementKladrId() string {
-	if x != nil {
-		return x.SettlementKladrId
+func (*SimpleTermFilterRequest) ProtoMessage() {}
+
+func (x *SimpleTermFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Address) GetSettlement() string {
-	if x != nil {
-		return x.Settlement
-	}
-	return ""
+// Deprecated: Use SimpleTermFilterRequest.ProtoReflect.Descriptor instead.
+func (*SimpleTermFilterRequest) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Address) GetSettlementType() string {
+func (x *SimpleTermFilterRequest) GetTerm() string {
 	if x != nil {
-		return x.SettlementType
+		return x.Term
 	}
 	return ""
 }
 
-func (x *Address) GetSettlementFull() string {
+func (x *SimpleTermFilterRequest) GetSize() int64 {
 	if x != nil {
-		return x.SettlementFull
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Address) GetStreetFiasId() string {
+func (x *SimpleTermFilterRequest) GetFilter() *FilterObject {
 	if x != nil {
-		return x.StreetFiasId
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *Address) GetStreetKladrId() string {
+func (x *SimpleTermFilterRequest) GetRanking() *Ranking {
 	if x != nil {
-		return x.StreetKladrId
+		return x.Ranking
 	}
-	return ""
+	return nil
 }
 
-func (x *Address) GetStreet() string {
-	if x != nil {
-		return x.Street
-	}
-	return ""
+type AddressListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*Address `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *Address) GetStreetType() string {
-	if x != nil {
-		return x.StreetType
+func (x *AddressListResponse) Reset() {
+	*x = AddressListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Address) GetStreetFull() string {
-	if x != nil {
-		return x.StreetFull
-	}
-	return ""
+func (x *AddressListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Address) GetHouseFiasId() string {
+func (*AddressListResponse) ProtoMessage() {}
+
+func (x *AddressListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressListResponse.ProtoReflect.Descriptor instead.
+func (*AddressListResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddressListResponse) GetItems() []*Address {
 	if x != nil {
-		return x.HouseFiasId
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AddressListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Address) GetHouseKladrId() string {
+type NormalizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    *Address       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RoomFiasId string         `protobuf:"bytes,2,opt,name=room_fias_id,json=roomFiasId,proto3" json:"room_fias_id,omitempty"`
+	Confidence float32        `protobuf:"fixed32,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Remainder  string         `protobuf:"bytes,4,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	Parsed     *ParsedAddress `protobuf:"bytes,5,opt,name=parsed,proto3" json:"parsed,omitempty"`
+}
+
+func (x *NormalizeResponse) Reset() {
+	*x = NormalizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NormalizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalizeResponse) ProtoMessage() {}
+
+func (x *NormalizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalizeResponse.ProtoReflect.Descriptor instead.
+func (*NormalizeResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NormalizeResponse) GetAddress() *Address {
 	if x != nil {
-		return x.HouseKladrId
+		return x.Address
+	}
+	return nil
+}
+
+func (x *NormalizeResponse) GetRoomFiasId() string {
+	if x != nil {
+		return x.RoomFiasId
 	}
 	return ""
 }
 
-func (x *Address) GetHouse() string {
+func (x *NormalizeResponse) GetConfidence() float32 {
 	if x != nil {
-		return x.House
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *NormalizeResponse) GetRemainder() string {
+	if x != nil {
+		return x.Remainder
 	}
 	return ""
 }
 
-func (x *Address) GetHouseType() string {
+func (x *NormalizeResponse) GetParsed() *ParsedAddress {
 	if x != nil {
-		return x.HouseType
+		return x.Parsed
+	}
+	return nil
+}
+
+type ParsedAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostalCode string   `protobuf:"bytes,1,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Names      []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	House      string   `protobuf:"bytes,3,opt,name=house,proto3" json:"house,omitempty"`
+	Building   string   `protobuf:"bytes,4,opt,name=building,proto3" json:"building,omitempty"`
+	Structure  string   `protobuf:"bytes,5,opt,name=structure,proto3" json:"structure,omitempty"`
+	Apartment  string   `protobuf:"bytes,6,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	Region     string   `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+	City       string   `protobuf:"bytes,8,opt,name=city,proto3" json:"city,omitempty"`
+	Street     string   `protobuf:"bytes,9,opt,name=street,proto3" json:"street,omitempty"`
+}
+
+func (x *ParsedAddress) Reset() {
+	*x = ParsedAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedAddress) ProtoMessage() {}
+
+func (x *ParsedAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedAddress.ProtoReflect.Descriptor instead.
+func (*ParsedAddress) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ParsedAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
 	}
 	return ""
 }
 
-func (x *Address) GetHouseFull() string {
+func (x *ParsedAddress) GetNames() []string {
 	if x != nil {
-		return x.HouseFull
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ParsedAddress) GetHouse() string {
+	if x != nil {
+		return x.House
 	}
 	return ""
 }
 
-func (x *Address) GetGeoLat() float32 {
+func (x *ParsedAddress) GetBuilding() string {
 	if x != nil {
-		return x.GeoLat
+		return x.Building
 	}
-	return 0
+	return ""
 }
 
-func (x *Address) GetGeoLon() float32 {
+func (x *ParsedAddress) GetStructure() string {
 	if x != nil {
-		return x.GeoLon
+		return x.Structure
 	}
-	return 0
+	return ""
 }
 
-func (x *Address) GetOkato() string {
+func (x *ParsedAddress) GetApartment() string {
 	if x != nil {
-		return x.Okato
+		return x.Apartment
 	}
 	return ""
 }
 
-func (x *Address) GetOktmo() string {
+func (x *ParsedAddress) GetRegion() string {
 	if x != nil {
-		return x.Oktmo
+		return x.Region
 	}
 	return ""
 }
 
-func (x *Address) GetUpdatedDate() string {
+func (x *ParsedAddress) GetCity() string {
 	if x != nil {
-		return x.UpdatedDate
+		return x.City
 	}
 	return ""
 }
 
-type Health struct {
+func (x *ParsedAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+type ResolveCurrentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uptime               int64   `protobuf:"varint,1,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
-	AllocatedMemory      float32 `protobuf:"fixed32,2,opt,name=AllocatedMemory,proto3" json:"AllocatedMemory,omitempty"`
-	TotalAllocatedMemory float32 `protobuf:"fixed32,3,opt,name=TotalAllocatedMemory,proto3" json:"TotalAllocatedMemory,omitempty"`
-	Goroutines           int32   `protobuf:"varint,4,opt,name=Goroutines,proto3" json:"Goroutines,omitempty"`
-	GCCycles             uint32  `protobuf:"varint,5,opt,name=GCCycles,proto3" json:"GCCycles,omitempty"`
-	NumberOfCPUs         int32   `protobuf:"varint,6,opt,name=NumberOfCPUs,proto3" json:"NumberOfCPUs,omitempty"`
-	HeapSys              float32 `protobuf:"fixed32,7,opt,name=HeapSys,proto3" json:"HeapSys,omitempty"`
-	HeapAllocated        float32 `protobuf:"fixed32,8,opt,name=HeapAllocated,proto3" json:"HeapAllocated,omitempty"`
-	ObjectsInUse         uint64  `protobuf:"varint,9,opt,name=ObjectsInUse,proto3" json:"ObjectsInUse,omitempty"`
-	OSMemoryObtained     float32 `protobuf:"fixed32,10,opt,name=OSMemoryObtained,proto3" json:"OSMemoryObtained,omitempty"`
+	Address *Address   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Chain   []*Address `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
 }
 
-func (x *Health) Reset() {
-	*x = Health{}
+func (x *ResolveCurrentResponse) Reset() {
+	*x = ResolveCurrentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9]
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Health) String() string {
+func (x *ResolveCurrentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Health) ProtoMessage() {}
+func (*ResolveCurrentResponse) ProtoMessage() {}
 
-func (x *Health) ProtoReflect() protoreflect.Message {
-	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9]
+func (x *ResolveCurrentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -939,497 +1155,2897 @@ func (x *Health) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Health.ProtoReflect.Descriptor instead.
-func (*Health) Descriptor() ([]byte, []int) {
-	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ResolveCurrentResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCurrentResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Health) GetUptime() int64 {
+func (x *ResolveCurrentResponse) GetAddress() *Address {
 	if x != nil {
-		return x.Uptime
+		return x.Address
 	}
-	return 0
+	return nil
 }
 
-func (x *Health) GetAllocatedMemory() float32 {
+func (x *ResolveCurrentResponse) GetChain() []*Address {
 	if x != nil {
-		return x.AllocatedMemory
+		return x.Chain
 	}
-	return 0
+	return nil
 }
 
-func (x *Health) GetTotalAllocatedMemory() float32 {
-	if x != nil {
-		return x.TotalAllocatedMemory
-	}
-	return 0
+type FilterObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level      *NumberFilter `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	ParentGuid *StringFilter `protobuf:"bytes,2,opt,name=parent_guid,json=parentGuid,proto3" json:"parent_guid,omitempty"`
+	KladrId    *StringFilter `protobuf:"bytes,3,opt,name=kladr_id,json=kladrId,proto3" json:"kladr_id,omitempty"`
 }
 
-func (x *Health) GetGoroutines() int32 {
-	if x != nil {
-		return x.Goroutines
+func (x *FilterObject) Reset() {
+	*x = FilterObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Health) GetGCCycles() uint32 {
-	if x != nil {
-		return x.GCCycles
-	}
-	return 0
+func (x *FilterObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Health) GetNumberOfCPUs() int32 {
-	if x != nil {
-		return x.NumberOfCPUs
+func (*FilterObject) ProtoMessage() {}
+
+func (x *FilterObject) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Health) GetHeapSys() float32 {
-	if x != nil {
-		return x.HeapSys
-	}
-	return 0
+// Deprecated: Use FilterObject.ProtoReflect.Descriptor instead.
+func (*FilterObject) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Health) GetHeapAllocated() float32 {
+func (x *FilterObject) GetLevel() *NumberFilter {
 	if x != nil {
-		return x.HeapAllocated
+		return x.Level
 	}
-	return 0
+	return nil
 }
 
-func (x *Health) GetObjectsInUse() uint64 {
+func (x *FilterObject) GetParentGuid() *StringFilter {
 	if x != nil {
-		return x.ObjectsInUse
+		return x.ParentGuid
 	}
-	return 0
+	return nil
 }
 
-func (x *Health) GetOSMemoryObtained() float32 {
+func (x *FilterObject) GetKladrId() *StringFilter {
 	if x != nil {
-		return x.OSMemoryObtained
+		return x.KladrId
 	}
-	return 0
+	return nil
 }
 
-type Version struct {
+type Ranking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerVersion string `protobuf:"bytes,1,opt,name=ServerVersion,proto3" json:"ServerVersion,omitempty"`
-	GrpcVersion   string `protobuf:"bytes,2,opt,name=GrpcVersion,proto3" json:"GrpcVersion,omitempty"`
-	FiasVersion   string `protobuf:"bytes,3,opt,name=FiasVersion,proto3" json:"FiasVersion,omitempty"`
+	Level      float32 `protobuf:"fixed32,1,opt,name=level,proto3" json:"level,omitempty"`
+	Center     float32 `protobuf:"fixed32,2,opt,name=center,proto3" json:"center,omitempty"`
+	Population float32 `protobuf:"fixed32,3,opt,name=population,proto3" json:"population,omitempty"`
 }
 
-func (x *Version) Reset() {
-	*x = Version{}
+func (x *Ranking) Reset() {
+	*x = Ranking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10]
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Version) String() string {
+func (x *Ranking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Version) ProtoMessage() {}
+func (*Ranking) ProtoMessage() {}
 
-func (x *Version) ProtoReflect() protoreflect.Message {
-	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10]
+func (x *Ranking) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Version.ProtoReflect.Descriptor instead.
-func (*Version) Descriptor() ([]byte, []int) {
-	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *Version) GetServerVersion() string {
-	if x != nil {
-		return x.ServerVersion
-	}
-	return ""
-}
-
-func (x *Version) GetGrpcVersion() string {
-	if x != nil {
-		return x.GrpcVersion
-	}
-	return ""
-}
-
-func (x *Version) GetFiasVersion() string {
-	if x != nil {
-		return x.FiasVersion
-	}
-	return ""
-}
-
-var File_app_interfaces_grpc_proto_v1_fias_fias_proto protoreflect.FileDescriptor
-
-var file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDesc = []byte{
-	0x0a, 0x2c, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x66,
-	0x69, 0x61, 0x73, 0x2f, 0x66, 0x69, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73,
-	0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x21, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67,
-	0x75, 0x69, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x0c, 0xd0, 0x9c, 0xd0, 0xbe, 0xd1, 0x81, 0xd0, 0xba,
-	0xd0, 0xb2, 0xd0, 0xb0, 0xd2, 0x01, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72,
-	0x6d, 0x12, 0x31, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x13, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x20, 0x6f, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
-	0x01, 0x30, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0xe7, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x72,
-	0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
-	0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41,
-	0x25, 0x32, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x3a, 0x0c, 0xd0, 0x9c, 0xd0, 0xbe, 0xd1, 0x81, 0xd0, 0xba, 0xd0, 0xb2, 0xd0, 0xb0, 0xd2,
-	0x01, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x31, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
-	0x13, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20,
-	0x70, 0x61, 0x67, 0x65, 0x3a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
-	0x32, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1e, 0x92,
-	0x41, 0x1b, 0x32, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20,
-	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x30, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x22, 0x7c, 0x0a, 0x17, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
-	0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07,
-	0xd2, 0x01, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x22, 0x3d, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
-	0xe8, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x12, 0x3e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x4f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x51, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x19, 0x92, 0x41,
-	0x16, 0x32, 0x14, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x20, 0x66, 0x69, 0x61, 0x73, 0x49, 0x64, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47,
-	0x75, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x6b, 0x6c, 0x61, 0x64, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x13, 0x92, 0x41,
-	0x10, 0x32, 0x0e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6b, 0x6c, 0x61, 0x64, 0x72, 0x49,
-	0x64, 0x52, 0x07, 0x6b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0c, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32,
-	0x0d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x02, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x27,
-	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x15, 0x92, 0x41, 0x12,
-	0x32, 0x10, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
-	0x74, 0x6f, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xe9, 0x0a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46,
-	0x69, 0x61, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x46, 0x69, 0x61, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
-	0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46,
-	0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
-	0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75,
-	0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x6c, 0x61,
-	0x64, 0x72, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x6c, 0x61, 0x64,
-	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x61,
-	0x73, 0x49, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46,
-	0x75, 0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x72, 0x65, 0x61, 0x46, 0x69, 0x61,
-	0x73, 0x49, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x72, 0x65, 0x61, 0x46,
-	0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x72, 0x65, 0x61, 0x4b, 0x6c, 0x61,
-	0x64, 0x72, 0x49, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x72, 0x65, 0x61,
-	0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x65, 0x61, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x41,
-	0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41,
-	0x72, 0x65, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x65, 0x61, 0x46,
-	0x75, 0x6c, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x72, 0x65, 0x61, 0x46,
-	0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x69, 0x74, 0x79, 0x46, 0x69, 0x61, 0x73, 0x49,
-	0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x69, 0x74, 0x79, 0x46, 0x69, 0x61,
-	0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x69, 0x74, 0x79, 0x4b, 0x6c, 0x61, 0x64, 0x72,
-	0x49, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x69, 0x74, 0x79, 0x4b, 0x6c,
-	0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74,
-	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c,
-	0x6c, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c,
-	0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46,
-	0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x2c, 0x0a,
-	0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72,
-	0x49, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x1d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x53,
-	0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12,
-	0x24, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64,
-	0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4b, 0x6c,
-	0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18,
-	0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x23, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x20, 0x0a,
-	0x0b, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x24, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12,
-	0x22, 0x0a, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x18,
-	0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4b, 0x6c, 0x61, 0x64,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x26, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x75,
-	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f,
-	0x75, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65,
-	0x46, 0x75, 0x6c, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x75, 0x73,
-	0x65, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x61, 0x74, 0x18,
-	0x29, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x61, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x47,
-	0x65, 0x6f, 0x4c, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x18, 0x2b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x4f,
-	0x6b, 0x74, 0x6d, 0x6f, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x74, 0x6d,
-	0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
-	0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x22, 0xee, 0x02, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x12, 0x32, 0x0a, 0x14, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x43, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x47, 0x43, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73,
-	0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x50, 0x55, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
-	0x43, 0x50, 0x55, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x48, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x48, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49,
-	0x6e, 0x55, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x53, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x10, 0x4f, 0x53, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x62, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x64, 0x22, 0x73, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x47, 0x72, 0x70, 0x63,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x73, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x69,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x58, 0x0a, 0x0d, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x0f, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x32, 0x5a, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x66, 0x69,
-	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x10, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32,
-	0xa0, 0x05, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1a, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x3a,
-	0x01, 0x2a, 0x5a, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c,
-	0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x70, 0x6f,
-	0x73, 0x74, 0x61, 0x6c, 0x2f, 0x7b, 0x74, 0x65, 0x72, 0x6d, 0x7d, 0x12, 0x53, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x47, 0x75, 0x69, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f,
-	0x76, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d,
-	0x12, 0x5c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f,
-	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x62,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72,
-	0x6d, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x74, 0x65,
-	0x72, 0x6d, 0x12, 0x7e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x73, 0x12, 0x20, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x5a, 0x12,
-	0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x73, 0x42, 0x9d, 0x04, 0x5a, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x76,
-	0x31, 0x2f, 0x66, 0x69, 0x61, 0x73, 0x92, 0x41, 0xe8, 0x03, 0x12, 0xaa, 0x01, 0x0a, 0x0e, 0x47,
-	0x6f, 0x46, 0x69, 0x61, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x47, 0x0a,
-	0x0c, 0x46, 0x69, 0x61, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x68,
-	0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x41, 0x65, 0x72, 0x6f, 0x41, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x67, 0x6f, 0x66,
-	0x69, 0x61, 0x73, 0x1a, 0x11, 0x67, 0x61, 0x72, 0x69, 0x6e, 0x40, 0x61, 0x65, 0x72, 0x6f, 0x69,
-	0x64, 0x65, 0x61, 0x2e, 0x72, 0x75, 0x2a, 0x4a, 0x0a, 0x0b, 0x4d, 0x49, 0x54, 0x20, 0x4c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x65, 0x72, 0x6f, 0x41, 0x67,
-	0x65, 0x6e, 0x63, 0x79, 0x2f, 0x67, 0x6f, 0x66, 0x69, 0x61, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x2e,
-	0x4d, 0x44, 0x32, 0x03, 0x33, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52,
-	0x70, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12, 0x69, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72,
-	0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x2e, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x52, 0x53, 0x0a, 0x03, 0x34, 0x30, 0x34, 0x12, 0x4c, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20,
-	0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x23, 0x2f, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x4a, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x43, 0x0a,
-	0x21, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
-	0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x62, 0x61,
-	0x64, 0x2e, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescOnce sync.Once
-	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData = file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDesc
-)
-
-func file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP() []byte {
-	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescOnce.Do(func() {
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData)
-	})
-	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData
-}
-
-var file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_app_interfaces_grpc_proto_v1_fias_fias_proto_goTypes = []interface{}{
-	(*GuidRequest)(nil),             // 0: fias_v1.GuidRequest
-	(*TermRequest)(nil),             // 1: fias_v1.TermRequest
-	(*TermFilterRequest)(nil),       // 2: fias_v1.TermFilterRequest
-	(*SimpleTermFilterRequest)(nil), // 3: fias_v1.SimpleTermFilterRequest
-	(*AddressListResponse)(nil),     // 4: fias_v1.AddressListResponse
-	(*FilterObject)(nil),            // 5: fias_v1.FilterObject
-	(*StringFilter)(nil),            // 6: fias_v1.StringFilter
-	(*NumberFilter)(nil),            // 7: fias_v1.NumberFilter
-	(*Address)(nil),                 // 8: fias_v1.Address
-	(*Health)(nil),                  // 9: fias_v1.Health
-	(*Version)(nil),                 // 10: fias_v1.Version
-	(*empty.Empty)(nil),             // 11: google.protobuf.Empty
-}
-var file_app_interfaces_grpc_proto_v1_fias_fias_proto_depIdxs = []int32{
-	5,  // 0: fias_v1.TermFilterRequest.filter:type_name -> fias_v1.FilterObject
-	5,  // 1: fias_v1.SimpleTermFilterRequest.filter:type_name -> fias_v1.FilterObject
-	8,  // 2: fias_v1.AddressListResponse.items:type_name -> fias_v1.Address
-	7,  // 3: fias_v1.FilterObject.level:type_name -> fias_v1.NumberFilter
-	6,  // 4: fias_v1.FilterObject.parent_guid:type_name -> fias_v1.StringFilter
-	6,  // 5: fias_v1.FilterObject.kladr_id:type_name -> fias_v1.StringFilter
-	11, // 6: fias_v1.HealthService.CheckHealth:input_type -> google.protobuf.Empty
-	11, // 7: fias_v1.VersionService.GetVersion:input_type -> google.protobuf.Empty
-	2,  // 8: fias_v1.AddressService.GetAddressByTerm:input_type -> fias_v1.TermFilterRequest
-	1,  // 9: fias_v1.AddressService.GetAddressByPostal:input_type -> fias_v1.TermRequest
-	0,  // 10: fias_v1.AddressService.GetByGuid:input_type -> fias_v1.GuidRequest
-	11, // 11: fias_v1.AddressService.GetAllCities:input_type -> google.protobuf.Empty
-	1,  // 12: fias_v1.AddressService.GetCitiesByTerm:input_type -> fias_v1.TermRequest
-	3,  // 13: fias_v1.AddressService.GetSuggests:input_type -> fias_v1.SimpleTermFilterRequest
-	9,  // 14: fias_v1.HealthService.CheckHealth:output_type -> fias_v1.Health
-	10, // 15: fias_v1.VersionService.GetVersion:output_type -> fias_v1.Version
-	4,  // 16: fias_v1.AddressService.GetAddressByTerm:output_type -> fias_v1.AddressListResponse
-	4,  // 17: fias_v1.AddressService.GetAddressByPostal:output_type -> fias_v1.AddressListResponse
-	8,  // 18: fias_v1.AddressService.GetByGuid:output_type -> fias_v1.Address
-	4,  // 19: fias_v1.AddressService.GetAllCities:output_type -> fias_v1.AddressListResponse
-	4,  // 20: fias_v1.AddressService.GetCitiesByTerm:output_type -> fias_v1.AddressListResponse
-	4,  // 21: fias_v1.AddressService.GetSuggests:output_type -> fias_v1.AddressListResponse
-	14, // [14:22] is the sub-list for method output_type
-	6,  // [6:14] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
-}
-
-func init() { file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() }
-func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
-	if File_app_interfaces_grpc_proto_v1_fias_fias_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GuidRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ranking.ProtoReflect.Descriptor instead.
+func (*Ranking) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Ranking) GetLevel() float32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Ranking) GetCenter() float32 {
+	if x != nil {
+		return x.Center
+	}
+	return 0
+}
+
+func (x *Ranking) GetPopulation() float32 {
+	if x != nil {
+		return x.Population
+	}
+	return 0
+}
+
+type StringFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *StringFilter) Reset() {
+	*x = StringFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StringFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StringFilter) ProtoMessage() {}
+
+func (x *StringFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StringFilter.ProtoReflect.Descriptor instead.
+func (*StringFilter) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StringFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type NumberFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []float32 `protobuf:"fixed32,1,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Min    float32   `protobuf:"fixed32,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max    float32   `protobuf:"fixed32,3,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *NumberFilter) Reset() {
+	*x = NumberFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NumberFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberFilter) ProtoMessage() {}
+
+func (x *NumberFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberFilter.ProtoReflect.Descriptor instead.
+func (*NumberFilter) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NumberFilter) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *NumberFilter) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *NumberFilter) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FiasId            string  `protobuf:"bytes,2,opt,name=FiasId,proto3" json:"FiasId,omitempty"`
+	FiasLevel         string  `protobuf:"bytes,3,opt,name=FiasLevel,proto3" json:"FiasLevel,omitempty"`
+	ParentFiasId      string  `protobuf:"bytes,4,opt,name=ParentFiasId,proto3" json:"ParentFiasId,omitempty"`
+	ShortName         string  `protobuf:"bytes,5,opt,name=ShortName,proto3" json:"ShortName,omitempty"`
+	FormalName        string  `protobuf:"bytes,6,opt,name=FormalName,proto3" json:"FormalName,omitempty"`
+	PostalCode        string  `protobuf:"bytes,7,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
+	FullName          string  `protobuf:"bytes,8,opt,name=FullName,proto3" json:"FullName,omitempty"`
+	FullAddress       string  `protobuf:"bytes,9,opt,name=FullAddress,proto3" json:"FullAddress,omitempty"`
+	KladrId           string  `protobuf:"bytes,10,opt,name=KladrId,proto3" json:"KladrId,omitempty"`
+	RegionFiasId      string  `protobuf:"bytes,11,opt,name=RegionFiasId,proto3" json:"RegionFiasId,omitempty"`
+	RegionKladrId     string  `protobuf:"bytes,12,opt,name=RegionKladrId,proto3" json:"RegionKladrId,omitempty"`
+	Region            string  `protobuf:"bytes,13,opt,name=Region,proto3" json:"Region,omitempty"`
+	RegionType        string  `protobuf:"bytes,14,opt,name=RegionType,proto3" json:"RegionType,omitempty"`
+	RegionFull        string  `protobuf:"bytes,15,opt,name=RegionFull,proto3" json:"RegionFull,omitempty"`
+	AreaFiasId        string  `protobuf:"bytes,16,opt,name=AreaFiasId,proto3" json:"AreaFiasId,omitempty"`
+	AreaKladrId       string  `protobuf:"bytes,17,opt,name=AreaKladrId,proto3" json:"AreaKladrId,omitempty"`
+	Area              string  `protobuf:"bytes,18,opt,name=Area,proto3" json:"Area,omitempty"`
+	AreaType          string  `protobuf:"bytes,19,opt,name=AreaType,proto3" json:"AreaType,omitempty"`
+	AreaFull          string  `protobuf:"bytes,20,opt,name=AreaFull,proto3" json:"AreaFull,omitempty"`
+	CityFiasId        string  `protobuf:"bytes,21,opt,name=CityFiasId,proto3" json:"CityFiasId,omitempty"`
+	CityKladrId       string  `protobuf:"bytes,22,opt,name=CityKladrId,proto3" json:"CityKladrId,omitempty"`
+	City              string  `protobuf:"bytes,23,opt,name=City,proto3" json:"City,omitempty"`
+	CityType          string  `protobuf:"bytes,24,opt,name=CityType,proto3" json:"CityType,omitempty"`
+	CityFull          string  `protobuf:"bytes,25,opt,name=CityFull,proto3" json:"CityFull,omitempty"`
+	SettlementFiasId  string  `protobuf:"bytes,26,opt,name=SettlementFiasId,proto3" json:"SettlementFiasId,omitempty"`
+	SettlementKladrId string  `protobuf:"bytes,27,opt,name=SettlementKladrId,proto3" json:"SettlementKladrId,omitempty"`
+	Settlement        string  `protobuf:"bytes,28,opt,name=Settlement,proto3" json:"Settlement,omitempty"`
+	SettlementType    string  `protobuf:"bytes,29,opt,name=SettlementType,proto3" json:"SettlementType,omitempty"`
+	SettlementFull    string  `protobuf:"bytes,30,opt,name=SettlementFull,proto3" json:"SettlementFull,omitempty"`
+	StreetFiasId      string  `protobuf:"bytes,31,opt,name=StreetFiasId,proto3" json:"StreetFiasId,omitempty"`
+	StreetKladrId     string  `protobuf:"bytes,32,opt,name=StreetKladrId,proto3" json:"StreetKladrId,omitempty"`
+	Street            string  `protobuf:"bytes,33,opt,name=Street,proto3" json:"Street,omitempty"`
+	StreetType        string  `protobuf:"bytes,34,opt,name=StreetType,proto3" json:"StreetType,omitempty"`
+	StreetFull        string  `protobuf:"bytes,35,opt,name=StreetFull,proto3" json:"StreetFull,omitempty"`
+	HouseFiasId       string  `protobuf:"bytes,36,opt,name=HouseFiasId,proto3" json:"HouseFiasId,omitempty"`
+	HouseKladrId      string  `protobuf:"bytes,37,opt,name=HouseKladrId,proto3" json:"HouseKladrId,omitempty"`
+	House             string  `protobuf:"bytes,38,opt,name=House,proto3" json:"House,omitempty"`
+	HouseType         string  `protobuf:"bytes,39,opt,name=HouseType,proto3" json:"HouseType,omitempty"`
+	HouseFull         string  `protobuf:"bytes,40,opt,name=HouseFull,proto3" json:"HouseFull,omitempty"`
+	GeoLat            float32 `protobuf:"fixed32,41,opt,name=GeoLat,proto3" json:"GeoLat,omitempty"`
+	GeoLon            float32 `protobuf:"fixed32,42,opt,name=GeoLon,proto3" json:"GeoLon,omitempty"`
+	Okato             string  `protobuf:"bytes,43,opt,name=Okato,proto3" json:"Okato,omitempty"`
+	Oktmo             string  `protobuf:"bytes,44,opt,name=Oktmo,proto3" json:"Oktmo,omitempty"`
+	UpdatedDate       string  `protobuf:"bytes,45,opt,name=UpdatedDate,proto3" json:"UpdatedDate,omitempty"`
+	StartDate         string  `protobuf:"bytes,46,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	EndDate           string  `protobuf:"bytes,47,opt,name=EndDate,proto3" json:"EndDate,omitempty"`
+	PrevId            string  `protobuf:"bytes,48,opt,name=PrevId,proto3" json:"PrevId,omitempty"`
+	NextId            string  `protobuf:"bytes,49,opt,name=NextId,proto3" json:"NextId,omitempty"`
+	GeoPrecision      string  `protobuf:"bytes,50,opt,name=GeoPrecision,proto3" json:"GeoPrecision,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Address) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Address) GetFiasId() string {
+	if x != nil {
+		return x.FiasId
+	}
+	return ""
+}
+
+func (x *Address) GetFiasLevel() string {
+	if x != nil {
+		return x.FiasLevel
+	}
+	return ""
+}
+
+func (x *Address) GetParentFiasId() string {
+	if x != nil {
+		return x.ParentFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Address) GetFormalName() string {
+	if x != nil {
+		return x.FormalName
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Address) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
+}
+
+func (x *Address) GetKladrId() string {
+	if x != nil {
+		return x.KladrId
+	}
+	return ""
+}
+
+func (x *Address) GetRegionFiasId() string {
+	if x != nil {
+		return x.RegionFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetRegionKladrId() string {
+	if x != nil {
+		return x.RegionKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetRegionType() string {
+	if x != nil {
+		return x.RegionType
+	}
+	return ""
+}
+
+func (x *Address) GetRegionFull() string {
+	if x != nil {
+		return x.RegionFull
+	}
+	return ""
+}
+
+func (x *Address) GetAreaFiasId() string {
+	if x != nil {
+		return x.AreaFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetAreaKladrId() string {
+	if x != nil {
+		return x.AreaKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *Address) GetAreaType() string {
+	if x != nil {
+		return x.AreaType
+	}
+	return ""
+}
+
+func (x *Address) GetAreaFull() string {
+	if x != nil {
+		return x.AreaFull
+	}
+	return ""
+}
+
+func (x *Address) GetCityFiasId() string {
+	if x != nil {
+		return x.CityFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetCityKladrId() string {
+	if x != nil {
+		return x.CityKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetCityType() string {
+	if x != nil {
+		return x.CityType
+	}
+	return ""
+}
+
+func (x *Address) GetCityFull() string {
+	if x != nil {
+		return x.CityFull
+	}
+	return ""
+}
+
+func (x *Address) GetSettlementFiasId() string {
+	if x != nil {
+		return x.SettlementFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetSettlementKladrId() string {
+	if x != nil {
+		return x.SettlementKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetSettlement() string {
+	if x != nil {
+		return x.Settlement
+	}
+	return ""
+}
+
+func (x *Address) GetSettlementType() string {
+	if x != nil {
+		return x.SettlementType
+	}
+	return ""
+}
+
+func (x *Address) GetSettlementFull() string {
+	if x != nil {
+		return x.SettlementFull
+	}
+	return ""
+}
+
+func (x *Address) GetStreetFiasId() string {
+	if x != nil {
+		return x.StreetFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetStreetKladrId() string {
+	if x != nil {
+		return x.StreetKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetStreetType() string {
+	if x != nil {
+		return x.StreetType
+	}
+	return ""
+}
+
+func (x *Address) GetStreetFull() string {
+	if x != nil {
+		return x.StreetFull
+	}
+	return ""
+}
+
+func (x *Address) GetHouseFiasId() string {
+	if x != nil {
+		return x.HouseFiasId
+	}
+	return ""
+}
+
+func (x *Address) GetHouseKladrId() string {
+	if x != nil {
+		return x.HouseKladrId
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetHouseType() string {
+	if x != nil {
+		return x.HouseType
+	}
+	return ""
+}
+
+func (x *Address) GetHouseFull() string {
+	if x != nil {
+		return x.HouseFull
+	}
+	return ""
+}
+
+func (x *Address) GetGeoLat() float32 {
+	if x != nil {
+		return x.GeoLat
+	}
+	return 0
+}
+
+func (x *Address) GetGeoLon() float32 {
+	if x != nil {
+		return x.GeoLon
+	}
+	return 0
+}
+
+func (x *Address) GetOkato() string {
+	if x != nil {
+		return x.Okato
+	}
+	return ""
+}
+
+func (x *Address) GetOktmo() string {
+	if x != nil {
+		return x.Oktmo
+	}
+	return ""
+}
+
+func (x *Address) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *Address) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Address) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Address) GetPrevId() string {
+	if x != nil {
+		return x.PrevId
+	}
+	return ""
+}
+
+func (x *Address) GetNextId() string {
+	if x != nil {
+		return x.NextId
+	}
+	return ""
+}
+
+func (x *Address) GetGeoPrecision() string {
+	if x != nil {
+		return x.GeoPrecision
+	}
+	return ""
+}
+
+type House struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string  `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FiasId       string  `protobuf:"bytes,2,opt,name=FiasId,proto3" json:"FiasId,omitempty"`
+	ParentFiasId string  `protobuf:"bytes,3,opt,name=ParentFiasId,proto3" json:"ParentFiasId,omitempty"`
+	HouseNum     string  `protobuf:"bytes,4,opt,name=HouseNum,proto3" json:"HouseNum,omitempty"`
+	BuildNum     string  `protobuf:"bytes,5,opt,name=BuildNum,proto3" json:"BuildNum,omitempty"`
+	StructNum    string  `protobuf:"bytes,6,opt,name=StructNum,proto3" json:"StructNum,omitempty"`
+	FullNum      string  `protobuf:"bytes,7,opt,name=FullNum,proto3" json:"FullNum,omitempty"`
+	FullAddress  string  `protobuf:"bytes,8,opt,name=FullAddress,proto3" json:"FullAddress,omitempty"`
+	PostalCode   string  `protobuf:"bytes,9,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
+	Okato        string  `protobuf:"bytes,10,opt,name=Okato,proto3" json:"Okato,omitempty"`
+	Oktmo        string  `protobuf:"bytes,11,opt,name=Oktmo,proto3" json:"Oktmo,omitempty"`
+	CadNum       string  `protobuf:"bytes,12,opt,name=CadNum,proto3" json:"CadNum,omitempty"`
+	GeoLat       float32 `protobuf:"fixed32,13,opt,name=GeoLat,proto3" json:"GeoLat,omitempty"`
+	GeoLon       float32 `protobuf:"fixed32,14,opt,name=GeoLon,proto3" json:"GeoLon,omitempty"`
+	UpdatedDate  string  `protobuf:"bytes,15,opt,name=UpdatedDate,proto3" json:"UpdatedDate,omitempty"`
+	GeoPrecision string  `protobuf:"bytes,16,opt,name=GeoPrecision,proto3" json:"GeoPrecision,omitempty"`
+}
+
+func (x *House) Reset() {
+	*x = House{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *House) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*House) ProtoMessage() {}
+
+func (x *House) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use House.ProtoReflect.Descriptor instead.
+func (*House) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *House) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *House) GetFiasId() string {
+	if x != nil {
+		return x.FiasId
+	}
+	return ""
+}
+
+func (x *House) GetParentFiasId() string {
+	if x != nil {
+		return x.ParentFiasId
+	}
+	return ""
+}
+
+func (x *House) GetHouseNum() string {
+	if x != nil {
+		return x.HouseNum
+	}
+	return ""
+}
+
+func (x *House) GetBuildNum() string {
+	if x != nil {
+		return x.BuildNum
+	}
+	return ""
+}
+
+func (x *House) GetStructNum() string {
+	if x != nil {
+		return x.StructNum
+	}
+	return ""
+}
+
+func (x *House) GetFullNum() string {
+	if x != nil {
+		return x.FullNum
+	}
+	return ""
+}
+
+func (x *House) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
+}
+
+func (x *House) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *House) GetOkato() string {
+	if x != nil {
+		return x.Okato
+	}
+	return ""
+}
+
+func (x *House) GetOktmo() string {
+	if x != nil {
+		return x.Oktmo
+	}
+	return ""
+}
+
+func (x *House) GetCadNum() string {
+	if x != nil {
+		return x.CadNum
+	}
+	return ""
+}
+
+func (x *House) GetGeoLat() float32 {
+	if x != nil {
+		return x.GeoLat
+	}
+	return 0
+}
+
+func (x *House) GetGeoLon() float32 {
+	if x != nil {
+		return x.GeoLon
+	}
+	return 0
+}
+
+func (x *House) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+func (x *House) GetGeoPrecision() string {
+	if x != nil {
+		return x.GeoPrecision
+	}
+	return ""
+}
+
+type PointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat       float64   `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon       float64   `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	Hierarchy Hierarchy `protobuf:"varint,3,opt,name=hierarchy,proto3,enum=fias_v1.Hierarchy" json:"hierarchy,omitempty"`
+}
+
+func (x *PointRequest) Reset() {
+	*x = PointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointRequest) ProtoMessage() {}
+
+func (x *PointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointRequest.ProtoReflect.Descriptor instead.
+func (*PointRequest) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PointRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *PointRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *PointRequest) GetHierarchy() Hierarchy {
+	if x != nil {
+		return x.Hierarchy
+	}
+	return Hierarchy_ADM
+}
+
+type ReverseGeocodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Precision string   `protobuf:"bytes,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	Distance  float32  `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	Address   *Address `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	House     *House   `protobuf:"bytes,4,opt,name=house,proto3" json:"house,omitempty"`
+}
+
+func (x *ReverseGeocodeResponse) Reset() {
+	*x = ReverseGeocodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseGeocodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseGeocodeResponse) ProtoMessage() {}
+
+func (x *ReverseGeocodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseGeocodeResponse.ProtoReflect.Descriptor instead.
+func (*ReverseGeocodeResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReverseGeocodeResponse) GetPrecision() string {
+	if x != nil {
+		return x.Precision
+	}
+	return ""
+}
+
+func (x *ReverseGeocodeResponse) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ReverseGeocodeResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ReverseGeocodeResponse) GetHouse() *House {
+	if x != nil {
+		return x.House
+	}
+	return nil
+}
+
+type ChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*Change `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ChangesResponse) Reset() {
+	*x = ChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesResponse) ProtoMessage() {}
+
+func (x *ChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesResponse.ProtoReflect.Descriptor instead.
+func (*ChangesResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangesResponse) GetItems() []*Change {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID         string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	VersionId  int64  `protobuf:"varint,2,opt,name=VersionId,proto3" json:"VersionId,omitempty"`
+	ObjectType string `protobuf:"bytes,3,opt,name=ObjectType,proto3" json:"ObjectType,omitempty"`
+	Guid       string `protobuf:"bytes,4,opt,name=Guid,proto3" json:"Guid,omitempty"`
+	ChangeType string `protobuf:"bytes,5,opt,name=ChangeType,proto3" json:"ChangeType,omitempty"`
+	RegionCode string `protobuf:"bytes,6,opt,name=RegionCode,proto3" json:"RegionCode,omitempty"`
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Change) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Change) GetVersionId() int64 {
+	if x != nil {
+		return x.VersionId
+	}
+	return 0
+}
+
+func (x *Change) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *Change) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+func (x *Change) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *Change) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+type RoomListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Room `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RoomListResponse) Reset() {
+	*x = RoomListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListResponse) ProtoMessage() {}
+
+func (x *RoomListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListResponse.ProtoReflect.Descriptor instead.
+func (*RoomListResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RoomListResponse) GetItems() []*Room {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Room struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FiasId      string `protobuf:"bytes,2,opt,name=FiasId,proto3" json:"FiasId,omitempty"`
+	HouseFiasId string `protobuf:"bytes,3,opt,name=HouseFiasId,proto3" json:"HouseFiasId,omitempty"`
+	RegionCode  string `protobuf:"bytes,4,opt,name=RegionCode,proto3" json:"RegionCode,omitempty"`
+	FlatNumber  string `protobuf:"bytes,5,opt,name=FlatNumber,proto3" json:"FlatNumber,omitempty"`
+	FlatType    string `protobuf:"bytes,6,opt,name=FlatType,proto3" json:"FlatType,omitempty"`
+	RoomNumber  string `protobuf:"bytes,7,opt,name=RoomNumber,proto3" json:"RoomNumber,omitempty"`
+	RoomType    string `protobuf:"bytes,8,opt,name=RoomType,proto3" json:"RoomType,omitempty"`
+	FullNum     string `protobuf:"bytes,9,opt,name=FullNum,proto3" json:"FullNum,omitempty"`
+	FullAddress string `protobuf:"bytes,10,opt,name=FullAddress,proto3" json:"FullAddress,omitempty"`
+	PostalCode  string `protobuf:"bytes,11,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
+	CadNum      string `protobuf:"bytes,12,opt,name=CadNum,proto3" json:"CadNum,omitempty"`
+	RoomCadNum  string `protobuf:"bytes,13,opt,name=RoomCadNum,proto3" json:"RoomCadNum,omitempty"`
+	UpdatedDate string `protobuf:"bytes,14,opt,name=UpdatedDate,proto3" json:"UpdatedDate,omitempty"`
+}
+
+func (x *Room) Reset() {
+	*x = Room{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Room) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Room) ProtoMessage() {}
+
+func (x *Room) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Room) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Room) GetFiasId() string {
+	if x != nil {
+		return x.FiasId
+	}
+	return ""
+}
+
+func (x *Room) GetHouseFiasId() string {
+	if x != nil {
+		return x.HouseFiasId
+	}
+	return ""
+}
+
+func (x *Room) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *Room) GetFlatNumber() string {
+	if x != nil {
+		return x.FlatNumber
+	}
+	return ""
+}
+
+func (x *Room) GetFlatType() string {
+	if x != nil {
+		return x.FlatType
+	}
+	return ""
+}
+
+func (x *Room) GetRoomNumber() string {
+	if x != nil {
+		return x.RoomNumber
+	}
+	return ""
+}
+
+func (x *Room) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+func (x *Room) GetFullNum() string {
+	if x != nil {
+		return x.FullNum
+	}
+	return ""
+}
+
+func (x *Room) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
+}
+
+func (x *Room) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Room) GetCadNum() string {
+	if x != nil {
+		return x.CadNum
+	}
+	return ""
+}
+
+func (x *Room) GetRoomCadNum() string {
+	if x != nil {
+		return x.RoomCadNum
+	}
+	return ""
+}
+
+func (x *Room) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+type SteadListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Stead `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SteadListResponse) Reset() {
+	*x = SteadListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SteadListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SteadListResponse) ProtoMessage() {}
+
+func (x *SteadListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SteadListResponse.ProtoReflect.Descriptor instead.
+func (*SteadListResponse) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SteadListResponse) GetItems() []*Stead {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Stead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID           string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	FiasId       string `protobuf:"bytes,2,opt,name=FiasId,proto3" json:"FiasId,omitempty"`
+	ParentFiasId string `protobuf:"bytes,3,opt,name=ParentFiasId,proto3" json:"ParentFiasId,omitempty"`
+	RegionCode   string `protobuf:"bytes,4,opt,name=RegionCode,proto3" json:"RegionCode,omitempty"`
+	Number       string `protobuf:"bytes,5,opt,name=Number,proto3" json:"Number,omitempty"`
+	FullNum      string `protobuf:"bytes,6,opt,name=FullNum,proto3" json:"FullNum,omitempty"`
+	FullAddress  string `protobuf:"bytes,7,opt,name=FullAddress,proto3" json:"FullAddress,omitempty"`
+	PostalCode   string `protobuf:"bytes,8,opt,name=PostalCode,proto3" json:"PostalCode,omitempty"`
+	Okato        string `protobuf:"bytes,9,opt,name=Okato,proto3" json:"Okato,omitempty"`
+	Oktmo        string `protobuf:"bytes,10,opt,name=Oktmo,proto3" json:"Oktmo,omitempty"`
+	CadNum       string `protobuf:"bytes,11,opt,name=CadNum,proto3" json:"CadNum,omitempty"`
+	UpdatedDate  string `protobuf:"bytes,12,opt,name=UpdatedDate,proto3" json:"UpdatedDate,omitempty"`
+}
+
+func (x *Stead) Reset() {
+	*x = Stead{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stead) ProtoMessage() {}
+
+func (x *Stead) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stead.ProtoReflect.Descriptor instead.
+func (*Stead) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Stead) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Stead) GetFiasId() string {
+	if x != nil {
+		return x.FiasId
+	}
+	return ""
+}
+
+func (x *Stead) GetParentFiasId() string {
+	if x != nil {
+		return x.ParentFiasId
+	}
+	return ""
+}
+
+func (x *Stead) GetRegionCode() string {
+	if x != nil {
+		return x.RegionCode
+	}
+	return ""
+}
+
+func (x *Stead) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Stead) GetFullNum() string {
+	if x != nil {
+		return x.FullNum
+	}
+	return ""
+}
+
+func (x *Stead) GetFullAddress() string {
+	if x != nil {
+		return x.FullAddress
+	}
+	return ""
+}
+
+func (x *Stead) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Stead) GetOkato() string {
+	if x != nil {
+		return x.Okato
+	}
+	return ""
+}
+
+func (x *Stead) GetOktmo() string {
+	if x != nil {
+		return x.Oktmo
+	}
+	return ""
+}
+
+func (x *Stead) GetCadNum() string {
+	if x != nil {
+		return x.CadNum
+	}
+	return ""
+}
+
+func (x *Stead) GetUpdatedDate() string {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return ""
+}
+
+type Health struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uptime               int64   `protobuf:"varint,1,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	AllocatedMemory      float32 `protobuf:"fixed32,2,opt,name=AllocatedMemory,proto3" json:"AllocatedMemory,omitempty"`
+	TotalAllocatedMemory float32 `protobuf:"fixed32,3,opt,name=TotalAllocatedMemory,proto3" json:"TotalAllocatedMemory,omitempty"`
+	Goroutines           int32   `protobuf:"varint,4,opt,name=Goroutines,proto3" json:"Goroutines,omitempty"`
+	GCCycles             uint32  `protobuf:"varint,5,opt,name=GCCycles,proto3" json:"GCCycles,omitempty"`
+	NumberOfCPUs         int32   `protobuf:"varint,6,opt,name=NumberOfCPUs,proto3" json:"NumberOfCPUs,omitempty"`
+	HeapSys              float32 `protobuf:"fixed32,7,opt,name=HeapSys,proto3" json:"HeapSys,omitempty"`
+	HeapAllocated        float32 `protobuf:"fixed32,8,opt,name=HeapAllocated,proto3" json:"HeapAllocated,omitempty"`
+	ObjectsInUse         uint64  `protobuf:"varint,9,opt,name=ObjectsInUse,proto3" json:"ObjectsInUse,omitempty"`
+	OSMemoryObtained     float32 `protobuf:"fixed32,10,opt,name=OSMemoryObtained,proto3" json:"OSMemoryObtained,omitempty"`
+}
+
+func (x *Health) Reset() {
+	*x = Health{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Health) ProtoMessage() {}
+
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Health) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *Health) GetAllocatedMemory() float32 {
+	if x != nil {
+		return x.AllocatedMemory
+	}
+	return 0
+}
+
+func (x *Health) GetTotalAllocatedMemory() float32 {
+	if x != nil {
+		return x.TotalAllocatedMemory
+	}
+	return 0
+}
+
+func (x *Health) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *Health) GetGCCycles() uint32 {
+	if x != nil {
+		return x.GCCycles
+	}
+	return 0
+}
+
+func (x *Health) GetNumberOfCPUs() int32 {
+	if x != nil {
+		return x.NumberOfCPUs
+	}
+	return 0
+}
+
+func (x *Health) GetHeapSys() float32 {
+	if x != nil {
+		return x.HeapSys
+	}
+	return 0
+}
+
+func (x *Health) GetHeapAllocated() float32 {
+	if x != nil {
+		return x.HeapAllocated
+	}
+	return 0
+}
+
+func (x *Health) GetObjectsInUse() uint64 {
+	if x != nil {
+		return x.ObjectsInUse
+	}
+	return 0
+}
+
+func (x *Health) GetOSMemoryObtained() float32 {
+	if x != nil {
+		return x.OSMemoryObtained
+	}
+	return 0
+}
+
+type Version struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerVersion string `protobuf:"bytes,1,opt,name=ServerVersion,proto3" json:"ServerVersion,omitempty"`
+	GrpcVersion   string `protobuf:"bytes,2,opt,name=GrpcVersion,proto3" json:"GrpcVersion,omitempty"`
+	FiasVersion   string `protobuf:"bytes,3,opt,name=FiasVersion,proto3" json:"FiasVersion,omitempty"`
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+func (*Version) Descriptor() ([]byte, []int) {
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Version) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *Version) GetGrpcVersion() string {
+	if x != nil {
+		return x.GrpcVersion
+	}
+	return ""
+}
+
+func (x *Version) GetFiasVersion() string {
+	if x != nil {
+		return x.FiasVersion
+	}
+	return ""
+}
+
+var File_app_interfaces_grpc_proto_v1_fias_fias_proto protoreflect.FileDescriptor
+
+var file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x61, 0x73, 0x2f, 0x66, 0x69, 0x61, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x73,
+	0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x88, 0x01, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x67, 0x75, 0x69, 0x64, 0x12, 0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30,
+	0x32, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72,
+	0x63, 0x68, 0x79, 0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x52, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x22, 0x60, 0x0a, 0x0f, 0x47,
+	0x75, 0x69, 0x64, 0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x75,
+	0x69, 0x64, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x19, 0x44, 0x61, 0x74, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x59, 0x59, 0x59, 0x59, 0x2d, 0x4d, 0x4d, 0x2d, 0x44, 0x44,
+	0xd2, 0x01, 0x04, 0x64, 0x61, 0x74, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd6, 0x01,
+	0x0a, 0x10, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5b, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x18, 0x46, 0x72, 0x65, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x6d,
+	0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x3a,
+	0x21, 0xd0, 0xbc, 0xd1, 0x81, 0xd0, 0xba, 0x2c, 0x20, 0xd1, 0x82, 0xd0, 0xb2, 0xd0, 0xb5, 0xd1,
+	0x80, 0xd1, 0x81, 0xd0, 0xba, 0xd0, 0xb0, 0xd1, 0x8f, 0x20, 0x37, 0x20, 0xd0, 0xba, 0xd0, 0xb2,
+	0x20, 0x35, 0xd2, 0x01, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12,
+	0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x20,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f,
+	0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x68, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x45, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41,
+	0x32, 0x32, 0x2b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x20, 0x69, 0x64, 0x20, 0x28, 0x41, 0x4f, 0x49, 0x44, 0x29, 0x20, 0x6f, 0x72, 0x20, 0x66,
+	0x69, 0x61, 0x73, 0x49, 0x64, 0x20, 0x28, 0x41, 0x4f, 0x47, 0x55, 0x49, 0x44, 0x29, 0xd2, 0x01,
+	0x02, 0x69, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61,
+	0x72, 0x63, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33,
+	0x92, 0x41, 0x30, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x52, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x22, 0xa8,
+	0x01, 0x0a, 0x0b, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x39, 0x92, 0x41,
+	0x36, 0x32, 0x34, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f,
+	0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x2c, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69,
+	0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4a, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x96, 0x03, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x66, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x40, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x66, 0x69, 0x61, 0x73,
+	0x49, 0x64, 0x2c, 0x20, 0x74, 0x6f, 0x70, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0x92, 0x41, 0x18, 0x32, 0x16, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x31, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32,
+	0x13, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x3a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x4a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x65, 0x0a, 0x09, 0x68,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63,
+	0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75,
+	0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63,
+	0x68, 0x79, 0x22, 0x94, 0x03, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32,
+	0x2f, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x2c, 0x20, 0x61,
+	0x6c, 0x6c, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x28, 0x92,
+	0x41, 0x25, 0x32, 0x23, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x2c, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12,
+	0x80, 0x01, 0x0a, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5b, 0x92, 0x41, 0x58, 0x32, 0x56, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x20, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x69, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x59, 0x59,
+	0x59, 0x59, 0x2d, 0x4d, 0x4d, 0x2d, 0x44, 0x44, 0x20, 0x6f, 0x72, 0x20, 0x52, 0x46, 0x43, 0x20,
+	0x33, 0x33, 0x33, 0x39, 0x52, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e,
+	0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68,
+	0x79, 0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09,
+	0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x22, 0xf4, 0x03, 0x0a, 0x15, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x3a,
+	0x07, 0x35, 0x35, 0x2e, 0x37, 0x35, 0x37, 0x35, 0xd2, 0x01, 0x03, 0x6c, 0x61, 0x74, 0x52, 0x03,
+	0x6c, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x3a, 0x07, 0x33, 0x37, 0x2e, 0x36, 0x31, 0x33, 0x36, 0xd2, 0x01, 0x03, 0x6c, 0x6f, 0x6e, 0x52,
+	0x03, 0x6c, 0x6f, 0x6e, 0x12, 0x78, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x60, 0x92, 0x41, 0x5d, 0x32, 0x55, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x20, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x73, 0x20,
+	0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x32, 0x30, 0x20, 0x6b, 0x6d,
+	0x3a, 0x04, 0x31, 0x30, 0x30, 0x30, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x98,
+	0x01, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x81,
+	0x01, 0x92, 0x41, 0x7e, 0x32, 0x7c, 0x4d, 0x6f, 0x73, 0x74, 0x20, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x3a, 0x20, 0x38, 0x20, 0x2d, 0x20, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2c, 0x20, 0x37, 0x2c, 0x20,
+	0x36, 0x35, 0x2c, 0x20, 0x39, 0x30, 0x2c, 0x20, 0x39, 0x31, 0x20, 0x2d, 0x20, 0x73, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x2c, 0x20, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x20, 0x2d, 0x20, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x3b, 0x20,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x65, 0x0a, 0x09, 0x68, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66,
+	0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79,
+	0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x68,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79,
+	0x22, 0xef, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32,
+	0x33, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
+	0x6f, 0x66, 0x20, 0x46, 0x49, 0x41, 0x53, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x20, 0x6e, 0x65, 0x77, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x20, 0x6f, 0x6e, 0x65, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x13, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x04, 0x31, 0x30, 0x30, 0x30,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32,
+	0x26, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x0c, 0xd0, 0x9c, 0xd0, 0xbe, 0xd1, 0x81, 0xd0, 0xba, 0xd0,
+	0xb2, 0xd0, 0xb0, 0xd2, 0x01, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x12, 0x31, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d,
+	0x92, 0x41, 0x1a, 0x32, 0x13, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x20, 0x6f, 0x6e, 0x20, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x1e, 0x92, 0x41, 0x1b, 0x32, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01,
+	0x30, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x22, 0x95, 0x04, 0x0a, 0x11, 0x54, 0x65, 0x72, 0x6d,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25,
+	0x32, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x3a, 0x0c, 0xd0, 0x9c, 0xd0, 0xbe, 0xd1, 0x81, 0xd0, 0xba, 0xd0, 0xb2, 0xd0, 0xb0, 0xd2, 0x01,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x31, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x13,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x6e, 0x20, 0x70,
+	0x61, 0x67, 0x65, 0x3a, 0x03, 0x31, 0x30, 0x30, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x32,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1e, 0x92, 0x41,
+	0x1b, 0x32, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x30, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79,
+	0x3a, 0x20, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x20, 0x6f, 0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x68,
+	0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x12, 0x63, 0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x42, 0x37, 0x92, 0x41, 0x34,
+	0x32, 0x32, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x60, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x3c, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2c, 0x20,
+	0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20,
+	0x66, 0x72, 0x6f, 0x6d, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xe1, 0x01, 0x0a, 0x17, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x92, 0x41, 0x07, 0xd2, 0x01,
+	0x04, 0x74, 0x65, 0x72, 0x6d, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x63,
+	0x0a, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x20,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65,
+	0x64, 0x20, 0x69, 0x66, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41,
+	0x29, 0x32, 0x27, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xca, 0x03, 0x0a, 0x11, 0x4e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x76, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x45, 0x42, 0x65, 0x73, 0x74, 0x20, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x20,
+	0x68, 0x6f, 0x75, 0x73, 0x65, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61, 0x72, 0x65,
+	0x20, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
+	0x66, 0x69, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0x92,
+	0x41, 0x28, 0x32, 0x26, 0x52, 0x6f, 0x6f, 0x6d, 0x20, 0x66, 0x69, 0x61, 0x73, 0x49, 0x64, 0x20,
+	0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x77, 0x61, 0x73, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x0a, 0x72, 0x6f, 0x6f, 0x6d,
+	0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x21, 0x92, 0x41, 0x1e, 0x32,
+	0x1c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63,
+	0x65, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x30, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41,
+	0x2a, 0x32, 0x28, 0x50, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x5e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x2e,
+	0x92, 0x41, 0x2b, 0x32, 0x29, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x73, 0x65, 0x64, 0x22, 0xd1, 0x03, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92,
+	0x41, 0x0d, 0x32, 0x0b, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x52,
+	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32,
+	0x1d, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x20,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x33, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0x92, 0x41, 0x12, 0x32, 0x10, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x09, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0x41,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x6f, 0x66, 0x66, 0x69,
+	0x63, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x92, 0x41,
+	0x19, 0x32, 0x17, 0x43, 0x69, 0x74, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x20, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x22, 0xed, 0x01, 0x0a, 0x16, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x20,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x20, 0x77, 0x61, 0x73, 0x20, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6d, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x45, 0x92, 0x41,
+	0x42, 0x32, 0x40, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x72, 0x6f,
+	0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20,
+	0x6f, 0x6e, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20,
+	0x6f, 0x6e, 0x65, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x0b, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x20, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x66, 0x69, 0x61, 0x73,
+	0x49, 0x64, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x69, 0x64, 0x12, 0x45,
+	0x0a, 0x08, 0x6b, 0x6c, 0x61, 0x64, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x20, 0x6b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x52, 0x07, 0x6b, 0x6c,
+	0x61, 0x64, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x2e, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x42, 0x18, 0x92, 0x41, 0x15, 0x32, 0x13, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x3d, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x20, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x36, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0x50, 0x6f, 0x70, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x70, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x02, 0x42, 0x12, 0x92, 0x41, 0x0f, 0x32, 0x0d, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x15, 0x92, 0x41, 0x12, 0x32, 0x10,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x74, 0x6f,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xb4, 0x0c, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x61,
+	0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69,
+	0x61, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x73,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50,
+	0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75, 0x6c, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x6c, 0x61, 0x64, 0x72,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x61, 0x73, 0x49,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x4b,
+	0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46, 0x75, 0x6c,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x46,
+	0x75, 0x6c, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x72, 0x65, 0x61, 0x46, 0x69, 0x61, 0x73, 0x49,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x72, 0x65, 0x61, 0x46, 0x69, 0x61,
+	0x73, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x72, 0x65, 0x61, 0x4b, 0x6c, 0x61, 0x64, 0x72,
+	0x49, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x72, 0x65, 0x61, 0x4b, 0x6c,
+	0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x65, 0x61, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x65, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x65,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x72, 0x65,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x65, 0x61, 0x46, 0x75, 0x6c,
+	0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x72, 0x65, 0x61, 0x46, 0x75, 0x6c,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x69, 0x74, 0x79, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x69, 0x74, 0x79, 0x46, 0x69, 0x61, 0x73, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x69, 0x74, 0x79, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x69, 0x74, 0x79, 0x4b, 0x6c, 0x61, 0x64,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x69, 0x74, 0x79, 0x46, 0x75, 0x6c, 0x6c, 0x12,
+	0x2a, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61,
+	0x73, 0x49, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x75, 0x6c, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x65, 0x65, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x4b, 0x6c, 0x61, 0x64,
+	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49, 0x64, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4b, 0x6c, 0x61, 0x64, 0x72, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x75,
+	0x6c, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46,
+	0x75, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x61, 0x74, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x65, 0x6f, 0x4c, 0x6f, 0x6e, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x47, 0x65, 0x6f,
+	0x4c, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b, 0x74,
+	0x6d, 0x6f, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x74, 0x6d, 0x6f, 0x12,
+	0x20, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x2e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72, 0x65,
+	0x76, 0x49, 0x64, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x76, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x6f,
+	0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x73, 0x20, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x2c, 0x20, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x69, 0x74, 0x79, 0x52, 0x0c,
+	0x47, 0x65, 0x6f, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xfe, 0x03, 0x0a,
+	0x05, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x75, 0x6c, 0x6c,
+	0x4e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x46, 0x75, 0x6c, 0x6c, 0x4e,
+	0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b,
+	0x74, 0x6d, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x74, 0x6d, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4c,
+	0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x47, 0x65, 0x6f, 0x4c, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65,
+	0x6f, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x38, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x20, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x2c, 0x20, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x69, 0x74, 0x79, 0x52,
+	0x0c, 0x47, 0x65, 0x6f, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd6, 0x01,
+	0x0a, 0x0c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1c, 0x92, 0x41, 0x19,
+	0x32, 0x08, 0x4c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x3a, 0x07, 0x35, 0x35, 0x2e, 0x37,
+	0x35, 0x37, 0x35, 0xd2, 0x01, 0x03, 0x6c, 0x61, 0x74, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x2f,
+	0x0a, 0x03, 0x6c, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1d, 0x92, 0x41, 0x1a,
+	0x32, 0x09, 0x4c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x3a, 0x07, 0x33, 0x37, 0x2e,
+	0x36, 0x31, 0x33, 0x36, 0xd2, 0x01, 0x03, 0x6c, 0x6f, 0x6e, 0x52, 0x03, 0x6c, 0x6f, 0x6e, 0x12,
+	0x65, 0x0a, 0x09, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x20, 0x68, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x3a, 0x20,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x20, 0x6f,
+	0x72, 0x20, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x52, 0x09, 0x68, 0x69, 0x65,
+	0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x22, 0xbf, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64,
+	0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x2c, 0x20, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x2b, 0x92, 0x41, 0x28, 0x32, 0x26, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x63, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x20,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x69,
+	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41,
+	0x29, 0x32, 0x27, 0x4e, 0x65, 0x78, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x2c, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x70, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8b, 0x02, 0x0a, 0x06, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x20, 0x6f, 0x72, 0x20, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x52, 0x0a, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x75, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x0a, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b,
+	0x92, 0x41, 0x38, 0x32, 0x36, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x20, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x2c, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x2c, 0x20, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
+	0x20, 0x6f, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x0a, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x52, 0x6f, 0x6f, 0x6d, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x9e, 0x03, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x61,
+	0x73, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x46, 0x69, 0x61,
+	0x73, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x46, 0x6c, 0x61, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x6c, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x6c, 0x61, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x46,
+	0x75, 0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x46, 0x75,
+	0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75, 0x6c, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6f, 0x73,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75,
+	0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x6f, 0x6f, 0x6d, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x12,
+	0x20, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x39, 0x0a, 0x11, 0x53, 0x74, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x65, 0x61, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xcd, 0x02, 0x0a,
+	0x05, 0x53, 0x74, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x61, 0x73,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x75,
+	0x6c, 0x6c, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x46, 0x75, 0x6c,
+	0x6c, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x6f, 0x73, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x61, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x4f, 0x6b, 0x74, 0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x6b, 0x74,
+	0x6d, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x43, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xee, 0x02, 0x0a,
+	0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x32, 0x0a, 0x14, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a,
+	0x0a, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x47, 0x43, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x47, 0x43, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x50, 0x55, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x43, 0x50, 0x55, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x48, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07,
+	0x48, 0x65, 0x61, 0x70, 0x53, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x70, 0x41,
+	0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x48, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x49, 0x6e, 0x55, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x53, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x62, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x4f, 0x53, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4f, 0x62, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x22, 0x73, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x46, 0x69, 0x61, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x69, 0x61, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x2a, 0x1d, 0x0a, 0x09, 0x48, 0x69, 0x65, 0x72, 0x61, 0x72, 0x63, 0x68, 0x79, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x44, 0x4d, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x55, 0x4e, 0x10,
+	0x01, 0x32, 0x58, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x66, 0x69, 0x61, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x09, 0x12, 0x07, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x32, 0x5a, 0x0a, 0x0e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xd8, 0x0d, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x12,
+	0x1a, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69,
+	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x31, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x3a, 0x01, 0x2a, 0x5a, 0x16, 0x12, 0x14, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x74, 0x65,
+	0x72, 0x6d, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x2f, 0x7b, 0x74, 0x65,
+	0x72, 0x6d, 0x7d, 0x12, 0x53, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x79, 0x47, 0x75, 0x69, 0x64,
+	0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
+	0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x68, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66,
+	0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x63, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x79, 0x47, 0x75, 0x69, 0x64, 0x41,
+	0x74, 0x12, 0x18, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x66, 0x69,
+	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x28, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x74,
+	0x2f, 0x7b, 0x64, 0x61, 0x74, 0x65, 0x7d, 0x12, 0x75, 0x0a, 0x09, 0x4e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2b, 0x22, 0x11, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x5a, 0x13, 0x12, 0x11, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x77,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x43, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x8e, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5a, 0x28, 0x12, 0x26, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x12, 0x62, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x42, 0x79, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x5f, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x66, 0x69,
+	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x30, 0x01, 0x12, 0x57, 0x0a, 0x0c, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x66, 0x69, 0x61, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x75, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x73,
+	0x30, 0x01, 0x12, 0x72, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6f,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x47, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x79, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69,
+	0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x17, 0x12, 0x15, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6f, 0x63, 0x6f,
+	0x64, 0x65, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x7e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x20, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x22, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x5a, 0x12,
+	0x12, 0x10, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x73, 0x32, 0xcc, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x42, 0x79, 0x47,
+	0x75, 0x69, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47, 0x75,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x66, 0x69, 0x61, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x12, 0x13, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x6f, 0x6f, 0x6d, 0x2f, 0x7b,
+	0x67, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x73, 0x42, 0x79, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x47, 0x75, 0x69, 0x64, 0x12, 0x14, 0x2e, 0x66,
+	0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x6f, 0x6f, 0x6d,
+	0x73, 0x32, 0xd7, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x65, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x54, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x65, 0x61, 0x64, 0x42, 0x79,
+	0x47, 0x75, 0x69, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47,
+	0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x66, 0x69, 0x61,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x64, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x2f, 0x7b, 0x67, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x65, 0x61, 0x64, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x47, 0x75,
+	0x69, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x69,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x66, 0x69, 0x61, 0x73, 0x5f,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2f, 0x7b, 0x67,
+	0x75, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x65, 0x61, 0x64, 0x73, 0x42, 0x9d, 0x04, 0x5a, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x61, 0x73, 0x92,
+	0x41, 0xe8, 0x03, 0x12, 0xaa, 0x01, 0x0a, 0x0e, 0x47, 0x6f, 0x46, 0x69, 0x61, 0x73, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x46, 0x69, 0x61, 0x73, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x24, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x65, 0x72, 0x6f, 0x41,
+	0x67, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x67, 0x6f, 0x66, 0x69, 0x61, 0x73, 0x1a, 0x11, 0x67, 0x61,
+	0x72, 0x69, 0x6e, 0x40, 0x61, 0x65, 0x72, 0x6f, 0x69, 0x64, 0x65, 0x61, 0x2e, 0x72, 0x75, 0x2a,
+	0x4a, 0x0a, 0x0b, 0x4d, 0x49, 0x54, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x41, 0x65, 0x72, 0x6f, 0x41, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x67, 0x6f,
+	0x66, 0x69, 0x61, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
+	0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x2e, 0x4d, 0x44, 0x32, 0x03, 0x33, 0x2e, 0x30,
+	0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x52, 0x70, 0x0a, 0x03, 0x34, 0x30, 0x33, 0x12,
+	0x69, 0x0a, 0x47, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e,
+	0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x12, 0x1e, 0x0a, 0x1c, 0x1a, 0x1a,
+	0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x53, 0x0a, 0x03, 0x34, 0x30,
+	0x34, 0x12, 0x4c, 0x0a, 0x2a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x20,
+	0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x2e, 0x12,
+	0x1e, 0x0a, 0x1c, 0x1a, 0x1a, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x4a, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x43, 0x0a, 0x21, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x62, 0x61, 0x64, 0x2e, 0x12, 0x1e, 0x0a, 0x1c, 0x1a,
+	0x1a, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescOnce sync.Once
+	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData = file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDesc
+)
+
+func file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescGZIP() []byte {
+	file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescOnce.Do(func() {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData)
+	})
+	return file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDescData
+}
+
+var file_app_interfaces_grpc_proto_v1_fias_fias_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_app_interfaces_grpc_proto_v1_fias_fias_proto_goTypes = []interface{}{
+	(Hierarchy)(0),                  // 0: fias_v1.Hierarchy
+	(*GuidRequest)(nil),             // 1: fias_v1.GuidRequest
+	(*GuidDateRequest)(nil),         // 2: fias_v1.GuidDateRequest
+	(*NormalizeRequest)(nil),        // 3: fias_v1.NormalizeRequest
+	(*ResolveCurrentRequest)(nil),   // 4: fias_v1.ResolveCurrentRequest
+	(*PageRequest)(nil),             // 5: fias_v1.PageRequest
+	(*ListChildrenRequest)(nil),     // 6: fias_v1.ListChildrenRequest
+	(*ExportRequest)(nil),           // 7: fias_v1.ExportRequest
+	(*ReverseGeocodeRequest)(nil),   // 8: fias_v1.ReverseGeocodeRequest
+	(*ChangesRequest)(nil),          // 9: fias_v1.ChangesRequest
+	(*TermRequest)(nil),             // 10: fias_v1.TermRequest
+	(*TermFilterRequest)(nil),       // 11: fias_v1.TermFilterRequest
+	(*SimpleTermFilterRequest)(nil), // 12: fias_v1.SimpleTermFilterRequest
+	(*AddressListResponse)(nil),     // 13: fias_v1.AddressListResponse
+	(*NormalizeResponse)(nil),       // 14: fias_v1.NormalizeResponse
+	(*ParsedAddress)(nil),           // 15: fias_v1.ParsedAddress
+	(*ResolveCurrentResponse)(nil),  // 16: fias_v1.ResolveCurrentResponse
+	(*FilterObject)(nil),            // 17: fias_v1.FilterObject
+	(*Ranking)(nil),                 // 18: fias_v1.Ranking
+	(*StringFilter)(nil),            // 19: fias_v1.StringFilter
+	(*NumberFilter)(nil),            // 20: fias_v1.NumberFilter
+	(*Address)(nil),                 // 21: fias_v1.Address
+	(*House)(nil),                   // 22: fias_v1.House
+	(*PointRequest)(nil),            // 23: fias_v1.PointRequest
+	(*ReverseGeocodeResponse)(nil),  // 24: fias_v1.ReverseGeocodeResponse
+	(*ChangesResponse)(nil),         // 25: fias_v1.ChangesResponse
+	(*Change)(nil),                  // 26: fias_v1.Change
+	(*RoomListResponse)(nil),        // 27: fias_v1.RoomListResponse
+	(*Room)(nil),                    // 28: fias_v1.Room
+	(*SteadListResponse)(nil),       // 29: fias_v1.SteadListResponse
+	(*Stead)(nil),                   // 30: fias_v1.Stead
+	(*Health)(nil),                  // 31: fias_v1.Health
+	(*Version)(nil),                 // 32: fias_v1.Version
+	(*empty.Empty)(nil),             // 33: google.protobuf.Empty
+}
+var file_app_interfaces_grpc_proto_v1_fias_fias_proto_depIdxs = []int32{
+	0,  // 0: fias_v1.GuidRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	0,  // 1: fias_v1.NormalizeRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	0,  // 2: fias_v1.ResolveCurrentRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	0,  // 3: fias_v1.ListChildrenRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	0,  // 4: fias_v1.ExportRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	0,  // 5: fias_v1.ReverseGeocodeRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	17, // 6: fias_v1.TermFilterRequest.filter:type_name -> fias_v1.FilterObject
+	0,  // 7: fias_v1.TermFilterRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	18, // 8: fias_v1.TermFilterRequest.ranking:type_name -> fias_v1.Ranking
+	17, // 9: fias_v1.SimpleTermFilterRequest.filter:type_name -> fias_v1.FilterObject
+	18, // 10: fias_v1.SimpleTermFilterRequest.ranking:type_name -> fias_v1.Ranking
+	21, // 11: fias_v1.AddressListResponse.items:type_name -> fias_v1.Address
+	21, // 12: fias_v1.NormalizeResponse.address:type_name -> fias_v1.Address
+	15, // 13: fias_v1.NormalizeResponse.parsed:type_name -> fias_v1.ParsedAddress
+	21, // 14: fias_v1.ResolveCurrentResponse.address:type_name -> fias_v1.Address
+	21, // 15: fias_v1.ResolveCurrentResponse.chain:type_name -> fias_v1.Address
+	20, // 16: fias_v1.FilterObject.level:type_name -> fias_v1.NumberFilter
+	19, // 17: fias_v1.FilterObject.parent_guid:type_name -> fias_v1.StringFilter
+	19, // 18: fias_v1.FilterObject.kladr_id:type_name -> fias_v1.StringFilter
+	0,  // 19: fias_v1.PointRequest.hierarchy:type_name -> fias_v1.Hierarchy
+	21, // 20: fias_v1.ReverseGeocodeResponse.address:type_name -> fias_v1.Address
+	22, // 21: fias_v1.ReverseGeocodeResponse.house:type_name -> fias_v1.House
+	26, // 22: fias_v1.ChangesResponse.items:type_name -> fias_v1.Change
+	28, // 23: fias_v1.RoomListResponse.items:type_name -> fias_v1.Room
+	30, // 24: fias_v1.SteadListResponse.items:type_name -> fias_v1.Stead
+	33, // 25: fias_v1.HealthService.CheckHealth:input_type -> google.protobuf.Empty
+	33, // 26: fias_v1.VersionService.GetVersion:input_type -> google.protobuf.Empty
+	11, // 27: fias_v1.AddressService.GetAddressByTerm:input_type -> fias_v1.TermFilterRequest
+	10, // 28: fias_v1.AddressService.GetAddressByPostal:input_type -> fias_v1.TermRequest
+	1,  // 29: fias_v1.AddressService.GetByGuid:input_type -> fias_v1.GuidRequest
+	1,  // 30: fias_v1.AddressService.GetHistory:input_type -> fias_v1.GuidRequest
+	2,  // 31: fias_v1.AddressService.GetByGuidAt:input_type -> fias_v1.GuidDateRequest
+	3,  // 32: fias_v1.AddressService.Normalize:input_type -> fias_v1.NormalizeRequest
+	4,  // 33: fias_v1.AddressService.ResolveCurrent:input_type -> fias_v1.ResolveCurrentRequest
+	5,  // 34: fias_v1.AddressService.GetAllCities:input_type -> fias_v1.PageRequest
+	6,  // 35: fias_v1.AddressService.ListChildren:input_type -> fias_v1.ListChildrenRequest
+	10, // 36: fias_v1.AddressService.GetCitiesByTerm:input_type -> fias_v1.TermRequest
+	7,  // 37: fias_v1.AddressService.ExportAddresses:input_type -> fias_v1.ExportRequest
+	7,  // 38: fias_v1.AddressService.ExportHouses:input_type -> fias_v1.ExportRequest
+	8,  // 39: fias_v1.AddressService.ReverseGeocode:input_type -> fias_v1.ReverseGeocodeRequest
+	23, // 40: fias_v1.AddressService.GetByPoint:input_type -> fias_v1.PointRequest
+	9,  // 41: fias_v1.AddressService.GetChanges:input_type -> fias_v1.ChangesRequest
+	12, // 42: fias_v1.AddressService.GetSuggests:input_type -> fias_v1.SimpleTermFilterRequest
+	1,  // 43: fias_v1.RoomService.GetRoomByGuid:input_type -> fias_v1.GuidRequest
+	1,  // 44: fias_v1.RoomService.GetRoomsByHouseGuid:input_type -> fias_v1.GuidRequest
+	1,  // 45: fias_v1.SteadService.GetSteadByGuid:input_type -> fias_v1.GuidRequest
+	1,  // 46: fias_v1.SteadService.GetSteadsByAddressGuid:input_type -> fias_v1.GuidRequest
+	31, // 47: fias_v1.HealthService.CheckHealth:output_type -> fias_v1.Health
+	32, // 48: fias_v1.VersionService.GetVersion:output_type -> fias_v1.Version
+	13, // 49: fias_v1.AddressService.GetAddressByTerm:output_type -> fias_v1.AddressListResponse
+	13, // 50: fias_v1.AddressService.GetAddressByPostal:output_type -> fias_v1.AddressListResponse
+	21, // 51: fias_v1.AddressService.GetByGuid:output_type -> fias_v1.Address
+	13, // 52: fias_v1.AddressService.GetHistory:output_type -> fias_v1.AddressListResponse
+	21, // 53: fias_v1.AddressService.GetByGuidAt:output_type -> fias_v1.Address
+	14, // 54: fias_v1.AddressService.Normalize:output_type -> fias_v1.NormalizeResponse
+	16, // 55: fias_v1.AddressService.ResolveCurrent:output_type -> fias_v1.ResolveCurrentResponse
+	13, // 56: fias_v1.AddressService.GetAllCities:output_type -> fias_v1.AddressListResponse
+	13, // 57: fias_v1.AddressService.ListChildren:output_type -> fias_v1.AddressListResponse
+	13, // 58: fias_v1.AddressService.GetCitiesByTerm:output_type -> fias_v1.AddressListResponse
+	21, // 59: fias_v1.AddressService.ExportAddresses:output_type -> fias_v1.Address
+	22, // 60: fias_v1.AddressService.ExportHouses:output_type -> fias_v1.House
+	24, // 61: fias_v1.AddressService.ReverseGeocode:output_type -> fias_v1.ReverseGeocodeResponse
+	13, // 62: fias_v1.AddressService.GetByPoint:output_type -> fias_v1.AddressListResponse
+	25, // 63: fias_v1.AddressService.GetChanges:output_type -> fias_v1.ChangesResponse
+	13, // 64: fias_v1.AddressService.GetSuggests:output_type -> fias_v1.AddressListResponse
+	28, // 65: fias_v1.RoomService.GetRoomByGuid:output_type -> fias_v1.Room
+	27, // 66: fias_v1.RoomService.GetRoomsByHouseGuid:output_type -> fias_v1.RoomListResponse
+	30, // 67: fias_v1.SteadService.GetSteadByGuid:output_type -> fias_v1.Stead
+	29, // 68: fias_v1.SteadService.GetSteadsByAddressGuid:output_type -> fias_v1.SteadListResponse
+	47, // [47:69] is the sub-list for method output_type
+	25, // [25:47] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() }
+func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
+	if File_app_interfaces_grpc_proto_v1_fias_fias_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuidDateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCurrentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChildrenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseGeocodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TermFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleTermFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddressListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NormalizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCurrentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterObject); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ranking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StringFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NumberFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*House); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1440,8 +4056,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TermRequest); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1452,8 +4068,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TermFilterRequest); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseGeocodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1464,8 +4080,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleTermFilterRequest); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1476,8 +4092,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddressListResponse); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Change); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1488,8 +4104,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterObject); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1500,8 +4116,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringFilter); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1512,8 +4128,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NumberFilter); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SteadListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1524,8 +4140,8 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1536,7 +4152,7 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Health); i {
 			case 0:
 				return &v.state
@@ -1548,7 +4164,7 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 				return nil
 			}
 		}
-		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Version); i {
 			case 0:
 				return &v.state
@@ -1566,13 +4182,14 @@ func file_app_interfaces_grpc_proto_v1_fias_fias_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_interfaces_grpc_proto_v1_fias_fias_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   32,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   5,
 		},
 		GoTypes:           file_app_interfaces_grpc_proto_v1_fias_fias_proto_goTypes,
 		DependencyIndexes: file_app_interfaces_grpc_proto_v1_fias_fias_proto_depIdxs,
+		EnumInfos:         file_app_interfaces_grpc_proto_v1_fias_fias_proto_enumTypes,
 		MessageInfos:      file_app_interfaces_grpc_proto_v1_fias_fias_proto_msgTypes,
 	}.Build()
 	File_app_interfaces_grpc_proto_v1_fias_fias_proto = out.File
@@ -1740,8 +4357,18 @@ type AddressServiceClient interface {
 	GetAddressByTerm(ctx context.Context, in *TermFilterRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
 	GetAddressByPostal(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
 	GetByGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*Address, error)
-	GetAllCities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AddressListResponse, error)
+	GetHistory(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
+	GetByGuidAt(ctx context.Context, in *GuidDateRequest, opts ...grpc.CallOption) (*Address, error)
+	Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error)
+	ResolveCurrent(ctx context.Context, in *ResolveCurrentRequest, opts ...grpc.CallOption) (*ResolveCurrentResponse, error)
+	GetAllCities(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
+	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
 	GetCitiesByTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
+	ExportAddresses(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (AddressService_ExportAddressesClient, error)
+	ExportHouses(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (AddressService_ExportHousesClient, error)
+	ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error)
+	GetByPoint(ctx context.Context, in *PointRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
+	GetChanges(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (*ChangesResponse, error)
 	GetSuggests(ctx context.Context, in *SimpleTermFilterRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
 }
 
@@ -1780,7 +4407,43 @@ func (c *addressServiceClient) GetByGuid(ctx context.Context, in *GuidRequest, o
 	return out, nil
 }
 
-func (c *addressServiceClient) GetAllCities(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AddressListResponse, error) {
+func (c *addressServiceClient) GetHistory(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
+	out := new(AddressListResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) GetByGuidAt(ctx context.Context, in *GuidDateRequest, opts ...grpc.CallOption) (*Address, error) {
+	out := new(Address)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetByGuidAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) Normalize(ctx context.Context, in *NormalizeRequest, opts ...grpc.CallOption) (*NormalizeResponse, error) {
+	out := new(NormalizeResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/Normalize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) ResolveCurrent(ctx context.Context, in *ResolveCurrentRequest, opts ...grpc.CallOption) (*ResolveCurrentResponse, error) {
+	out := new(ResolveCurrentResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/ResolveCurrent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) GetAllCities(ctx context.Context, in *PageRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
 	out := new(AddressListResponse)
 	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetAllCities", in, out, opts...)
 	if err != nil {
@@ -1789,6 +4452,15 @@ func (c *addressServiceClient) GetAllCities(ctx context.Context, in *empty.Empty
 	return out, nil
 }
 
+func (c *addressServiceClient) ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
+	out := new(AddressListResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/ListChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *addressServiceClient) GetCitiesByTerm(ctx context.Context, in *TermRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
 	out := new(AddressListResponse)
 	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetCitiesByTerm", in, out, opts...)
@@ -1798,6 +4470,97 @@ func (c *addressServiceClient) GetCitiesByTerm(ctx context.Context, in *TermRequ
 	return out, nil
 }
 
+func (c *addressServiceClient) ExportAddresses(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (AddressService_ExportAddressesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AddressService_serviceDesc.Streams[0], "/fias_v1.AddressService/ExportAddresses", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &addressServiceExportAddressesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AddressService_ExportAddressesClient interface {
+	Recv() (*Address, error)
+	grpc.ClientStream
+}
+
+type addressServiceExportAddressesClient struct {
+	grpc.ClientStream
+}
+
+func (x *addressServiceExportAddressesClient) Recv() (*Address, error) {
+	m := new(Address)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *addressServiceClient) ExportHouses(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (AddressService_ExportHousesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AddressService_serviceDesc.Streams[1], "/fias_v1.AddressService/ExportHouses", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &addressServiceExportHousesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AddressService_ExportHousesClient interface {
+	Recv() (*House, error)
+	grpc.ClientStream
+}
+
+type addressServiceExportHousesClient struct {
+	grpc.ClientStream
+}
+
+func (x *addressServiceExportHousesClient) Recv() (*House, error) {
+	m := new(House)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *addressServiceClient) ReverseGeocode(ctx context.Context, in *ReverseGeocodeRequest, opts ...grpc.CallOption) (*ReverseGeocodeResponse, error) {
+	out := new(ReverseGeocodeResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/ReverseGeocode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) GetByPoint(ctx context.Context, in *PointRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
+	out := new(AddressListResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetByPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressServiceClient) GetChanges(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (*ChangesResponse, error) {
+	out := new(ChangesResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *addressServiceClient) GetSuggests(ctx context.Context, in *SimpleTermFilterRequest, opts ...grpc.CallOption) (*AddressListResponse, error) {
 	out := new(AddressListResponse)
 	err := c.cc.Invoke(ctx, "/fias_v1.AddressService/GetSuggests", in, out, opts...)
@@ -1812,8 +4575,18 @@ type AddressServiceServer interface {
 	GetAddressByTerm(context.Context, *TermFilterRequest) (*AddressListResponse, error)
 	GetAddressByPostal(context.Context, *TermRequest) (*AddressListResponse, error)
 	GetByGuid(context.Context, *GuidRequest) (*Address, error)
-	GetAllCities(context.Context, *empty.Empty) (*AddressListResponse, error)
+	GetHistory(context.Context, *GuidRequest) (*AddressListResponse, error)
+	GetByGuidAt(context.Context, *GuidDateRequest) (*Address, error)
+	Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error)
+	ResolveCurrent(context.Context, *ResolveCurrentRequest) (*ResolveCurrentResponse, error)
+	GetAllCities(context.Context, *PageRequest) (*AddressListResponse, error)
+	ListChildren(context.Context, *ListChildrenRequest) (*AddressListResponse, error)
 	GetCitiesByTerm(context.Context, *TermRequest) (*AddressListResponse, error)
+	ExportAddresses(*ExportRequest, AddressService_ExportAddressesServer) error
+	ExportHouses(*ExportRequest, AddressService_ExportHousesServer) error
+	ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error)
+	GetByPoint(context.Context, *PointRequest) (*AddressListResponse, error)
+	GetChanges(context.Context, *ChangesRequest) (*ChangesResponse, error)
 	GetSuggests(context.Context, *SimpleTermFilterRequest) (*AddressListResponse, error)
 }
 
@@ -1830,76 +4603,178 @@ func (*UnimplementedAddressServiceServer) GetAddressByPostal(context.Context, *T
 func (*UnimplementedAddressServiceServer) GetByGuid(context.Context, *GuidRequest) (*Address, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByGuid not implemented")
 }
-func (*UnimplementedAddressServiceServer) GetAllCities(context.Context, *empty.Empty) (*AddressListResponse, error) {
+func (*UnimplementedAddressServiceServer) GetHistory(context.Context, *GuidRequest) (*AddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistory not implemented")
+}
+func (*UnimplementedAddressServiceServer) GetByGuidAt(context.Context, *GuidDateRequest) (*Address, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByGuidAt not implemented")
+}
+func (*UnimplementedAddressServiceServer) Normalize(context.Context, *NormalizeRequest) (*NormalizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Normalize not implemented")
+}
+func (*UnimplementedAddressServiceServer) ResolveCurrent(context.Context, *ResolveCurrentRequest) (*ResolveCurrentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCurrent not implemented")
+}
+func (*UnimplementedAddressServiceServer) GetAllCities(context.Context, *PageRequest) (*AddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllCities not implemented")
 }
+func (*UnimplementedAddressServiceServer) ListChildren(context.Context, *ListChildrenRequest) (*AddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
 func (*UnimplementedAddressServiceServer) GetCitiesByTerm(context.Context, *TermRequest) (*AddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCitiesByTerm not implemented")
 }
+func (*UnimplementedAddressServiceServer) ExportAddresses(*ExportRequest, AddressService_ExportAddressesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAddresses not implemented")
+}
+func (*UnimplementedAddressServiceServer) ExportHouses(*ExportRequest, AddressService_ExportHousesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportHouses not implemented")
+}
+func (*UnimplementedAddressServiceServer) ReverseGeocode(context.Context, *ReverseGeocodeRequest) (*ReverseGeocodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseGeocode not implemented")
+}
+func (*UnimplementedAddressServiceServer) GetByPoint(context.Context, *PointRequest) (*AddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByPoint not implemented")
+}
+func (*UnimplementedAddressServiceServer) GetChanges(context.Context, *ChangesRequest) (*ChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChanges not implemented")
+}
 func (*UnimplementedAddressServiceServer) GetSuggests(context.Context, *SimpleTermFilterRequest) (*AddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSuggests not implemented")
 }
 
-func RegisterAddressServiceServer(s *grpc.Server, srv AddressServiceServer) {
-	s.RegisterService(&_AddressService_serviceDesc, srv)
+func RegisterAddressServiceServer(s *grpc.Server, srv AddressServiceServer) {
+	s.RegisterService(&_AddressService_serviceDesc, srv)
+}
+
+func _AddressService_GetAddressByTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetAddressByTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetAddressByTerm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetAddressByTerm(ctx, req.(*TermFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetAddressByPostal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetAddressByPostal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetAddressByPostal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetAddressByPostal(ctx, req.(*TermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetByGuid(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetHistory(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AddressService_GetAddressByTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermFilterRequest)
+func _AddressService_GetByGuidAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AddressServiceServer).GetAddressByTerm(ctx, in)
+		return srv.(AddressServiceServer).GetByGuidAt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fias_v1.AddressService/GetAddressByTerm",
+		FullMethod: "/fias_v1.AddressService/GetByGuidAt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AddressServiceServer).GetAddressByTerm(ctx, req.(*TermFilterRequest))
+		return srv.(AddressServiceServer).GetByGuidAt(ctx, req.(*GuidDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AddressService_GetAddressByPostal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TermRequest)
+func _AddressService_Normalize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NormalizeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AddressServiceServer).GetAddressByPostal(ctx, in)
+		return srv.(AddressServiceServer).Normalize(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fias_v1.AddressService/GetAddressByPostal",
+		FullMethod: "/fias_v1.AddressService/Normalize",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AddressServiceServer).GetAddressByPostal(ctx, req.(*TermRequest))
+		return srv.(AddressServiceServer).Normalize(ctx, req.(*NormalizeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AddressService_GetByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GuidRequest)
+func _AddressService_ResolveCurrent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCurrentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AddressServiceServer).GetByGuid(ctx, in)
+		return srv.(AddressServiceServer).ResolveCurrent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/fias_v1.AddressService/GetByGuid",
+		FullMethod: "/fias_v1.AddressService/ResolveCurrent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AddressServiceServer).GetByGuid(ctx, req.(*GuidRequest))
+		return srv.(AddressServiceServer).ResolveCurrent(ctx, req.(*ResolveCurrentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AddressService_GetAllCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+	in := new(PageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1911,7 +4786,25 @@ func _AddressService_GetAllCities_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/fias_v1.AddressService/GetAllCities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AddressServiceServer).GetAllCities(ctx, req.(*empty.Empty))
+		return srv.(AddressServiceServer).GetAllCities(ctx, req.(*PageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/ListChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).ListChildren(ctx, req.(*ListChildrenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1934,6 +4827,102 @@ func _AddressService_GetCitiesByTerm_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AddressService_ExportAddresses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AddressServiceServer).ExportAddresses(m, &addressServiceExportAddressesServer{stream})
+}
+
+type AddressService_ExportAddressesServer interface {
+	Send(*Address) error
+	grpc.ServerStream
+}
+
+type addressServiceExportAddressesServer struct {
+	grpc.ServerStream
+}
+
+func (x *addressServiceExportAddressesServer) Send(m *Address) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AddressService_ExportHouses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AddressServiceServer).ExportHouses(m, &addressServiceExportHousesServer{stream})
+}
+
+type AddressService_ExportHousesServer interface {
+	Send(*House) error
+	grpc.ServerStream
+}
+
+type addressServiceExportHousesServer struct {
+	grpc.ServerStream
+}
+
+func (x *addressServiceExportHousesServer) Send(m *House) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AddressService_ReverseGeocode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseGeocodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).ReverseGeocode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/ReverseGeocode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).ReverseGeocode(ctx, req.(*ReverseGeocodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetByPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetByPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetByPoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetByPoint(ctx, req.(*PointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressService_GetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressServiceServer).GetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.AddressService/GetChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressServiceServer).GetChanges(ctx, req.(*ChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AddressService_GetSuggests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SimpleTermFilterRequest)
 	if err := dec(in); err != nil {
@@ -1968,19 +4957,278 @@ var _AddressService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetByGuid",
 			Handler:    _AddressService_GetByGuid_Handler,
 		},
+		{
+			MethodName: "GetHistory",
+			Handler:    _AddressService_GetHistory_Handler,
+		},
+		{
+			MethodName: "GetByGuidAt",
+			Handler:    _AddressService_GetByGuidAt_Handler,
+		},
+		{
+			MethodName: "Normalize",
+			Handler:    _AddressService_Normalize_Handler,
+		},
+		{
+			MethodName: "ResolveCurrent",
+			Handler:    _AddressService_ResolveCurrent_Handler,
+		},
 		{
 			MethodName: "GetAllCities",
 			Handler:    _AddressService_GetAllCities_Handler,
 		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _AddressService_ListChildren_Handler,
+		},
 		{
 			MethodName: "GetCitiesByTerm",
 			Handler:    _AddressService_GetCitiesByTerm_Handler,
 		},
+		{
+			MethodName: "ReverseGeocode",
+			Handler:    _AddressService_ReverseGeocode_Handler,
+		},
+		{
+			MethodName: "GetByPoint",
+			Handler:    _AddressService_GetByPoint_Handler,
+		},
+		{
+			MethodName: "GetChanges",
+			Handler:    _AddressService_GetChanges_Handler,
+		},
 		{
 			MethodName: "GetSuggests",
 			Handler:    _AddressService_GetSuggests_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAddresses",
+			Handler:       _AddressService_ExportAddresses_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportHouses",
+			Handler:       _AddressService_ExportHouses_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "app/interfaces/grpc/proto/v1/fias/fias.proto",
+}
+
+// RoomServiceClient is the client API for RoomService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RoomServiceClient interface {
+	GetRoomByGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*Room, error)
+	GetRoomsByHouseGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*RoomListResponse, error)
+}
+
+type roomServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRoomServiceClient(cc grpc.ClientConnInterface) RoomServiceClient {
+	return &roomServiceClient{cc}
+}
+
+func (c *roomServiceClient) GetRoomByGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*Room, error) {
+	out := new(Room)
+	err := c.cc.Invoke(ctx, "/fias_v1.RoomService/GetRoomByGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roomServiceClient) GetRoomsByHouseGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*RoomListResponse, error) {
+	out := new(RoomListResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.RoomService/GetRoomsByHouseGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RoomServiceServer is the server API for RoomService service.
+type RoomServiceServer interface {
+	GetRoomByGuid(context.Context, *GuidRequest) (*Room, error)
+	GetRoomsByHouseGuid(context.Context, *GuidRequest) (*RoomListResponse, error)
+}
+
+// UnimplementedRoomServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRoomServiceServer struct {
+}
+
+func (*UnimplementedRoomServiceServer) GetRoomByGuid(context.Context, *GuidRequest) (*Room, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomByGuid not implemented")
+}
+func (*UnimplementedRoomServiceServer) GetRoomsByHouseGuid(context.Context, *GuidRequest) (*RoomListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoomsByHouseGuid not implemented")
+}
+
+func RegisterRoomServiceServer(s *grpc.Server, srv RoomServiceServer) {
+	s.RegisterService(&_RoomService_serviceDesc, srv)
+}
+
+func _RoomService_GetRoomByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetRoomByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.RoomService/GetRoomByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetRoomByGuid(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoomService_GetRoomsByHouseGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoomServiceServer).GetRoomsByHouseGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.RoomService/GetRoomsByHouseGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoomServiceServer).GetRoomsByHouseGuid(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RoomService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fias_v1.RoomService",
+	HandlerType: (*RoomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoomByGuid",
+			Handler:    _RoomService_GetRoomByGuid_Handler,
+		},
+		{
+			MethodName: "GetRoomsByHouseGuid",
+			Handler:    _RoomService_GetRoomsByHouseGuid_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "app/interfaces/grpc/proto/v1/fias/fias.proto",
+}
+
+// SteadServiceClient is the client API for SteadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SteadServiceClient interface {
+	GetSteadByGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*Stead, error)
+	GetSteadsByAddressGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*SteadListResponse, error)
+}
+
+type steadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSteadServiceClient(cc grpc.ClientConnInterface) SteadServiceClient {
+	return &steadServiceClient{cc}
+}
+
+func (c *steadServiceClient) GetSteadByGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*Stead, error) {
+	out := new(Stead)
+	err := c.cc.Invoke(ctx, "/fias_v1.SteadService/GetSteadByGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *steadServiceClient) GetSteadsByAddressGuid(ctx context.Context, in *GuidRequest, opts ...grpc.CallOption) (*SteadListResponse, error) {
+	out := new(SteadListResponse)
+	err := c.cc.Invoke(ctx, "/fias_v1.SteadService/GetSteadsByAddressGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SteadServiceServer is the server API for SteadService service.
+type SteadServiceServer interface {
+	GetSteadByGuid(context.Context, *GuidRequest) (*Stead, error)
+	GetSteadsByAddressGuid(context.Context, *GuidRequest) (*SteadListResponse, error)
+}
+
+// UnimplementedSteadServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSteadServiceServer struct {
+}
+
+func (*UnimplementedSteadServiceServer) GetSteadByGuid(context.Context, *GuidRequest) (*Stead, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSteadByGuid not implemented")
+}
+func (*UnimplementedSteadServiceServer) GetSteadsByAddressGuid(context.Context, *GuidRequest) (*SteadListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSteadsByAddressGuid not implemented")
+}
+
+func RegisterSteadServiceServer(s *grpc.Server, srv SteadServiceServer) {
+	s.RegisterService(&_SteadService_serviceDesc, srv)
+}
+
+func _SteadService_GetSteadByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteadServiceServer).GetSteadByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.SteadService/GetSteadByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteadServiceServer).GetSteadByGuid(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SteadService_GetSteadsByAddressGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SteadServiceServer).GetSteadsByAddressGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fias_v1.SteadService/GetSteadsByAddressGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SteadServiceServer).GetSteadsByAddressGuid(ctx, req.(*GuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SteadService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "fias_v1.SteadService",
+	HandlerType: (*SteadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSteadByGuid",
+			Handler:    _SteadService_GetSteadByGuid_Handler,
+		},
+		{
+			MethodName: "GetSteadsByAddressGuid",
+			Handler:    _SteadService_GetSteadsByAddressGuid_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "app/interfaces/grpc/proto/v1/fias/fias.proto",
 }
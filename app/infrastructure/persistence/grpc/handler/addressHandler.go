@@ -5,26 +5,36 @@ import (
 	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/service"
 	fiasV1 "github.com/GarinAG/gofias/infrastructure/persistence/grpc/dto/v1/fias"
-	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // GRPC-обработчик адресов
 type AddressHandler struct {
-	Server         *grpc.Server            // GRPC-сервер
-	addressService *service.AddressService // Сервис адресов
-	houseService   *service.HouseService   // Сервис домов
+	Server           *grpc.Server                   // GRPC-сервер
+	addressService   *service.AddressService        // Сервис адресов
+	houseService     *service.HouseService          // Сервис домов
+	historyService   *service.AddressHistoryService // Сервис истории адресов
+	normalizeService *service.NormalizeService      // Сервис нормализации адресов
+	exportService    *service.ExportService         // Сервис выгрузки адресов и домов
+	changeLogService *service.ChangeLogService      // Сервис журнала изменений
+	geocodeService   *service.GeocodeService        // Сервис обратного геокодирования
 }
 
 // Инициализация обработчика
-func NewAddressHandler(a *service.AddressService, h *service.HouseService) *AddressHandler {
+func NewAddressHandler(a *service.AddressService, h *service.HouseService, history *service.AddressHistoryService, normalize *service.NormalizeService, export *service.ExportService, changeLog *service.ChangeLogService, geocode *service.GeocodeService) *AddressHandler {
 	handler := &AddressHandler{
-		addressService: a,
-		houseService:   h,
+		addressService:   a,
+		houseService:     h,
+		historyService:   history,
+		normalizeService: normalize,
+		exportService:    export,
+		changeLogService: changeLog,
+		geocodeService:   geocode,
 	}
 
 	return handler
@@ -44,9 +54,26 @@ func (h *AddressHandler) GetAddressByTerm(ctx context.Context, request *fiasV1.T
 	if request.Term == "" {
 		return nil, status.Error(codes.InvalidArgument, "term is required")
 	}
+	hierarchy := h.prepareHierarchy(request.Hierarchy)
 	filters := h.prepareFilter(request.Filter)
-	cities := h.addressService.GetAddressByTerm(request.Term, request.Size, request.From, filters...)
-	return h.prepareList(cities)
+	filters[0].Hierarchy = hierarchy
+	if request.From > 0 {
+		// Постраничная выборка по смещению сохранена для совместимости
+		cities := h.addressService.GetRankedAddressByTerm(request.Term, request.Size, request.From, h.prepareRanking(request.Ranking), filters...)
+		for _, city := range cities {
+			city.ApplyHierarchy(hierarchy)
+		}
+		return h.prepareList(cities)
+	}
+	cursor, err := h.prepareCursor(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	cities, next := h.addressService.GetAddressByTermPage(request.Term, request.Size, cursor, h.prepareRanking(request.Ranking), filters...)
+	for _, city := range cities {
+		city.ApplyHierarchy(hierarchy)
+	}
+	return h.preparePage(cities, next)
 }
 
 // Найти адрес по почтовому индексу
@@ -59,9 +86,31 @@ func (h *AddressHandler) GetAddressByPostal(ctx context.Context, request *fiasV1
 }
 
 // Получить список всех городов
-func (h *AddressHandler) GetAllCities(ctx context.Context, empty *empty.Empty) (*fiasV1.AddressListResponse, error) {
-	cities := h.addressService.GetCities()
-	return h.prepareList(cities)
+func (h *AddressHandler) GetAllCities(ctx context.Context, request *fiasV1.PageRequest) (*fiasV1.AddressListResponse, error) {
+	if request.Size == 0 && request.PageToken == "" {
+		cities := h.addressService.GetCities()
+		return h.prepareList(cities)
+	}
+	cursor, err := h.prepareCursor(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	cities, next := h.addressService.GetCitiesPage(request.Size, cursor)
+	return h.preparePage(cities, next)
+}
+
+// Получить страницу дочерних адресов объекта
+func (h *AddressHandler) ListChildren(ctx context.Context, request *fiasV1.ListChildrenRequest) (*fiasV1.AddressListResponse, error) {
+	cursor, err := h.prepareCursor(request.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	hierarchy := h.prepareHierarchy(request.Hierarchy)
+	items, next := h.addressService.GetChildren(request.ParentGuid, int(request.Level), hierarchy, request.Size, cursor)
+	for _, item := range items {
+		item.ApplyHierarchy(hierarchy)
+	}
+	return h.preparePage(items, next)
 }
 
 // Найти адрес по GUID
@@ -71,12 +120,132 @@ func (h *AddressHandler) GetByGuid(ctx context.Context, guid *fiasV1.GuidRequest
 	}
 	addr := h.addressService.GetByGuid(guid.Guid)
 	if addr != nil {
+		addr.ApplyHierarchy(h.prepareHierarchy(guid.Hierarchy))
+		h.addressService.FillLocations(addr)
 		return h.convertToAddress(addr), nil
 	}
 
 	return nil, status.Error(codes.NotFound, "address not found")
 }
 
+// Получить все версии адреса по GUID
+func (h *AddressHandler) GetHistory(ctx context.Context, guid *fiasV1.GuidRequest) (*fiasV1.AddressListResponse, error) {
+	if !h.historyService.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "address history is disabled")
+	}
+	if guid.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	history := h.historyService.GetHistory(guid.Guid)
+	if len(history) == 0 {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+
+	return h.prepareList(history)
+}
+
+// Найти версию адреса, действовавшую на указанную дату
+func (h *AddressHandler) GetByGuidAt(ctx context.Context, request *fiasV1.GuidDateRequest) (*fiasV1.Address, error) {
+	if !h.historyService.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "address history is disabled")
+	}
+	if request.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	date, err := time.Parse("2006-01-02", request.Date)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "date must be in format YYYY-MM-DD")
+	}
+	addr := h.historyService.GetByGuidAt(request.Guid, date)
+	if addr == nil {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	h.addressService.FillLocations(addr)
+
+	return h.convertToAddress(addr), nil
+}
+
+// Найти актуальный адрес по устаревшему идентификатору записи или GUID
+func (h *AddressHandler) ResolveCurrent(ctx context.Context, request *fiasV1.ResolveCurrentRequest) (*fiasV1.ResolveCurrentResponse, error) {
+	if request.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	hierarchy := h.prepareHierarchy(request.Hierarchy)
+	// Возвращает адрес без цепочки замен, если передан актуальный GUID
+	if addr := h.addressService.GetByGuid(request.Id); addr != nil {
+		addr.ApplyHierarchy(hierarchy)
+		return &fiasV1.ResolveCurrentResponse{Address: h.convertToAddress(addr)}, nil
+	}
+	chain := h.historyService.ResolveChain(request.Id)
+	if len(chain) == 0 {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+
+	response := fiasV1.ResolveCurrentResponse{}
+	for _, item := range chain {
+		response.Chain = append(response.Chain, h.convertToAddress(item))
+	}
+	// Получает актуальный адрес по GUID последней версии в цепочке
+	if addr := h.addressService.GetByGuid(chain[len(chain)-1].AoGuid); addr != nil {
+		addr.ApplyHierarchy(hierarchy)
+		response.Address = h.convertToAddress(addr)
+	}
+
+	return &response, nil
+}
+
+// Найти ближайший к точке дом, улицу или населенный пункт
+func (h *AddressHandler) ReverseGeocode(ctx context.Context, request *fiasV1.ReverseGeocodeRequest) (*fiasV1.ReverseGeocodeResponse, error) {
+	if request.Lat == 0 && request.Lon == 0 {
+		return nil, status.Error(codes.InvalidArgument, "lat and lon are required")
+	}
+	if request.Lat < -90 || request.Lat > 90 || request.Lon < -180 || request.Lon > 180 {
+		return nil, status.Error(codes.InvalidArgument, "invalid coordinates")
+	}
+	result := h.geocodeService.ReverseGeocode(request.Lat, request.Lon, int(request.Radius), int(request.Level))
+	if result == nil {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+
+	response := fiasV1.ReverseGeocodeResponse{
+		Precision: result.Precision,
+		Distance:  float32(result.Distance),
+	}
+	if result.Address != nil {
+		result.Address.ApplyHierarchy(h.prepareHierarchy(request.Hierarchy))
+		response.Address = h.convertToAddress(result.Address)
+	}
+	if result.House != nil {
+		h.addressService.FillHouseLocations(result.House)
+		response.House = h.convertToHouse(result.House)
+	}
+
+	return &response, nil
+}
+
+// Найти административные объекты, границы которых содержат точку
+func (h *AddressHandler) GetByPoint(ctx context.Context, request *fiasV1.PointRequest) (*fiasV1.AddressListResponse, error) {
+	if request.Lat == 0 && request.Lon == 0 {
+		return nil, status.Error(codes.InvalidArgument, "lat and lon are required")
+	}
+	if request.Lat < -90 || request.Lat > 90 || request.Lon < -180 || request.Lon > 180 {
+		return nil, status.Error(codes.InvalidArgument, "invalid coordinates")
+	}
+	if !h.geocodeService.BoundariesEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "boundaries are disabled")
+	}
+	items := h.geocodeService.GetByPoint(request.Lat, request.Lon)
+	if len(items) == 0 {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	hierarchy := h.prepareHierarchy(request.Hierarchy)
+	for _, item := range items {
+		item.ApplyHierarchy(hierarchy)
+	}
+
+	return h.prepareList(items)
+}
+
 // Найти адрес по подстроке
 func (h *AddressHandler) GetSuggests(ctx context.Context, request *fiasV1.SimpleTermFilterRequest) (*fiasV1.AddressListResponse, error) {
 	if request.Term == "" {
@@ -89,15 +258,16 @@ func (h *AddressHandler) GetSuggests(ctx context.Context, request *fiasV1.Simple
 		size = 100
 	}
 	filters := h.prepareFilter(request.Filter)
+	ranking := h.prepareRanking(request.Ranking)
 
 	// Получает адреса по подсроке
-	suggests := h.addressService.GetAddressByTerm(request.Term, size, 0, filters...)
+	suggests := h.addressService.GetRankedAddressByTerm(request.Term, size, 0, ranking, filters...)
 	houseNum = size - int64(len(suggests))
 	// Проверка на необходимость загрузки домов
 	if houseNum > 0 {
 		cities := make(map[string]*entity.AddressObject, houseNum)
 		// Получает дома по подсроке
-		houses := h.houseService.GetAddressByTerm(request.Term, houseNum, 0, filters...)
+		houses := h.houseService.GetRankedAddressByTerm(request.Term, houseNum, 0, ranking, filters...)
 		for _, house := range houses {
 			// Ищет информацию об адресе дома в кэше
 			city, ok := cities[house.AoGuid]
@@ -111,31 +281,157 @@ func (h *AddressHandler) GetSuggests(ctx context.Context, request *fiasV1.Simple
 				cities[house.AoGuid] = city
 			}
 
-			// Формирует объект адреса
-			city.ID = house.ID
-			city.AoGuid = house.HouseGuid
-			city.ParentGuid = house.AoGuid
-			city.FormalName = house.HouseFullNum
-			city.ShortName = ""
-			city.AoLevel = 8
-			city.OffName = house.HouseFullNum
-			city.PostalCode = house.PostalCode
-			city.Okato = house.Okato
-			city.Oktmo = house.Oktmo
-			city.StartDate = house.StartDate
-			city.EndDate = house.EndDate
-			city.UpdateDate = house.UpdateDate
-			city.FullName = house.HouseFullNum
-			city.FullAddress = house.FullAddress
-			city.BazisUpdateDate = house.BazisUpdateDate
-
-			suggests = append(suggests, city)
+			suggests = append(suggests, h.houseToAddress(city, house))
 		}
 	}
 
 	return h.prepareList(suggests)
 }
 
+// Нормализовать строку адреса
+func (h *AddressHandler) Normalize(ctx context.Context, request *fiasV1.NormalizeRequest) (*fiasV1.NormalizeResponse, error) {
+	if request.Term == "" {
+		return nil, status.Error(codes.InvalidArgument, "term is required")
+	}
+	result := h.normalizeService.Normalize(request.Term)
+	if result.Address == nil {
+		return nil, status.Error(codes.NotFound, "address not found")
+	}
+	addr := result.Address
+	addr.ApplyHierarchy(h.prepareHierarchy(request.Hierarchy))
+	if result.House != nil {
+		addr = h.houseToAddress(addr, result.House)
+	}
+	h.addressService.FillLocations(addr)
+	response := fiasV1.NormalizeResponse{
+		Address:    h.convertToAddress(addr),
+		Confidence: float32(result.Confidence),
+		Remainder:  result.Remainder,
+		Parsed: &fiasV1.ParsedAddress{
+			PostalCode: result.Parsed.PostalCode,
+			Region:     result.Parsed.Region,
+			City:       result.Parsed.City,
+			Street:     result.Parsed.Street,
+			Names:      result.Parsed.Names,
+			House:      result.Parsed.House,
+			Building:   result.Parsed.Building,
+			Structure:  result.Parsed.Structure,
+			Apartment:  result.Parsed.Apartment,
+		},
+	}
+	if result.Room != nil {
+		response.RoomFiasId = result.Room.RoomGuid
+	}
+
+	return &response, nil
+}
+
+// Формирует объект адреса дома по адресу его улицы или населенного пункта
+func (h *AddressHandler) houseToAddress(city *entity.AddressObject, house *entity.HouseObject) *entity.AddressObject {
+	addr := *city
+	addr.ID = house.ID
+	addr.AoGuid = house.HouseGuid
+	addr.ParentGuid = house.AoGuid
+	addr.FormalName = house.HouseFullNum
+	addr.ShortName = ""
+	addr.AoLevel = 8
+	addr.OffName = house.HouseFullNum
+	addr.PostalCode = house.PostalCode
+	addr.Okato = house.Okato
+	addr.Oktmo = house.Oktmo
+	addr.StartDate = house.StartDate
+	addr.EndDate = house.EndDate
+	addr.UpdateDate = house.UpdateDate
+	addr.FullName = house.HouseFullNum
+	addr.FullAddress = house.FullAddress
+	addr.BazisUpdateDate = house.BazisUpdateDate
+	addr.Location = house.Location
+
+	return &addr
+}
+
+// Выгрузить адреса по фильтру
+func (h *AddressHandler) ExportAddresses(request *fiasV1.ExportRequest, stream fiasV1.AddressService_ExportAddressesServer) error {
+	filter, err := h.prepareExportFilter(request)
+	if err != nil {
+		return err
+	}
+	hierarchy := h.prepareHierarchy(request.Hierarchy)
+
+	return h.exportService.ExportAddresses(filter, func(items []*entity.AddressObject) error {
+		for _, item := range items {
+			item.ApplyHierarchy(hierarchy)
+			if err := stream.Send(h.convertToAddress(item)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Выгрузить дома по фильтру
+func (h *AddressHandler) ExportHouses(request *fiasV1.ExportRequest, stream fiasV1.AddressService_ExportHousesServer) error {
+	filter, err := h.prepareExportFilter(request)
+	if err != nil {
+		return err
+	}
+
+	return h.exportService.ExportHouses(filter, func(items []*entity.HouseObject) error {
+		h.addressService.FillHouseLocations(items...)
+		for _, item := range items {
+			if err := stream.Send(h.convertToHouse(item)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Получить изменения адресов и домов в версиях после указанной
+func (h *AddressHandler) GetChanges(ctx context.Context, request *fiasV1.ChangesRequest) (*fiasV1.ChangesResponse, error) {
+	if !h.changeLogService.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "change log is disabled")
+	}
+	// Токен страницы содержит идентификатор последней записи предыдущей страницы
+	items, next := h.changeLogService.GetChanges(int(request.SinceVersion), request.PageToken, request.Size)
+	response := fiasV1.ChangesResponse{NextPageToken: next}
+	for _, item := range items {
+		response.Items = append(response.Items, &fiasV1.Change{
+			ID:         item.ID,
+			VersionId:  int64(item.VersionId),
+			ObjectType: item.ObjectType,
+			Guid:       item.Guid,
+			ChangeType: item.ChangeType,
+			RegionCode: item.RegionCode,
+		})
+	}
+
+	return &response, nil
+}
+
+// Подготовить фильтр выгрузки
+func (h *AddressHandler) prepareExportFilter(request *fiasV1.ExportRequest) (entity.ExportFilter, error) {
+	filter := entity.ExportFilter{Regions: request.RegionCodes}
+	for _, level := range request.Levels {
+		filter.Levels = append(filter.Levels, int(level))
+	}
+	if request.UpdatedSince != "" {
+		// Дата обновления хранится в БД в локальном времени
+		since, err := time.ParseInLocation("2006-01-02", request.UpdatedSince, time.Local)
+		if err != nil {
+			since, err = time.Parse(time.RFC3339, request.UpdatedSince)
+		}
+		if err != nil {
+			return filter, status.Error(codes.InvalidArgument, "updated_since must be in format YYYY-MM-DD or RFC 3339")
+		}
+		filter.UpdatedSince = since.Local()
+	}
+
+	return filter, nil
+}
+
 // Подготавливает фильтр запросов
 func (h *AddressHandler) prepareFilter(requestFilter *fiasV1.FilterObject) []entity.FilterObject {
 	filter := entity.FilterObject{}
@@ -164,9 +460,32 @@ func (h *AddressHandler) prepareFilter(requestFilter *fiasV1.FilterObject) []ent
 	}
 }
 
+// Подготавливает параметры ранжирования, при их отсутствии используются параметры по умолчанию
+func (h *AddressHandler) prepareRanking(requestRanking *fiasV1.Ranking) *entity.RankingOptions {
+	if requestRanking == nil {
+		return nil
+	}
+
+	return &entity.RankingOptions{
+		Level:      float64(requestRanking.Level),
+		Center:     float64(requestRanking.Center),
+		Population: float64(requestRanking.Population),
+	}
+}
+
+// Получает тип иерархии адресов из запроса
+func (h *AddressHandler) prepareHierarchy(hierarchy fiasV1.Hierarchy) string {
+	if hierarchy == fiasV1.Hierarchy_MUN {
+		return entity.MunHierarchy
+	}
+
+	return entity.AdmHierarchy
+}
+
 // Формирует список объектов адресов
 func (h *AddressHandler) prepareList(cities []*entity.AddressObject) (*fiasV1.AddressListResponse, error) {
 	list := fiasV1.AddressListResponse{}
+	h.addressService.FillLocations(cities...)
 
 	for _, city := range cities {
 		list.Items = append(list.Items, h.convertToAddress(city))
@@ -175,6 +494,27 @@ func (h *AddressHandler) prepareList(cities []*entity.AddressObject) (*fiasV1.Ad
 	return &list, nil
 }
 
+// Подготовить страницу адресов с токеном следующей страницы
+func (h *AddressHandler) preparePage(cities []*entity.AddressObject, next *entity.Cursor) (*fiasV1.AddressListResponse, error) {
+	list, err := h.prepareList(cities)
+	if err != nil {
+		return nil, err
+	}
+	list.NextPageToken = next.Encode()
+
+	return list, nil
+}
+
+// Получить курсор из токена страницы
+func (h *AddressHandler) prepareCursor(token string) (*entity.Cursor, error) {
+	cursor, err := entity.DecodeCursor(token)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	return cursor, nil
+}
+
 // Конвертирует объект адреса в grpc-объект
 func (h *AddressHandler) convertToAddress(addr *entity.AddressObject) *fiasV1.Address {
 	if addr == nil {
@@ -220,6 +560,10 @@ func (h *AddressHandler) convertToAddress(addr *entity.AddressObject) *fiasV1.Ad
 		Okato:             addr.Okato,
 		Oktmo:             addr.Oktmo,
 		UpdatedDate:       addr.BazisUpdateDate,
+		StartDate:         addr.StartDate,
+		EndDate:           addr.EndDate,
+		PrevId:            addr.PrevId,
+		NextId:            addr.NextId,
 	}
 
 	if addr.AoLevel == 8 {
@@ -260,19 +604,43 @@ func (h *AddressHandler) convertToAddress(addr *entity.AddressObject) *fiasV1.Ad
 		item.RegionType = addr.ShortName
 		item.RegionFull = addr.FullName
 	}
-	if addr.Location != "" {
-		location := strings.Split(addr.Location, ",")
-		if len(location) == 2 {
-			lat, err := strconv.ParseFloat(location[0], 32)
-			if err == nil {
-				item.GeoLat = float32(lat)
-			}
-			lon, err := strconv.ParseFloat(location[1], 32)
-			if err == nil {
-				item.GeoLon = float32(lon)
-			}
-		}
-	}
+	item.GeoLat, item.GeoLon = h.prepareLocation(addr.Location)
+	item.GeoPrecision = addr.GeoPrecision
 
 	return &item
 }
+
+// Конвертирует объект дома в grpc-объект
+func (h *AddressHandler) convertToHouse(house *entity.HouseObject) *fiasV1.House {
+	item := &fiasV1.House{
+		ID:           house.ID,
+		FiasId:       house.HouseGuid,
+		ParentFiasId: house.AoGuid,
+		HouseNum:     house.HouseNum,
+		BuildNum:     house.BuildNum,
+		StructNum:    house.StructNum,
+		FullNum:      house.HouseFullNum,
+		FullAddress:  house.FullAddress,
+		PostalCode:   house.PostalCode,
+		Okato:        house.Okato,
+		Oktmo:        house.Oktmo,
+		CadNum:       house.CadNum,
+		UpdatedDate:  house.BazisUpdateDate,
+	}
+	item.GeoLat, item.GeoLon = h.prepareLocation(house.Location)
+	item.GeoPrecision = house.GeoPrecision
+
+	return item
+}
+
+// Получить координаты из строки в формате "широта,долгота"
+func (h *AddressHandler) prepareLocation(value string) (float32, float32) {
+	var lat, lon float64
+	location := strings.Split(value, ",")
+	if len(location) == 2 {
+		lat, _ = strconv.ParseFloat(location[0], 32)
+		lon, _ = strconv.ParseFloat(location[1], 32)
+	}
+
+	return float32(lat), float32(lon)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"context"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/service"
+	fiasV1 "github.com/GarinAG/gofias/infrastructure/persistence/grpc/dto/v1/fias"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPC-обработчик помещений
+type RoomHandler struct {
+	Server      *grpc.Server         // GRPC-сервер
+	roomService *service.RoomService // Сервис помещений
+}
+
+// Инициализация обработчика
+func NewRoomHandler(r *service.RoomService) *RoomHandler {
+	handler := &RoomHandler{
+		roomService: r,
+	}
+
+	return handler
+}
+
+// Найти помещение по GUID
+func (h *RoomHandler) GetRoomByGuid(ctx context.Context, request *fiasV1.GuidRequest) (*fiasV1.Room, error) {
+	if request.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	room := h.roomService.GetByGuid(request.Guid)
+	if room != nil {
+		return h.convertToRoom(room), nil
+	}
+
+	return nil, status.Error(codes.NotFound, "room not found")
+}
+
+// Найти помещения по GUID дома
+func (h *RoomHandler) GetRoomsByHouseGuid(ctx context.Context, request *fiasV1.GuidRequest) (*fiasV1.RoomListResponse, error) {
+	if request.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	var list []*fiasV1.Room
+	for _, room := range h.roomService.GetByHouseGuid(request.Guid) {
+		list = append(list, h.convertToRoom(room))
+	}
+
+	return &fiasV1.RoomListResponse{
+		Items: list,
+	}, nil
+}
+
+// Конвертирует объект помещения в объект ответа
+func (h *RoomHandler) convertToRoom(room *entity.RoomObject) *fiasV1.Room {
+	return &fiasV1.Room{
+		ID:          room.ID,
+		FiasId:      room.RoomGuid,
+		HouseFiasId: room.HouseGuid,
+		RegionCode:  room.RegionCode,
+		FlatNumber:  room.FlatNumber,
+		FlatType:    room.FlatType,
+		RoomNumber:  room.RoomNumber,
+		RoomType:    room.RoomType,
+		FullNum:     room.RoomFullNum,
+		FullAddress: room.FullAddress,
+		PostalCode:  room.PostalCode,
+		CadNum:      room.CadNum,
+		RoomCadNum:  room.RoomCadNum,
+		UpdatedDate: room.BazisUpdateDate,
+	}
+}
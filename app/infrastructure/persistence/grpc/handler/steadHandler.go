@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"github.com/GarinAG/gofias/domain/address/entity"
+	"github.com/GarinAG/gofias/domain/address/service"
+	fiasV1 "github.com/GarinAG/gofias/infrastructure/persistence/grpc/dto/v1/fias"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPC-обработчик земельных участков
+type SteadHandler struct {
+	Server       *grpc.Server          // GRPC-сервер
+	steadService *service.SteadService // Сервис участков
+}
+
+// Инициализация обработчика
+func NewSteadHandler(s *service.SteadService) *SteadHandler {
+	handler := &SteadHandler{
+		steadService: s,
+	}
+
+	return handler
+}
+
+// Найти участок по GUID
+func (h *SteadHandler) GetSteadByGuid(ctx context.Context, request *fiasV1.GuidRequest) (*fiasV1.Stead, error) {
+	if request.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	stead := h.steadService.GetByGuid(request.Guid)
+	if stead != nil {
+		return h.convertToStead(stead), nil
+	}
+
+	return nil, status.Error(codes.NotFound, "stead not found")
+}
+
+// Найти участки по GUID адреса
+func (h *SteadHandler) GetSteadsByAddressGuid(ctx context.Context, request *fiasV1.GuidRequest) (*fiasV1.SteadListResponse, error) {
+	if request.Guid == "" {
+		return nil, status.Error(codes.InvalidArgument, "guid is required")
+	}
+	var list []*fiasV1.Stead
+	for _, stead := range h.steadService.GetByAddressGuid(request.Guid) {
+		list = append(list, h.convertToStead(stead))
+	}
+
+	return &fiasV1.SteadListResponse{
+		Items: list,
+	}, nil
+}
+
+// Конвертирует объект участка в объект ответа
+func (h *SteadHandler) convertToStead(stead *entity.SteadObject) *fiasV1.Stead {
+	return &fiasV1.Stead{
+		ID:           stead.ID,
+		FiasId:       stead.SteadGuid,
+		ParentFiasId: stead.ParentGuid,
+		RegionCode:   stead.RegionCode,
+		Number:       stead.Number,
+		FullNum:      stead.SteadFullNum,
+		FullAddress:  stead.FullAddress,
+		PostalCode:   stead.PostalCode,
+		Okato:        stead.Okato,
+		Oktmo:        stead.Oktmo,
+		CadNum:       stead.CadNum,
+		UpdatedDate:  stead.BazisUpdateDate,
+	}
+}
@@ -52,13 +52,22 @@ func NewGrpcServer(ctn *registry.Container) *GrpcServer {
 		}
 	}()
 	// Инициализация GRPC-сервера
-	server := grpc.NewServer(grpc.UnaryInterceptor(serverInterceptor))
+	server := grpc.NewServer(grpc.UnaryInterceptor(serverInterceptor), grpc.StreamInterceptor(streamInterceptor))
 	// Регистрация обработчика адресов
 	grpcHandlerFiasV1.RegisterAddressServiceServer(server,
 		handlers.NewAddressHandler(
 			ctn.Resolve("addressService").(*service.AddressService),
 			ctn.Resolve("houseService").(*service.HouseService),
+			ctn.Resolve("addressHistoryService").(*service.AddressHistoryService),
+			ctn.Resolve("normalizeService").(*service.NormalizeService),
+			ctn.Resolve("exportService").(*service.ExportService),
+			ctn.Resolve("changeLogService").(*service.ChangeLogService),
+			ctn.Resolve("geocodeService").(*service.GeocodeService),
 		))
+	// Регистрация обработчика помещений
+	grpcHandlerFiasV1.RegisterRoomServiceServer(server, handlers.NewRoomHandler(ctn.Resolve("roomService").(*service.RoomService)))
+	// Регистрация обработчика земельных участков
+	grpcHandlerFiasV1.RegisterSteadServiceServer(server, handlers.NewSteadHandler(ctn.Resolve("steadService").(*service.SteadService)))
 	// Инициализация обработчика состояния приложения
 	grpcHandlerFiasV1.RegisterHealthServiceServer(server, handlers.NewHealthHandler())
 	// Инициализация обработчика версий
@@ -145,6 +154,16 @@ func (g *GrpcServer) proxyService(wg *sync.WaitGroup) {
 	if err != nil {
 		g.Logger.Fatal("error reg address endpoint", err)
 	}
+	// Регистрирует обработчик помещений
+	err = grpcHandlerFiasV1.RegisterRoomServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts)
+	if err != nil {
+		g.Logger.Fatal("error reg room endpoint", err)
+	}
+	// Регистрирует обработчик земельных участков
+	err = grpcHandlerFiasV1.RegisterSteadServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts)
+	if err != nil {
+		g.Logger.Fatal("error reg stead endpoint", err)
+	}
 	// Регистрирует обработчик состояния приложения
 	err = grpcHandlerFiasV1.RegisterHealthServiceHandlerFromEndpoint(ctx, mux, grpcAddress, opts)
 	if err != nil {
@@ -210,3 +229,34 @@ func serverInterceptor(ctx context.Context,
 
 	return h, err
 }
+
+// Инициализирует посредника потоковых запросов
+func streamInterceptor(srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	// Читает метаданные
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	var xRequestId string
+	// Ищет ID запроса в заголовках
+	for i, v := range md {
+		if i == "x-request-id" {
+			xRequestId = v[0]
+		}
+	}
+
+	// Исполняет запрос
+	err := handler(srv, stream)
+
+	if globalConfig.GetConfig().Grpc.SaveRequest {
+		globalLogger.WithFields(interfaces.LoggerFields{
+			"x-request-id": xRequestId,
+			"method":       info.FullMethod,
+			"time":         time.Since(start),
+		}).Info("Stream")
+	}
+
+	return err
+}
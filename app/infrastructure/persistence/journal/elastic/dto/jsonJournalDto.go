@@ -0,0 +1,13 @@
+package dto
+
+// Объект журнала импорта в эластике
+type JsonJournalDto struct {
+	VersionId int            `json:"version_id"`
+	IsFull    bool           `json:"is_full"`
+	Phase     string         `json:"phase"`
+	File      string         `json:"file"`
+	Offset    int            `json:"offset"`
+	Files     map[string]int `json:"files"`
+	Begin     string         `json:"begin"`
+	UpdatedAt string         `json:"updated_at"`
+}
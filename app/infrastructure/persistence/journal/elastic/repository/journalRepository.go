@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/GarinAG/gofias/domain/journal/entity"
+	"github.com/GarinAG/gofias/domain/journal/repository"
+	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	"github.com/GarinAG/gofias/infrastructure/persistence/journal/elastic/dto"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/olivere/elastic/v7"
+)
+
+const (
+	// Структура индекса в эластике
+	journalIndexSettings = `
+	{
+	  "settings": {
+		"index": {
+		  "number_of_shards": 1,
+		  "number_of_replicas": "0",
+		  "refresh_interval": "-1",
+		  "requests": {
+			"cache": {
+			  "enable": "false"
+			}
+		  },
+		  "blocks": {
+			"read_only_allow_delete": "false"
+		  }
+		}
+	  },
+	  "mappings": {
+		"dynamic": false,
+		"properties": {
+		  "version_id": {
+			"type": "integer"
+		  },
+		  "is_full": {
+			"type": "boolean"
+		  },
+		  "phase": {
+			"type": "keyword"
+		  },
+		  "file": {
+			"type": "keyword"
+		  },
+		  "offset": {
+			"type": "integer"
+		  },
+		  "files": {
+			"type": "object",
+			"enabled": false
+		  },
+		  "begin": {
+			"type": "date"
+		  },
+		  "updated_at": {
+			"type": "date"
+		  }
+		}
+	  }
+	}
+	`
+	// Идентификатор документа журнала
+	journalId = "current"
+)
+
+// Репозиторий журнала импорта в эластике
+type ElasticJournalRepository struct {
+	elasticClient *elasticHelper.Client // Клиент эластика
+	indexName     string                // Название индекса
+}
+
+// Инициализация репозитория
+func NewElasticJournalRepository(elasticClient *elasticHelper.Client, configInterface interfaces.ConfigInterface) repository.JournalRepositoryInterface {
+	repos := &ElasticJournalRepository{
+		elasticClient: elasticClient,
+		indexName:     configInterface.GetConfig().ProjectPrefix + entity.Journal{}.TableName(),
+	}
+
+	return repos
+}
+
+// Инициализация индекса
+func (j *ElasticJournalRepository) Init() error {
+	return j.elasticClient.CreateIndex(j.indexName, journalIndexSettings)
+}
+
+// Получить журнал импорта
+func (j *ElasticJournalRepository) GetJournal() (*entity.Journal, error) {
+	res, err := j.elasticClient.Client.Get().
+		Index(j.indexName).
+		Id(journalId).
+		Realtime(true).
+		Do(context.Background())
+
+	if elastic.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var dtoItem dto.JsonJournalDto
+	// Конвертирует структуру ответа в DTO
+	if err := json.Unmarshal(res.Source, &dtoItem); err != nil {
+		return nil, err
+	}
+
+	return j.convertToEntity(dtoItem), nil
+}
+
+// Сохранить журнал импорта
+func (j *ElasticJournalRepository) SetJournal(journal *entity.Journal) error {
+	res, err := j.elasticClient.Client.Bulk().
+		Index(j.indexName).
+		Refresh("true").
+		Add(elastic.NewBulkIndexRequest().Id(journalId).Doc(j.convertToDto(*journal))).
+		Do(context.Background())
+
+	if err != nil {
+		return err
+	}
+	if res.Errors {
+		return errors.New("Bulk commit failed")
+	}
+
+	return nil
+}
+
+// Конвертирует объект журнала эластика в объект журнала
+func (j *ElasticJournalRepository) convertToEntity(item dto.JsonJournalDto) *entity.Journal {
+	files := item.Files
+	if files == nil {
+		files = make(map[string]int)
+	}
+
+	return &entity.Journal{
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     files,
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+// Конвертирует объект журнала в объект журнала эластика
+func (j *ElasticJournalRepository) convertToDto(item entity.Journal) *dto.JsonJournalDto {
+	return &dto.JsonJournalDto{
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     item.Files,
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+// Удалить индекс
+func (j *ElasticJournalRepository) Clear() error {
+	return j.elasticClient.DropIndex(j.indexName)
+}
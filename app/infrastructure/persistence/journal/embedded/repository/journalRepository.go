@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/journal/entity"
+	"github.com/GarinAG/gofias/domain/journal/repository"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/infrastructure/persistence/journal/embedded/dto"
+	"github.com/GarinAG/gofias/interfaces"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// Ключ записи журнала
+	journalId = "current"
+)
+
+// Репозиторий журнала импорта во встроенном хранилище
+type EmbeddedJournalRepository struct {
+	embeddedClient *embeddedHelper.Client // Клиент встроенного хранилища
+	bucketName     string                 // Название бакета
+}
+
+// Инициализация репозитория
+func NewEmbeddedJournalRepository(embeddedClient *embeddedHelper.Client, configInterface interfaces.ConfigInterface) repository.JournalRepositoryInterface {
+	repos := &EmbeddedJournalRepository{
+		embeddedClient: embeddedClient,
+		bucketName:     configInterface.GetConfig().ProjectPrefix + entity.Journal{}.TableName(),
+	}
+
+	return repos
+}
+
+// Инициализация бакета
+func (j *EmbeddedJournalRepository) Init() error {
+	return j.embeddedClient.CreateBucket(j.bucketName)
+}
+
+// Получить журнал импорта
+func (j *EmbeddedJournalRepository) GetJournal() (*entity.Journal, error) {
+	var data []byte
+	err := j.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(j.bucketName))
+		if bucket != nil {
+			data = append(data, bucket.Get([]byte(journalId))...)
+		}
+
+		return nil
+	})
+
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+
+	var dtoItem dto.JsonJournalDto
+	if err := json.Unmarshal(data, &dtoItem); err != nil {
+		return nil, err
+	}
+
+	return j.convertToEntity(dtoItem), nil
+}
+
+// Сохранить журнал импорта
+func (j *EmbeddedJournalRepository) SetJournal(journal *entity.Journal) error {
+	data, err := json.Marshal(j.convertToDto(*journal))
+	if err != nil {
+		return err
+	}
+
+	return j.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(j.bucketName))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put([]byte(journalId), data)
+	})
+}
+
+// Конвертирует объект журнала встроенного хранилища в объект журнала
+func (j *EmbeddedJournalRepository) convertToEntity(item dto.JsonJournalDto) *entity.Journal {
+	files := item.Files
+	if files == nil {
+		files = make(map[string]int)
+	}
+
+	return &entity.Journal{
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     files,
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+// Конвертирует объект журнала в объект журнала встроенного хранилища
+func (j *EmbeddedJournalRepository) convertToDto(item entity.Journal) *dto.JsonJournalDto {
+	return &dto.JsonJournalDto{
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     item.Files,
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+// Удалить бакет
+func (j *EmbeddedJournalRepository) Clear() error {
+	return j.embeddedClient.DropBucket(j.bucketName)
+}
@@ -0,0 +1,14 @@
+package dto
+
+// Объект журнала импорта в PostgreSQL
+type PgJournalDto struct {
+	ID        string `gorm:"column:journal_id"`
+	VersionId int    `gorm:"column:version_id"`
+	IsFull    bool   `gorm:"column:is_full"`
+	Phase     string `gorm:"column:phase"`
+	File      string `gorm:"column:file"`
+	Offset    int    `gorm:"column:file_offset"`
+	Files     string `gorm:"column:files"`
+	Begin     string `gorm:"column:begin_date"`
+	UpdatedAt string `gorm:"column:updated_at"`
+}
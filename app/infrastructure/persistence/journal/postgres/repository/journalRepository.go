@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/GarinAG/gofias/domain/journal/entity"
+	"github.com/GarinAG/gofias/domain/journal/repository"
+	"github.com/GarinAG/gofias/infrastructure/persistence/journal/postgres/dto"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/interfaces"
+)
+
+const (
+	// Структура таблицы в PostgreSQL
+	journalTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  journal_id text PRIMARY KEY,
+	  version_id integer NOT NULL DEFAULT 0,
+	  is_full boolean NOT NULL DEFAULT false,
+	  phase text NOT NULL DEFAULT '',
+	  file text NOT NULL DEFAULT '',
+	  file_offset integer NOT NULL DEFAULT 0,
+	  files jsonb NOT NULL DEFAULT '{}',
+	  begin_date text NOT NULL DEFAULT '',
+	  updated_at text NOT NULL DEFAULT ''
+	);
+	`
+	// Идентификатор записи журнала
+	journalId = "current"
+)
+
+// Репозиторий журнала импорта в PostgreSQL
+type PgJournalRepository struct {
+	pgClient  *pgHelper.Client // Клиент PostgreSQL
+	tableName string           // Название таблицы
+}
+
+// Инициализация репозитория
+func NewPgJournalRepository(pgClient *pgHelper.Client, configInterface interfaces.ConfigInterface) repository.JournalRepositoryInterface {
+	repos := &PgJournalRepository{
+		pgClient:  pgClient,
+		tableName: configInterface.GetConfig().ProjectPrefix + entity.Journal{}.TableName(),
+	}
+
+	return repos
+}
+
+// Инициализация таблицы
+func (j *PgJournalRepository) Init() error {
+	return j.pgClient.CreateTable(fmt.Sprintf(journalTableSettings, j.tableName))
+}
+
+// Получить журнал импорта
+func (j *PgJournalRepository) GetJournal() (*entity.Journal, error) {
+	var list []dto.PgJournalDto
+	err := j.pgClient.DB.Table(j.tableName).
+		Where("journal_id = ?", journalId).
+		Find(&list).Error
+
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return j.convertToEntity(list[0])
+}
+
+// Сохранить журнал импорта
+func (j *PgJournalRepository) SetJournal(journal *entity.Journal) error {
+	item, err := j.convertToDto(*journal)
+	if err != nil {
+		return err
+	}
+
+	return j.pgClient.Upsert(j.tableName, "journal_id", []interface{}{item})
+}
+
+// Конвертирует объект журнала PostgreSQL в объект журнала
+func (j *PgJournalRepository) convertToEntity(item dto.PgJournalDto) (*entity.Journal, error) {
+	var files map[string]int
+	if item.Files != "" {
+		if err := json.Unmarshal([]byte(item.Files), &files); err != nil {
+			return nil, err
+		}
+	}
+	if files == nil {
+		files = make(map[string]int)
+	}
+
+	return &entity.Journal{
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     files,
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}, nil
+}
+
+// Конвертирует объект журнала в объект журнала PostgreSQL
+func (j *PgJournalRepository) convertToDto(item entity.Journal) (*dto.PgJournalDto, error) {
+	files, err := json.Marshal(item.Files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PgJournalDto{
+		ID:        journalId,
+		VersionId: item.VersionId,
+		IsFull:    item.IsFull,
+		Phase:     item.Phase,
+		File:      item.File,
+		Offset:    item.Offset,
+		Files:     string(files),
+		Begin:     item.Begin,
+		UpdatedAt: item.UpdatedAt,
+	}, nil
+}
+
+// Удалить таблицу
+func (j *PgJournalRepository) Clear() error {
+	return j.pgClient.DropTable(j.tableName)
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Максимальное количество параметров в одном запросе PostgreSQL
+const maxQueryParams = 65535
+
+// Минимальное сходство слова при поиске с учетом опечаток
+const fuzzyWordSimilarity = 0.5
+
+// Объект-обёртка клиента PostgreSQL
+type Client struct {
+	DB *gorm.DB // Подключение к БД
+}
+
+// Условие выборки элементов
+type Condition struct {
+	Query string        // SQL-условие
+	Args  []interface{} // Параметры условия
+}
+
+// Инициализация объекта
+func NewPostgresClient(configInterface interfaces.ConfigInterface, logger interfaces.LoggerInterface) *Client {
+	pgConfig := configInterface.GetConfig().Postgres
+	dsn := fmt.Sprintf("host=%s port=%s user=%s dbname=%s sslmode=%s",
+		pgConfig.Host, pgConfig.Port, pgConfig.User, pgConfig.Database, pgConfig.SslMode)
+	// Проверка авторизации
+	if pgConfig.Password != "" {
+		dsn += " password=" + pgConfig.Password
+	}
+
+	// Подключение к БД
+	db, err := gorm.Open("postgres", dsn)
+	if err != nil {
+		logger.WithFields(interfaces.LoggerFields{"error": err}).Fatal("Postgres connection failed")
+		panic(err)
+	}
+
+	return &Client{
+		DB: db,
+	}
+}
+
+// Создание таблицы
+func (c *Client) CreateTable(ddl string) error {
+	return c.DB.Exec(ddl).Error
+}
+
+// Удаление таблицы
+func (c *Client) DropTable(table string) error {
+	return c.DB.Exec("DROP TABLE IF EXISTS " + table).Error
+}
+
+// Подсчитать количество элементов в БД по фильтру
+func (c *Client) CountAllData(table string, condition *Condition) (int64, error) {
+	var count int64
+	db := c.DB.Table(table)
+	if condition != nil {
+		db = db.Where(condition.Query, condition.Args...)
+	}
+	err := db.Count(&count).Error
+
+	return count, err
+}
+
+// Добавить или обновить элементы по ключу
+func (c *Client) Upsert(table string, keyColumn string, items []interface{}) error {
+	if len(items) == 0 {
+		return nil
+	}
+	// Получает список колонок из описания DTO
+	var columns []string
+	var updates []string
+	for _, field := range c.DB.NewScope(items[0]).Fields() {
+		if field.IsIgnored {
+			continue
+		}
+		columns = append(columns, field.DBName)
+		if field.DBName != keyColumn {
+			updates = append(updates, field.DBName+" = EXCLUDED."+field.DBName)
+		}
+	}
+	query := "INSERT INTO " + table + " (" + strings.Join(columns, ", ") + ") VALUES %s ON CONFLICT (" + keyColumn + ") DO UPDATE SET " + strings.Join(updates, ", ")
+
+	// Разбивает элементы на пачки с учетом ограничения количества параметров
+	batch := maxQueryParams / len(columns)
+	for start := 0; start < len(items); start += batch {
+		end := start + batch
+		if end > len(items) {
+			end = len(items)
+		}
+		var rows []string
+		var args []interface{}
+		for _, item := range items[start:end] {
+			var placeholders []string
+			for _, field := range c.DB.NewScope(item).Fields() {
+				if field.IsIgnored {
+					continue
+				}
+				args = append(args, field.Field.Interface())
+				placeholders = append(placeholders, "$"+strconv.Itoa(len(args)))
+			}
+			rows = append(rows, "("+strings.Join(placeholders, ", ")+")")
+		}
+		if _, err := c.DB.DB().Exec(fmt.Sprintf(query, strings.Join(rows, ", ")), args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Удалить элементы по ключу
+func (c *Client) Delete(table string, keyColumn string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return c.DB.Exec("DELETE FROM "+table+" WHERE "+keyColumn+" IN (?)", ids).Error
+}
+
+// Обновить значение колонки у элементов по ключу
+func (c *Client) UpdateColumn(table string, keyColumn string, column string, values map[string]string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	var rows []string
+	var args []interface{}
+	for id, value := range values {
+		args = append(args, id, value)
+		rows = append(rows, "($"+strconv.Itoa(len(args)-1)+", $"+strconv.Itoa(len(args))+")")
+		// Отправляет запрос при достижении ограничения количества параметров
+		if len(args)+2 > maxQueryParams {
+			if err := c.updateColumnRows(table, keyColumn, column, rows, args); err != nil {
+				return err
+			}
+			rows = nil
+			args = nil
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	return c.updateColumnRows(table, keyColumn, column, rows, args)
+}
+
+// Выполняет обновление колонки по списку значений
+func (c *Client) updateColumnRows(table string, keyColumn string, column string, rows []string, args []interface{}) error {
+	query := "UPDATE " + table + " AS t SET " + column + " = v.value FROM (VALUES " + strings.Join(rows, ", ") + ") AS v(id, value) WHERE t." + keyColumn + " = v.id"
+	_, err := c.DB.DB().Exec(query, args...)
+
+	return err
+}
+
+// Разбить подстроку поиска на слова
+func PrepareTermWords(term string) []string {
+	return strings.FieldsFunc(strings.ToLower(term), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Подготовить шаблоны поиска по словам подстроки
+func PrepareTermPatterns(term string) []string {
+	replacer := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	var patterns []string
+	for _, word := range PrepareTermWords(term) {
+		patterns = append(patterns, "%"+replacer.Replace(word)+"%")
+	}
+
+	return patterns
+}
+
+// Добавить условие поиска по словам подстроки с учетом опечаток
+func WhereFuzzyTerm(db *gorm.DB, field string, term string) *gorm.DB {
+	words := PrepareTermWords(term)
+	if len(words) == 0 {
+		return nil
+	}
+	for _, word := range words {
+		db = db.Where("word_similarity(?, "+field+") >= ?", word, fuzzyWordSimilarity)
+	}
+
+	return db
+}
+
+// Подготовить условие поиска по словам подстроки для использования в составных выражениях
+func PrepareTermCondition(field string, term string, fuzzy bool) (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	if fuzzy {
+		for _, word := range PrepareTermWords(term) {
+			conditions = append(conditions, "word_similarity(?, "+field+") >= ?")
+			args = append(args, word, fuzzyWordSimilarity)
+		}
+	} else {
+		for _, pattern := range PrepareTermPatterns(term) {
+			conditions = append(conditions, field+" ILIKE ?")
+			args = append(args, pattern)
+		}
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+
+	return "(" + strings.Join(conditions, " AND ") + ")", args
+}
@@ -49,6 +49,9 @@ const (
 		  },
 		  "rec_upd_houses": {
 			"type": "integer"
+		  },
+		  "regions": {
+			"type": "keyword"
 		  }
 		}
 	  }
@@ -126,6 +129,7 @@ func (v *ElasticVersionRepository) convertToEntity(item dto.JsonVersionDto) *ent
 		UpdateDate:       item.UpdateDate,
 		RecUpdateAddress: item.RecUpdateAddress,
 		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          item.Regions,
 	}
 }
 
@@ -137,6 +141,7 @@ func (v *ElasticVersionRepository) convertToDto(item entity.Version) *dto.JsonVe
 		UpdateDate:       item.UpdateDate,
 		RecUpdateAddress: item.RecUpdateAddress,
 		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          item.Regions,
 	}
 }
 
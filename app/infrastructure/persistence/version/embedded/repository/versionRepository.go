@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"github.com/GarinAG/gofias/domain/version/entity"
+	"github.com/GarinAG/gofias/domain/version/repository"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
+	"github.com/GarinAG/gofias/infrastructure/persistence/version/embedded/dto"
+	"github.com/GarinAG/gofias/interfaces"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Репозиторий версий во встроенном хранилище
+type EmbeddedVersionRepository struct {
+	embeddedClient *embeddedHelper.Client // Клиент встроенного хранилища
+	bucketName     string                 // Название бакета
+}
+
+// Инициализация репозитория
+func NewEmbeddedVersionRepository(embeddedClient *embeddedHelper.Client, configInterface interfaces.ConfigInterface) repository.VersionRepositoryInterface {
+	repos := &EmbeddedVersionRepository{
+		embeddedClient: embeddedClient,
+		bucketName:     configInterface.GetConfig().ProjectPrefix + entity.Version{}.TableName(),
+	}
+
+	return repos
+}
+
+// Инициализация бакета
+func (v *EmbeddedVersionRepository) Init() error {
+	return v.embeddedClient.CreateBucket(v.bucketName)
+}
+
+// Получить текущую версию БД ФИАС
+func (v *EmbeddedVersionRepository) GetVersion() (*entity.Version, error) {
+	var data []byte
+	err := v.embeddedClient.DB.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(v.bucketName))
+		if bucket == nil {
+			return nil
+		}
+		// Ключи упорядочены по номеру версии, последний ключ - текущая версия
+		_, value := bucket.Cursor().Last()
+		data = append(data, value...)
+
+		return nil
+	})
+
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+
+	var dtoItem dto.JsonVersionDto
+	if err := json.Unmarshal(data, &dtoItem); err != nil {
+		return nil, err
+	}
+
+	return v.convertToEntity(dtoItem), nil
+}
+
+// Сохранить версию
+func (v *EmbeddedVersionRepository) SetVersion(version *entity.Version) error {
+	data, err := json.Marshal(v.convertToDto(*version))
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(version.ID))
+
+	return v.embeddedClient.DB.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(v.bucketName))
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key, data)
+	})
+}
+
+// Конвертирует объект версии встроенного хранилища в объект версии
+func (v *EmbeddedVersionRepository) convertToEntity(item dto.JsonVersionDto) *entity.Version {
+	return &entity.Version{
+		ID:               item.ID,
+		FiasVersion:      item.FiasVersion,
+		UpdateDate:       item.UpdateDate,
+		RecUpdateAddress: item.RecUpdateAddress,
+		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          item.Regions,
+	}
+}
+
+// Конвертирует объект версии в объект версии встроенного хранилища
+func (v *EmbeddedVersionRepository) convertToDto(item entity.Version) *dto.JsonVersionDto {
+	return &dto.JsonVersionDto{
+		ID:               item.ID,
+		FiasVersion:      item.FiasVersion,
+		UpdateDate:       item.UpdateDate,
+		RecUpdateAddress: item.RecUpdateAddress,
+		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          item.Regions,
+	}
+}
+
+// Удалить бакет
+func (v *EmbeddedVersionRepository) Clear() error {
+	return v.embeddedClient.DropBucket(v.bucketName)
+}
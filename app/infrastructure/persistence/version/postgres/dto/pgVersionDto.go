@@ -0,0 +1,11 @@
+package dto
+
+// Объект версии в PostgreSQL
+type PgVersionDto struct {
+	ID               int    `gorm:"column:version_id"`
+	FiasVersion      string `gorm:"column:fias_version"`
+	UpdateDate       string `gorm:"column:update_date"`
+	RecUpdateAddress int    `gorm:"column:rec_upd_address"`
+	RecUpdateHouses  int    `gorm:"column:rec_upd_houses"`
+	Regions          string `gorm:"column:regions"`
+}
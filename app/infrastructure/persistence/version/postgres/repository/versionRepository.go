@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"fmt"
+	"github.com/GarinAG/gofias/domain/version/entity"
+	"github.com/GarinAG/gofias/domain/version/repository"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
+	"github.com/GarinAG/gofias/infrastructure/persistence/version/postgres/dto"
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/GarinAG/gofias/util"
+	"strings"
+)
+
+const (
+	// Структура таблицы в PostgreSQL
+	versionTableSettings = `
+	CREATE TABLE IF NOT EXISTS %[1]s (
+	  version_id integer PRIMARY KEY,
+	  fias_version text NOT NULL DEFAULT '',
+	  update_date text NOT NULL DEFAULT '',
+	  rec_upd_address integer NOT NULL DEFAULT 0,
+	  rec_upd_houses integer NOT NULL DEFAULT 0,
+	  regions text NOT NULL DEFAULT ''
+	);
+	ALTER TABLE %[1]s ADD COLUMN IF NOT EXISTS regions text NOT NULL DEFAULT '';
+	`
+)
+
+// Репозиторий версий в PostgreSQL
+type PgVersionRepository struct {
+	pgClient  *pgHelper.Client // Клиент PostgreSQL
+	tableName string           // Название таблицы
+}
+
+// Инициализация репозитория
+func NewPgVersionRepository(pgClient *pgHelper.Client, configInterface interfaces.ConfigInterface) repository.VersionRepositoryInterface {
+	repos := &PgVersionRepository{
+		pgClient:  pgClient,
+		tableName: configInterface.GetConfig().ProjectPrefix + entity.Version{}.TableName(),
+	}
+
+	return repos
+}
+
+// Инициализация таблицы
+func (v *PgVersionRepository) Init() error {
+	return v.pgClient.CreateTable(fmt.Sprintf(versionTableSettings, v.tableName))
+}
+
+// Получить текущую версию БД ФИАС
+func (v *PgVersionRepository) GetVersion() (*entity.Version, error) {
+	var list []dto.PgVersionDto
+	err := v.pgClient.DB.Table(v.tableName).
+		Order("version_id DESC").
+		Limit(1).
+		Find(&list).Error
+
+	if err != nil {
+		return nil, err
+	}
+	if len(list) > 0 {
+		return v.convertToEntity(list[0]), nil
+	}
+
+	return nil, nil
+}
+
+// Сохранить версию
+func (v *PgVersionRepository) SetVersion(version *entity.Version) error {
+	return v.pgClient.Upsert(v.tableName, "version_id", []interface{}{v.convertToDto(*version)})
+}
+
+// Конвертирует объект версии PostgreSQL в объект версии
+func (v *PgVersionRepository) convertToEntity(item dto.PgVersionDto) *entity.Version {
+	return &entity.Version{
+		ID:               item.ID,
+		FiasVersion:      item.FiasVersion,
+		UpdateDate:       item.UpdateDate,
+		RecUpdateAddress: item.RecUpdateAddress,
+		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          util.SplitRegions(item.Regions),
+	}
+}
+
+// Конвертирует объект версии в объект версии PostgreSQL
+func (v *PgVersionRepository) convertToDto(item entity.Version) *dto.PgVersionDto {
+	return &dto.PgVersionDto{
+		ID:               item.ID,
+		FiasVersion:      item.FiasVersion,
+		UpdateDate:       item.UpdateDate,
+		RecUpdateAddress: item.RecUpdateAddress,
+		RecUpdateHouses:  item.RecUpdateHouses,
+		Regions:          strings.Join(item.Regions, ","),
+	}
+}
+
+// Удалить таблицу
+func (v *PgVersionRepository) Clear() error {
+	return v.pgClient.DropTable(v.tableName)
+}
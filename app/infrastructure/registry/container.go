@@ -3,24 +3,50 @@ package registry
 import (
 	"flag"
 	cache "github.com/AeroAgency/golang-bigcache-lib"
+	"github.com/GarinAG/gofias/domain/address/entity"
 	"github.com/GarinAG/gofias/domain/address/repository"
 	"github.com/GarinAG/gofias/domain/address/service"
 	directoryService "github.com/GarinAG/gofias/domain/directory/service"
 	fiasApiService "github.com/GarinAG/gofias/domain/fiasApi/service"
+	journalRepositoryInterface "github.com/GarinAG/gofias/domain/journal/repository"
+	journalService "github.com/GarinAG/gofias/domain/journal/service"
 	osmService "github.com/GarinAG/gofias/domain/osm/service"
+	versionRepositoryInterface "github.com/GarinAG/gofias/domain/version/repository"
 	versionService "github.com/GarinAG/gofias/domain/version/service"
 	elasticRepository "github.com/GarinAG/gofias/infrastructure/persistence/address/elastic/repository"
+	embeddedRepository "github.com/GarinAG/gofias/infrastructure/persistence/address/embedded/repository"
+	fileRepository "github.com/GarinAG/gofias/infrastructure/persistence/address/file/repository"
+	pgRepository "github.com/GarinAG/gofias/infrastructure/persistence/address/postgres/repository"
 	"github.com/GarinAG/gofias/infrastructure/persistence/config"
 	elasticHelper "github.com/GarinAG/gofias/infrastructure/persistence/elastic"
+	embeddedHelper "github.com/GarinAG/gofias/infrastructure/persistence/embedded"
 	fiasApiRepository "github.com/GarinAG/gofias/infrastructure/persistence/fiasApi/http/repository"
+	journalRepository "github.com/GarinAG/gofias/infrastructure/persistence/journal/elastic/repository"
+	embeddedJournalRepository "github.com/GarinAG/gofias/infrastructure/persistence/journal/embedded/repository"
+	pgJournalRepository "github.com/GarinAG/gofias/infrastructure/persistence/journal/postgres/repository"
 	log "github.com/GarinAG/gofias/infrastructure/persistence/logger"
+	pgHelper "github.com/GarinAG/gofias/infrastructure/persistence/postgres"
 	versionRepository "github.com/GarinAG/gofias/infrastructure/persistence/version/elastic/repository"
+	embeddedVersionRepository "github.com/GarinAG/gofias/infrastructure/persistence/version/embedded/repository"
+	pgVersionRepository "github.com/GarinAG/gofias/infrastructure/persistence/version/postgres/repository"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/allegro/bigcache"
 	"github.com/sarulabs/di"
 	"time"
 )
 
+// Типы хранилища данных
+const (
+	StorageElastic  = "elastic"  // Elasticsearch
+	StoragePostgres = "postgres" // PostgreSQL
+	StorageEmbedded = "embedded" // Встроенное хранилище bbolt и bleve
+)
+
+// Типы публикации журнала изменений
+const (
+	PublisherFile = "file" // Запись в файл в формате JSON Lines
+)
+
 var (
 	ConfigPath = flag.String("config-path", "./", "Config path")
 	ConfigType = flag.String("config-type", "yaml", "Config type")
@@ -99,11 +125,51 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				return client, nil
 			},
 		},
+		// Клиент PostgreSQL
+		{
+			Name: "postgresClient",
+			Build: func(ctn di.Container) (interface{}, error) {
+				client := pgHelper.NewPostgresClient(ctn.Get("config").(interfaces.ConfigInterface), ctn.Get("logger").(interfaces.LoggerInterface))
+
+				return client, nil
+			},
+			Close: func(obj interface{}) error {
+				return obj.(*pgHelper.Client).DB.Close()
+			},
+		},
+		// Клиент встроенного хранилища
+		{
+			Name: "embeddedClient",
+			Build: func(ctn di.Container) (interface{}, error) {
+				client := embeddedHelper.NewEmbeddedClient(ctn.Get("config").(interfaces.ConfigInterface), ctn.Get("logger").(interfaces.LoggerInterface))
+
+				return client, nil
+			},
+			Close: func(obj interface{}) error {
+				return obj.(*embeddedHelper.Client).Close()
+			},
+		},
 		// Репозиторий домов
 		{
 			Name: "houseRepository",
 			Build: func(ctn di.Container) (interface{}, error) {
 				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgHouseRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix,
+						appConfig.GetConfig().Workers.Houses), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedHouseRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix,
+						appConfig.GetConfig().Workers.Houses), nil
+				}
 				repo := elasticRepository.NewElasticHouseRepository(
 					ctn.Get("elasticClient").(*elasticHelper.Client),
 					ctn.Get("logger").(interfaces.LoggerInterface),
@@ -114,11 +180,155 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				return repo, nil
 			},
 		},
+		// Репозиторий помещений
+		{
+			Name: "roomRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgRoomRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedRoomRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				}
+				repo := elasticRepository.NewElasticRoomRepository(
+					ctn.Get("elasticClient").(*elasticHelper.Client),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					appConfig.GetConfig().BatchSize,
+					appConfig.GetConfig().ProjectPrefix)
+
+				return repo, nil
+			},
+		},
+		// Репозиторий истории адресов
+		{
+			Name: "addressHistoryRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return buildAddressHistoryRepository(ctn, entity.AddressObject{}.HistoryTableName()), nil
+			},
+		},
+		// Репозиторий версий адресов со ссылками на предыдущие и следующие записи
+		{
+			Name: "addressLinkRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return buildAddressHistoryRepository(ctn, entity.AddressObject{}.LinkTableName()), nil
+			},
+		},
+		// Репозиторий журнала изменений
+		{
+			Name: "changeLogRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgChangeLogRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedChangeLogRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				}
+				repo := elasticRepository.NewElasticChangeLogRepository(
+					ctn.Get("elasticClient").(*elasticHelper.Client),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					appConfig.GetConfig().BatchSize,
+					appConfig.GetConfig().ProjectPrefix)
+
+				return repo, nil
+			},
+		},
+		// Репозиторий границ административных объектов
+		{
+			Name: "boundaryRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgBoundaryRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedBoundaryRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				}
+				repo := elasticRepository.NewElasticBoundaryRepository(
+					ctn.Get("elasticClient").(*elasticHelper.Client),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					appConfig.GetConfig().BatchSize,
+					appConfig.GetConfig().ProjectPrefix)
+
+				return repo, nil
+			},
+		},
+		// Репозиторий земельных участков
+		{
+			Name: "steadRepository",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgSteadRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedSteadRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix), nil
+				}
+				repo := elasticRepository.NewElasticSteadRepository(
+					ctn.Get("elasticClient").(*elasticHelper.Client),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					appConfig.GetConfig().BatchSize,
+					appConfig.GetConfig().ProjectPrefix)
+
+				return repo, nil
+			},
+		},
 		// Репозиторий адресов
 		{
 			Name: "addressRepository",
 			Build: func(ctn di.Container) (interface{}, error) {
 				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					return pgRepository.NewPgAddressRepository(
+						ctn.Get("postgresClient").(*pgHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix,
+						appConfig.GetConfig().Workers.Addresses,
+						ctn.Get("cache").(cache.CacheInterface)), nil
+				case StorageEmbedded:
+					return embeddedRepository.NewEmbeddedAddressRepository(
+						ctn.Get("embeddedClient").(*embeddedHelper.Client),
+						ctn.Get("logger").(interfaces.LoggerInterface),
+						appConfig.GetConfig().BatchSize,
+						appConfig.GetConfig().ProjectPrefix,
+						appConfig.GetConfig().Workers.Addresses,
+						ctn.Get("cache").(cache.CacheInterface)), nil
+				}
 				repo := elasticRepository.NewElasticAddressRepository(
 					ctn.Get("elasticClient").(*elasticHelper.Client),
 					ctn.Get("logger").(interfaces.LoggerInterface),
@@ -149,6 +359,13 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 					ctn.Get("config").(interfaces.ConfigInterface)), nil
 			},
 		},
+		// Фильтр импорта по регионам
+		{
+			Name: "regionFilter",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewRegionFilter(ctn.Get("addressRepository").(repository.AddressRepositoryInterface)), nil
+			},
+		},
 		// Сервис импорта адресов
 		{
 			Name: "addressImportService",
@@ -156,7 +373,11 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				repo := ctn.Get("addressRepository").(repository.AddressRepositoryInterface)
 				logger := ctn.Get("logger").(interfaces.LoggerInterface)
 
-				return service.NewAddressImportService(repo, logger), nil
+				journal := ctn.Get("journalService").(*journalService.JournalService)
+				regions := ctn.Get("regionFilter").(*service.RegionFilter)
+				history := ctn.Get("addressHistoryService").(*service.AddressHistoryService)
+
+				return service.NewAddressImportService(repo, logger, journal, regions, history), nil
 			},
 		},
 		// Сервис импорта домов
@@ -166,18 +387,130 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				repo := ctn.Get("houseRepository").(repository.HouseRepositoryInterface)
 				logger := ctn.Get("logger").(interfaces.LoggerInterface)
 
-				return service.NewHouseImportService(repo, logger), nil
+				journal := ctn.Get("journalService").(*journalService.JournalService)
+				regions := ctn.Get("regionFilter").(*service.RegionFilter)
+
+				return service.NewHouseImportService(repo, logger, journal, regions), nil
+			},
+		},
+		// Сервис импорта помещений
+		{
+			Name: "roomImportService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("roomRepository").(repository.RoomRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+
+				journal := ctn.Get("journalService").(*journalService.JournalService)
+				regions := ctn.Get("regionFilter").(*service.RegionFilter)
+
+				return service.NewRoomImportService(repo, logger, journal, regions), nil
+			},
+		},
+		// Сервис импорта земельных участков
+		{
+			Name: "steadImportService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("steadRepository").(repository.SteadRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+
+				journal := ctn.Get("journalService").(*journalService.JournalService)
+				regions := ctn.Get("regionFilter").(*service.RegionFilter)
+
+				return service.NewSteadImportService(repo, logger, journal, regions), nil
+			},
+		},
+		// Сервис импорта данных в формате ГАР
+		{
+			Name: "garImportService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewGarImportService(
+					ctn.Get("addressRepository").(repository.AddressRepositoryInterface),
+					ctn.Get("houseRepository").(repository.HouseRepositoryInterface),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					ctn.Get("config").(interfaces.ConfigInterface).GetConfig().BatchSize,
+					ctn.Get("journalService").(*journalService.JournalService),
+					ctn.Get("addressHistoryService").(*service.AddressHistoryService)), nil
+			},
+		},
+		// Сервис отчета об изменениях импорта
+		{
+			Name: "changeReportService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewChangeReportService(
+					ctn.Get("addressRepository").(repository.AddressRepositoryInterface),
+					ctn.Get("houseRepository").(repository.HouseRepositoryInterface),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					ctn.Get("config").(interfaces.ConfigInterface).GetConfig().BatchSize), nil
+			},
+		},
+		// Сервис журнала изменений
+		{
+			Name: "changeLogService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+				// Сравнение загружаемых объектов выполняется отдельным экземпляром, чтобы не смешивать с отчетом об изменениях
+				changes := service.NewChangeReportService(
+					ctn.Get("addressRepository").(repository.AddressRepositoryInterface),
+					ctn.Get("houseRepository").(repository.HouseRepositoryInterface),
+					logger,
+					appConfig.GetConfig().BatchSize)
+				var publisher repository.ChangePublisherInterface
+				if appConfig.GetConfig().Changes.Enable && appConfig.GetConfig().Changes.Publisher == PublisherFile {
+					filePublisher, err := fileRepository.NewFileChangePublisher(appConfig.GetConfig().Changes.Path)
+					if err != nil {
+						return nil, err
+					}
+					publisher = filePublisher
+				}
+
+				return service.NewChangeLogService(
+					ctn.Get("changeLogRepository").(repository.ChangeLogRepositoryInterface),
+					publisher,
+					changes,
+					logger,
+					appConfig), nil
+			},
+			Close: func(obj interface{}) error {
+				obj.(*service.ChangeLogService).Close()
+
+				return nil
 			},
 		},
 		// Сервис версий
 		{
 			Name: "versionService",
 			Build: func(ctn di.Container) (interface{}, error) {
-				repo := versionRepository.NewElasticVersionRepository(ctn.Get("elasticClient").(*elasticHelper.Client),
-					ctn.Get("config").(interfaces.ConfigInterface))
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				var repo versionRepositoryInterface.VersionRepositoryInterface
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					repo = pgVersionRepository.NewPgVersionRepository(ctn.Get("postgresClient").(*pgHelper.Client), appConfig)
+				case StorageEmbedded:
+					repo = embeddedVersionRepository.NewEmbeddedVersionRepository(ctn.Get("embeddedClient").(*embeddedHelper.Client), appConfig)
+				default:
+					repo = versionRepository.NewElasticVersionRepository(ctn.Get("elasticClient").(*elasticHelper.Client), appConfig)
+				}
 				return versionService.NewVersionService(repo, ctn.Get("logger").(interfaces.LoggerInterface)), nil
 			},
 		},
+		// Сервис журнала импорта
+		{
+			Name: "journalService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+				var repo journalRepositoryInterface.JournalRepositoryInterface
+				switch appConfig.GetConfig().Storage {
+				case StoragePostgres:
+					repo = pgJournalRepository.NewPgJournalRepository(ctn.Get("postgresClient").(*pgHelper.Client), appConfig)
+				case StorageEmbedded:
+					repo = embeddedJournalRepository.NewEmbeddedJournalRepository(ctn.Get("embeddedClient").(*embeddedHelper.Client), appConfig)
+				default:
+					repo = journalRepository.NewElasticJournalRepository(ctn.Get("elasticClient").(*elasticHelper.Client), appConfig)
+				}
+				return journalService.NewJournalService(repo, ctn.Get("logger").(interfaces.LoggerInterface)), nil
+			},
+		},
 		// Сервис работы с ФИАС API
 		{
 			Name: "fiasApiService",
@@ -195,6 +528,13 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 					ctn.Get("directoryService").(*directoryService.DirectoryService),
 					ctn.Get("addressImportService").(*service.AddressImportService),
 					ctn.Get("houseImportService").(*service.HouseImportService),
+					ctn.Get("roomImportService").(*service.RoomImportService),
+					ctn.Get("steadImportService").(*service.SteadImportService),
+					ctn.Get("garImportService").(*service.GarImportService),
+					ctn.Get("journalService").(*journalService.JournalService),
+					ctn.Get("regionFilter").(*service.RegionFilter),
+					ctn.Get("changeReportService").(*service.ChangeReportService),
+					ctn.Get("changeLogService").(*service.ChangeLogService),
 					ctn.Get("config").(interfaces.ConfigInterface)), nil
 			},
 		},
@@ -205,7 +545,18 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				repo := ctn.Get("addressRepository").(repository.AddressRepositoryInterface)
 				logger := ctn.Get("logger").(interfaces.LoggerInterface)
 
-				return service.NewAddressService(repo, logger), nil
+				return service.NewAddressService(repo, ctn.Get("rankingService").(*service.RankingService), logger), nil
+			},
+		},
+		// Сервис ранжирования результатов поиска
+		{
+			Name: "rankingService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("addressRepository").(repository.AddressRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+				config := ctn.Get("config").(interfaces.ConfigInterface)
+
+				return service.NewRankingService(repo, logger, config), nil
 			},
 		},
 		// Сервис домов
@@ -215,7 +566,72 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 				repo := ctn.Get("houseRepository").(repository.HouseRepositoryInterface)
 				logger := ctn.Get("logger").(interfaces.LoggerInterface)
 
-				return service.NewHouseService(repo, logger), nil
+				return service.NewHouseService(repo, ctn.Get("rankingService").(*service.RankingService), logger), nil
+			},
+		},
+		// Сервис истории адресов
+		{
+			Name: "addressHistoryService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("addressHistoryRepository").(repository.AddressHistoryRepositoryInterface)
+				linkRepo := ctn.Get("addressLinkRepository").(repository.AddressHistoryRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+
+				return service.NewAddressHistoryService(repo, linkRepo, logger, appConfig), nil
+			},
+		},
+		// Сервис помещений
+		{
+			Name: "roomService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("roomRepository").(repository.RoomRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+
+				return service.NewRoomService(repo, logger), nil
+			},
+		},
+		// Сервис земельных участков
+		{
+			Name: "steadService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				repo := ctn.Get("steadRepository").(repository.SteadRepositoryInterface)
+				logger := ctn.Get("logger").(interfaces.LoggerInterface)
+
+				return service.NewSteadService(repo, logger), nil
+			},
+		},
+		// Сервис нормализации адресов
+		{
+			Name: "normalizeService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewNormalizeService(
+					ctn.Get("addressService").(*service.AddressService),
+					ctn.Get("houseService").(*service.HouseService),
+					ctn.Get("roomService").(*service.RoomService),
+					ctn.Get("logger").(interfaces.LoggerInterface)), nil
+			},
+		},
+		// Сервис выгрузки адресов и домов
+		{
+			Name: "exportService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewExportService(
+					ctn.Get("addressRepository").(repository.AddressRepositoryInterface),
+					ctn.Get("houseRepository").(repository.HouseRepositoryInterface),
+					ctn.Get("logger").(interfaces.LoggerInterface)), nil
+			},
+		},
+		// Сервис обратного геокодирования
+		{
+			Name: "geocodeService",
+			Build: func(ctn di.Container) (interface{}, error) {
+				return service.NewGeocodeService(
+					ctn.Get("addressRepository").(repository.AddressRepositoryInterface),
+					ctn.Get("houseRepository").(repository.HouseRepositoryInterface),
+					ctn.Get("boundaryRepository").(repository.BoundaryRepositoryInterface),
+					ctn.Get("logger").(interfaces.LoggerInterface),
+					ctn.Get("config").(interfaces.ConfigInterface)), nil
 			},
 		},
 		// Сервис работы с OpenStreetMap
@@ -224,11 +640,12 @@ func NewContainer(loggerPrefix string) (*Container, error) {
 			Build: func(ctn di.Container) (interface{}, error) {
 				addressRepo := ctn.Get("addressRepository").(repository.AddressRepositoryInterface)
 				houseRepo := ctn.Get("houseRepository").(repository.HouseRepositoryInterface)
+				boundaryRepo := ctn.Get("boundaryRepository").(repository.BoundaryRepositoryInterface)
 				logger := ctn.Get("logger").(interfaces.LoggerInterface)
 				downloadService := ctn.Get("downloadService").(*directoryService.DownloadService)
 				appConfig := ctn.Get("config").(interfaces.ConfigInterface)
 
-				return osmService.NewOsmService(addressRepo, houseRepo, downloadService, logger, appConfig), nil
+				return osmService.NewOsmService(addressRepo, houseRepo, boundaryRepo, downloadService, logger, appConfig), nil
 			},
 		},
 	}...); err != nil {
@@ -249,3 +666,18 @@ func (c *Container) Resolve(name string) interface{} {
 func (c *Container) Clean() error {
 	return c.ctn.Clean()
 }
+
+// Создать репозиторий версий адресов в выбранном хранилище
+func buildAddressHistoryRepository(ctn di.Container, tableName string) repository.AddressHistoryRepositoryInterface {
+	appConfig := ctn.Get("config").(interfaces.ConfigInterface)
+	logger := ctn.Get("logger").(interfaces.LoggerInterface)
+	tableName = appConfig.GetConfig().ProjectPrefix + tableName
+	switch appConfig.GetConfig().Storage {
+	case StoragePostgres:
+		return pgRepository.NewPgAddressHistoryRepository(ctn.Get("postgresClient").(*pgHelper.Client), logger, appConfig.GetConfig().BatchSize, tableName)
+	case StorageEmbedded:
+		return embeddedRepository.NewEmbeddedAddressHistoryRepository(ctn.Get("embeddedClient").(*embeddedHelper.Client), logger, appConfig.GetConfig().BatchSize, tableName)
+	}
+
+	return elasticRepository.NewElasticAddressHistoryRepository(ctn.Get("elasticClient").(*elasticHelper.Client), logger, appConfig.GetConfig().BatchSize, tableName)
+}
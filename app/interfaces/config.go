@@ -26,6 +26,29 @@ type ElasticConfig struct {
 	Password string // Пароль пользователя
 }
 
+// Конфиги PostgreSQL
+type PostgresConfig struct {
+	Host     string // Хост
+	Port     string // Порт
+	User     string // Пользователь
+	Password string // Пароль пользователя
+	Database string // Название БД
+	SslMode  string // Режим SSL-соединения
+}
+
+// Конфиги встроенного хранилища
+type EmbeddedConfig struct {
+	Path string // Путь к каталогу с файлами БД
+}
+
+// Конфиги загрузки файлов
+type DownloadConfig struct {
+	Retries    int    // Количество повторных попыток загрузки
+	RetryDelay int    // Начальная задержка между попытками в секундах, удваивается после каждой попытки
+	Timeout    int    // Таймаут соединения и ожидания данных в секундах
+	Proxy      string // Адрес прокси-сервера, по умолчанию берется из переменных окружения
+}
+
 // Конфиги логгерв
 type LoggerConfig struct {
 	Enable bool   // Активность логгера
@@ -57,22 +80,52 @@ type WorkersConfig struct {
 	Addresses int // Количество обработчиков для адресов
 }
 
+// Конфиги истории адресов
+type HistoryConfig struct {
+	Enable bool // Сохранять все версии адресов
+}
+
+// Конфиги журнала изменений
+type ChangesConfig struct {
+	Enable    bool   // Сохранять журнал изменений адресов и домов при импорте
+	Publisher string // Способ публикации изменений: file или пустая строка
+	Path      string // Путь к файлу публикации изменений
+}
+
+// Конфиги ранжирования результатов поиска
+type RankingConfig struct {
+	Enable     bool    // Ранжировать результаты поиска по подстроке
+	Level      float64 // Вес уровня адресного объекта
+	Center     float64 // Вес статуса центра района или региона
+	Population float64 // Вес численности населения
+}
+
 // Конфиги OSM
 type OsmConfig struct {
-	Url string // Путь до файла с данными
+	Url            string  // Путь до файла с данными
+	Boundaries     bool    // Загружать границы административных объектов
+	MatchThreshold float64 // Минимальная оценка сопоставления населенного пункта OSM с объектом ФИАС
+	ReportPath     string  // Каталог отчетов сопоставления
 }
 
 // Базовые конфиги приложения
 type BaseConfig struct {
-	ProjectPrefix     string        // Префикс проекта для хранения в БД
-	Elastic           ElasticConfig // Конфиги эластика
-	BatchSize         int           // Размер пачки для обновления
-	DirectoryFilePath string        // Путь сохранения файлов импорта
-	ProcessPrint      bool          // Разрешить вывод прогресса в консоль
-	FiasApiUrl        string        // Путь до FIAS Api сервиса
-	LoggerConsole     LoggerConfig  // Конфиги консольного логгера
-	LoggerFile        LoggerConfig  // Конфиги файлового логгера
-	Grpc              GrpcConfig    // Конфиги grpc-сервера
-	Workers           WorkersConfig // Конфиги RestApi-сервера
-	Osm               OsmConfig     // Конфиги OSM (гео-данные)
+	ProjectPrefix     string         // Префикс проекта для хранения в БД
+	Storage           string         // Тип хранилища: elastic, postgres или embedded
+	Elastic           ElasticConfig  // Конфиги эластика
+	Postgres          PostgresConfig // Конфиги PostgreSQL
+	Embedded          EmbeddedConfig // Конфиги встроенного хранилища
+	BatchSize         int            // Размер пачки для обновления
+	DirectoryFilePath string         // Путь сохранения файлов импорта
+	Download          DownloadConfig // Конфиги загрузки файлов
+	History           HistoryConfig  // Конфиги истории адресов
+	Changes           ChangesConfig  // Конфиги журнала изменений
+	Ranking           RankingConfig  // Конфиги ранжирования результатов поиска
+	ProcessPrint      bool           // Разрешить вывод прогресса в консоль
+	FiasApiUrl        string         // Путь до FIAS Api сервиса
+	LoggerConsole     LoggerConfig   // Конфиги консольного логгера
+	LoggerFile        LoggerConfig   // Конфиги файлового логгера
+	Grpc              GrpcConfig     // Конфиги grpc-сервера
+	Workers           WorkersConfig  // Конфиги RestApi-сервера
+	Osm               OsmConfig      // Конфиги OSM (гео-данные)
 }
@@ -0,0 +1,150 @@
+package util
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Радиус Земли в метрах
+const earthRadius = 6371000.0
+
+// Получить широту и долготу из строки координат в формате "lat,lon"
+func ParseLocation(value string) (float64, float64, bool) {
+	location := strings.Split(value, ",")
+	if len(location) != 2 {
+		return 0, 0, false
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(location[0]), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(location[1]), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return lat, lon, true
+}
+
+// Получить расстояние в метрах между двумя точками по формуле гаверсинусов
+func GeoDistance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLon := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// Точка в географических координатах
+type GeoPoint struct {
+	Lat float64 // Широта
+	Lon float64 // Долгота
+}
+
+// Проверить, что первая и последняя точки линии совпадают
+func IsClosedRing(points []GeoPoint) bool {
+	return len(points) > 3 && points[0] == points[len(points)-1]
+}
+
+// Собрать замкнутые контуры из линий, соединяя их по совпадающим концам
+func JoinGeoRings(lines [][]GeoPoint) [][]GeoPoint {
+	var rings [][]GeoPoint
+	var open [][]GeoPoint
+	for _, line := range lines {
+		if len(line) < 2 {
+			continue
+		}
+		if IsClosedRing(line) {
+			rings = append(rings, line)
+		} else {
+			open = append(open, line)
+		}
+	}
+
+	for len(open) > 0 {
+		ring := open[0]
+		open = open[1:]
+		for !IsClosedRing(ring) {
+			joined := false
+			for i, line := range open {
+				last := ring[len(ring)-1]
+				switch {
+				case line[0] == last:
+					ring = append(ring, line[1:]...)
+				case line[len(line)-1] == last:
+					for j := len(line) - 2; j >= 0; j-- {
+						ring = append(ring, line[j])
+					}
+				default:
+					continue
+				}
+				open = append(open[:i], open[i+1:]...)
+				joined = true
+				break
+			}
+			// Контур не удается замкнуть, отбрасываем его
+			if !joined {
+				break
+			}
+		}
+		if IsClosedRing(ring) {
+			rings = append(rings, ring)
+		}
+	}
+
+	return rings
+}
+
+// Получить центр тяжести замкнутых контуров, при нулевой площади или незамкнутых линиях возвращается среднее координат точек
+func GeoCentroid(rings [][]GeoPoint) (GeoPoint, bool) {
+	var area, lat, lon, sumLat, sumLon float64
+	var origin GeoPoint
+	count := 0
+	for _, ring := range rings {
+		// Координаты отсчитываются от первой точки, чтобы не терять точность на больших значениях
+		if count == 0 && len(ring) > 0 {
+			origin = ring[0]
+		}
+		for _, point := range ring {
+			sumLat += point.Lat
+			sumLon += point.Lon
+			count++
+		}
+		if !IsClosedRing(ring) {
+			continue
+		}
+		// Площадь контура учитывается независимо от направления обхода
+		sign := 1.0
+		if ringArea(ring) < 0 {
+			sign = -1.0
+		}
+		for i := 1; i < len(ring); i++ {
+			prevLat, prevLon := ring[i-1].Lat-origin.Lat, ring[i-1].Lon-origin.Lon
+			pointLat, pointLon := ring[i].Lat-origin.Lat, ring[i].Lon-origin.Lon
+			cross := sign * (prevLon*pointLat - pointLon*prevLat)
+			area += cross
+			lon += (prevLon + pointLon) * cross
+			lat += (prevLat + pointLat) * cross
+		}
+	}
+	if count == 0 {
+		return GeoPoint{}, false
+	}
+	if math.Abs(area) < 1e-12 {
+		return GeoPoint{Lat: sumLat / float64(count), Lon: sumLon / float64(count)}, true
+	}
+
+	return GeoPoint{Lat: origin.Lat + lat/(3*area), Lon: origin.Lon + lon/(3*area)}, true
+}
+
+// Получить ориентированную площадь контура в градусах
+func ringArea(ring []GeoPoint) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1].Lon*ring[i].Lat - ring[i].Lon*ring[i-1].Lat
+	}
+
+	return area / 2
+}
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"math"
+	"testing"
+)
+
+// Квадрат 2x2 градуса с центром в точке (1, 1), обход против часовой стрелки
+var testSquare = []GeoPoint{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {0, 0}}
+
+// Проверить совпадение координат с точностью до погрешности вычислений
+func equalPoints(a GeoPoint, b GeoPoint) bool {
+	return math.Abs(a.Lat-b.Lat) < 1e-9 && math.Abs(a.Lon-b.Lon) < 1e-9
+}
+
+func TestJoinGeoRings(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines [][]GeoPoint
+		rings int
+	}{
+		{"closed ring", [][]GeoPoint{testSquare}, 1},
+		{"open line", [][]GeoPoint{{{0, 0}, {0, 2}, {2, 2}}}, 0},
+		{"joined segments", [][]GeoPoint{{{0, 0}, {0, 2}, {2, 2}}, {{2, 2}, {2, 0}, {0, 0}}}, 1},
+		{"reversed segment", [][]GeoPoint{{{0, 0}, {0, 2}, {2, 2}}, {{0, 0}, {2, 0}, {2, 2}}}, 1},
+		{"missing segment", [][]GeoPoint{{{0, 0}, {0, 2}}, {{2, 2}, {2, 0}, {0, 0}}}, 0},
+		{"ring and joined triangle", [][]GeoPoint{testSquare, {{5, 5}, {5, 6}}, {{6, 6}, {5, 6}}, {{6, 6}, {5, 5}}}, 2},
+		{"short lines", [][]GeoPoint{{{0, 0}}, nil}, 0},
+	}
+	for _, test := range tests {
+		rings := JoinGeoRings(test.lines)
+		if len(rings) != test.rings {
+			t.Errorf("%s: got %d rings, want %d", test.name, len(rings), test.rings)
+			continue
+		}
+		for _, ring := range rings {
+			if !IsClosedRing(ring) {
+				t.Errorf("%s: ring %v is not closed", test.name, ring)
+			}
+		}
+	}
+}
+
+func TestGeoCentroid(t *testing.T) {
+	reversed := make([]GeoPoint, len(testSquare))
+	for i, point := range testSquare {
+		reversed[len(testSquare)-1-i] = point
+	}
+	tests := []struct {
+		name   string
+		rings  [][]GeoPoint
+		center GeoPoint
+		ok     bool
+	}{
+		{"closed ring", [][]GeoPoint{testSquare}, GeoPoint{1, 1}, true},
+		{"reversed ring", [][]GeoPoint{reversed}, GeoPoint{1, 1}, true},
+		{"open line", [][]GeoPoint{{{0, 0}, {0, 2}, {2, 2}}}, GeoPoint{2.0 / 3, 4.0 / 3}, true},
+		{"far from origin", [][]GeoPoint{{{55, 82}, {55, 83}, {56, 83}, {56, 82}, {55, 82}}}, GeoPoint{55.5, 82.5}, true},
+		{"two rings", [][]GeoPoint{testSquare, {{10, 10}, {10, 12}, {12, 12}, {12, 10}, {10, 10}}}, GeoPoint{6, 6}, true},
+		{"zero area", [][]GeoPoint{{{0, 0}, {0, 2}, {0, 1}, {0, 0}}}, GeoPoint{0, 0.75}, true},
+		{"empty", nil, GeoPoint{}, false},
+	}
+	for _, test := range tests {
+		center, ok := GeoCentroid(test.rings)
+		if ok != test.ok || !equalPoints(center, test.center) {
+			t.Errorf("%s: got %v %v, want %v %v", test.name, center, ok, test.center, test.ok)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package util
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Инициализация http-транспорта с таймаутами и прокси
+func NewHttpTransport(proxy string, timeout time.Duration) (*http.Transport, error) {
+	// По умолчанию прокси берется из переменных окружения HTTP_PROXY/HTTPS_PROXY
+	proxyFunc := http.ProxyFromEnvironment
+	if proxy != "" {
+		proxyUrl, err := url.Parse(proxy)
+		if err != nil {
+			return nil, err
+		}
+		proxyFunc = http.ProxyURL(proxyUrl)
+	}
+
+	return &http.Transport{
+		Proxy: proxyFunc,
+		DialContext: (&net.Dialer{
+			Timeout:   timeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConns:          20,
+		MaxIdleConnsPerHost:   20,
+	}, nil
+}
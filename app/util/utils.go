@@ -1,6 +1,9 @@
 package util
 
-import "sort"
+import (
+	"sort"
+	"strings"
+)
 
 var TimeFormat = "2006-01-02T15:04:00Z"
 
@@ -30,6 +33,25 @@ func UniqueStringSlice(slice []string) []string {
 	return list
 }
 
+// Разобрать список кодов регионов, перечисленных через запятую
+func SplitRegions(value string) []string {
+	var regions []string
+	for _, region := range strings.Split(value, ",") {
+		region = strings.TrimSpace(region)
+		// Дополняет однозначный код региона ведущим нулем, как в файлах ФИАС
+		if len(region) == 1 {
+			region = "0" + region
+		}
+		if region != "" {
+			regions = append(regions, region)
+		}
+	}
+	regions = UniqueStringSlice(regions)
+	sort.Strings(regions)
+
+	return regions
+}
+
 // Конвертировать массив строк в массив итерфейсов
 func ConvertStringSliceToInterface(slice []string) []interface{} {
 	newSlice := make([]interface{}, len(slice))
@@ -56,3 +78,13 @@ func ConvertFloat32SliceToInterface(slice []float32) []interface{} {
 
 	return newSlice
 }
+
+// Конвертировать массив целых чисел в массив итерфейсов
+func ConvertIntSliceToInterface(slice []int) []interface{} {
+	newSlice := make([]interface{}, len(slice))
+	for i := range slice {
+		newSlice[i] = slice[i]
+	}
+
+	return newSlice
+}
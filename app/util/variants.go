@@ -0,0 +1,125 @@
+package util
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Вариант поискового запроса
+type TermVariant struct {
+	Term  string // Текст запроса
+	Fuzzy bool   // Поиск с учетом опечаток
+}
+
+// Соответствие клавиш английской раскладки русской, клавиши с Shift дают те же буквы, так как запрос приводится к нижнему регистру
+var enToRuLayout = map[rune]rune{
+	'q': 'й', 'w': 'ц', 'e': 'у', 'r': 'к', 't': 'е', 'y': 'н', 'u': 'г', 'i': 'ш', 'o': 'щ', 'p': 'з', '[': 'х', ']': 'ъ',
+	'a': 'ф', 's': 'ы', 'd': 'в', 'f': 'а', 'g': 'п', 'h': 'р', 'j': 'о', 'k': 'л', 'l': 'д', ';': 'ж', '\'': 'э',
+	'z': 'я', 'x': 'ч', 'c': 'с', 'v': 'м', 'b': 'и', 'n': 'т', 'm': 'ь', ',': 'б', '.': 'ю', '`': 'ё',
+	'{': 'х', '}': 'ъ', ':': 'ж', '"': 'э', '<': 'б', '>': 'ю', '~': 'ё',
+}
+
+// Правила транслитерации латиницы в кириллицу, от длинных сочетаний к коротким
+var translitRules = []struct {
+	latin    string
+	cyrillic string
+}{
+	{"shch", "щ"}, {"sch", "щ"}, {"iy", "ий"}, {"yy", "ый"}, {"zh", "ж"}, {"kh", "х"}, {"ts", "ц"}, {"ch", "ч"}, {"sh", "ш"},
+	{"yu", "ю"}, {"ya", "я"}, {"yo", "е"}, {"ye", "е"}, {"'", "ь"},
+	{"a", "а"}, {"b", "б"}, {"v", "в"}, {"w", "в"}, {"g", "г"}, {"d", "д"}, {"e", "е"}, {"z", "з"}, {"i", "и"},
+	{"j", "й"}, {"k", "к"}, {"q", "к"}, {"l", "л"}, {"m", "м"}, {"n", "н"}, {"o", "о"}, {"p", "п"}, {"r", "р"},
+	{"s", "с"}, {"t", "т"}, {"u", "у"}, {"f", "ф"}, {"h", "х"}, {"c", "ц"}, {"x", "кс"}, {"y", "ы"},
+}
+
+// Проверяет, является ли символ буквой латиницы
+func isLatinLetter(r rune) bool {
+	return r < unicode.MaxASCII && unicode.IsLetter(r)
+}
+
+// Проверяет, является ли символ гласной латиницы
+func isLatinVowel(c byte) bool {
+	return strings.IndexByte("aeiouy", c) >= 0
+}
+
+// Проверяет наличие латинских букв в строке
+func hasLatin(term string) bool {
+	for _, r := range term {
+		if isLatinLetter(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Переключить раскладку клавиатуры строки с английской на русскую
+func SwitchLayoutToCyrillic(term string) string {
+	runes := []rune(strings.ToLower(term))
+	result := make([]rune, len(runes))
+	for i, r := range runes {
+		result[i] = r
+		if ru, ok := enToRuLayout[r]; ok {
+			// Знаки препинания заменяются только в начале и внутри слов, набранных латиницей
+			if !isLatinLetter(r) && (i == len(runes)-1 || !isLatinLetter(runes[i+1])) {
+				continue
+			}
+			result[i] = ru
+		}
+	}
+
+	return string(result)
+}
+
+// Транслитерировать латиницу в кириллицу
+func TranslitToCyrillic(term string) string {
+	term = strings.ToLower(term)
+	var result strings.Builder
+	var prev byte
+	for len(term) > 0 {
+		// Буква "y" после гласной в конце слога обозначает "й"
+		if term[0] == 'y' && result.Len() > 0 && isLatinVowel(prev) && (len(term) == 1 || !isLatinVowel(term[1])) {
+			result.WriteString("й")
+			prev, term = term[0], term[1:]
+			continue
+		}
+		matched := false
+		for _, rule := range translitRules {
+			if strings.HasPrefix(term, rule.latin) {
+				result.WriteString(rule.cyrillic)
+				prev, term = term[len(rule.latin)-1], term[len(rule.latin):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			r := []rune(term)[0]
+			result.WriteRune(r)
+			prev, term = term[0], term[len(string(r)):]
+		}
+	}
+
+	return result.String()
+}
+
+// Получить варианты поискового запроса в порядке убывания точности:
+// исходный запрос, запрос в другой раскладке и транслитерация, затем те же запросы с учетом опечаток
+func PrepareTermVariants(term string) []TermVariant {
+	terms := []string{term}
+	if hasLatin(term) {
+		terms = append(terms, SwitchLayoutToCyrillic(term), TranslitToCyrillic(term))
+	}
+	terms = UniqueStringSlice(terms)
+
+	var variants []TermVariant
+	for _, item := range terms {
+		variants = append(variants, TermVariant{Term: item})
+	}
+	// Поиск с опечатками выполняется только для запросов без латиницы, так как адреса хранятся в кириллице
+	for _, item := range terms {
+		if !hasLatin(item) {
+			variants = append(variants, TermVariant{Term: item, Fuzzy: true})
+		}
+	}
+
+	return variants
+}
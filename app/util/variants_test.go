@@ -0,0 +1,97 @@
+package util
+
+import (
+	"testing"
+)
+
+// Переключение раскладки, включая клавиши с Shift в начале и внутри слов
+func TestSwitchLayoutToCyrillic(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{"vjcrdf", "москва"},
+		{"Vjcrdf", "москва"},
+		{"ktybyf", "ленина"},
+		{",thlcr", "бердск"},
+		{"<thlcr", "бердск"},
+		{"{fkmpjdf", "хальзова"},
+		{"j:fy", "ожан"},
+		{"\"ktrnhjcnfkm", "электросталь"},
+		{"~kjxrf", "ёлочка"},
+		{"gjl]tpl", "подъезд"},
+		{"gjl}tpl", "подъезд"},
+		{"vjcrdf, ktybyf", "москва, ленина"},
+		{"jnltkm.", "отдель."},
+		{"ktybyf 7", "ленина 7"},
+		{"москва", "москва"},
+	}
+	for _, test := range tests {
+		if got := SwitchLayoutToCyrillic(test.term); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.term, got, test.want)
+		}
+	}
+}
+
+// Транслитерация латиницы с сочетаниями букв и "й" после гласной
+func TestTranslitToCyrillic(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{"moskva", "москва"},
+		{"Novosibirsk", "новосибирск"},
+		{"ulitsa lenina", "улица ленина"},
+		{"shchukino", "щукино"},
+		{"zhukovka", "жуковка"},
+		{"yakutsk", "якуцк"},
+		{"khabarovsk", "хабаровск"},
+		{"chelyabinsk", "челябинск"},
+		{"krasnyy", "красный"},
+		{"tverskaya", "тверская"},
+		{"maykop", "майкоп"},
+		{"obl'", "обль"},
+		{"москва 7", "москва 7"},
+	}
+	for _, test := range tests {
+		if got := TranslitToCyrillic(test.term); got != test.want {
+			t.Errorf("%q: got %q, want %q", test.term, got, test.want)
+		}
+	}
+}
+
+// Варианты запроса: исходный, другая раскладка и транслитерация, затем запросы без латиницы с учетом опечаток
+func TestPrepareTermVariants(t *testing.T) {
+	tests := []struct {
+		term string
+		want []TermVariant
+	}{
+		{"vjcrdf", []TermVariant{
+			{Term: "vjcrdf"}, {Term: "москва"}, {Term: "вйцрдф"},
+			{Term: "москва", Fuzzy: true}, {Term: "вйцрдф", Fuzzy: true},
+		}},
+		{"Масква", []TermVariant{
+			{Term: "Масква"}, {Term: "Масква", Fuzzy: true},
+		}},
+		{"moskva", []TermVariant{
+			{Term: "moskva"}, {Term: "ьщылмф"}, {Term: "москва"},
+			{Term: "ьщылмф", Fuzzy: true}, {Term: "москва", Fuzzy: true},
+		}},
+		{"7", []TermVariant{
+			{Term: "7"}, {Term: "7", Fuzzy: true},
+		}},
+	}
+	for _, test := range tests {
+		got := PrepareTermVariants(test.term)
+		if len(got) != len(test.want) {
+			t.Errorf("%q: got %v, want %v", test.term, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("%q: got %v, want %v", test.term, got, test.want)
+				break
+			}
+		}
+	}
+}
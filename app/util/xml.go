@@ -4,40 +4,83 @@ import (
 	"bufio"
 	"github.com/GarinAG/gofias/interfaces"
 	"github.com/tamerh/xml-stream-parser"
-	"os"
+	"io"
 	"sync"
 )
 
+// Количество элементов между контрольными точками разбора файла
+const checkpointStep = 10000
+
 // Интерфейс функции разбора XML-файла
 type ParseElement func(element *xmlparser.XMLElement) (interface{}, error)
 
-// Чтение и разбор XML-файла
-func ParseFile(wg *sync.WaitGroup, fileName string, c chan<- interface{}, logger interfaces.LoggerInterface, ParseElement ParseElement, xmlName string, total int) {
+// Интерфейс функции сохранения контрольной точки разбора файла
+type Checkpoint func(offset int)
+
+// Контрольная точка разбора файла, передается в канал вместе с элементами
+type CheckpointObject struct {
+	Offset int    // Количество обработанных элементов файла
+	Commit func() // Сохранить контрольную точку
+}
+
+// Потоковый разбор XML-файла, reader может быть файлом на диске или файлом в архиве
+func ParseFile(wg *sync.WaitGroup, reader io.Reader, fileName string, c chan<- interface{}, logger interfaces.LoggerInterface, ParseElement ParseElement, xmlName string, total int, offset int, checkpoint Checkpoint) {
 	defer wg.Done()
-	logger.WithFields(interfaces.LoggerFields{"fileName": fileName}).Info("Start parse xml file")
-	// Открывает файл для чтения
-	f, err := os.Open(fileName)
-	if err != nil {
-		logger.WithFields(interfaces.LoggerFields{"error": err}).Error("Error opening file")
-	}
-	defer f.Close()
+	logger.WithFields(interfaces.LoggerFields{"fileName": fileName, "offset": offset}).Info("Start parse xml file")
 
-	// Создает reader
-	br := bufio.NewReaderSize(f, 65536)
+	// Создает reader, ошибки чтения парсер не возвращает, поэтому они запоминаются отдельно
+	er := &errorReader{reader: reader}
+	br := bufio.NewReaderSize(er, 65536)
 	parser := xmlparser.NewXMLParser(br, xmlName).ParseAttributesOnly(xmlName)
 
 	// Создает прогресс-бар
 	bar := StartNewProgress(total, "Parsing XML "+xmlName, false)
 
 	// Читает объекты в XML-файле
+	position := 0
 	for xml := range parser.Stream() {
-		data, err := ParseElement(xml)
+		position++
 		bar.Increment()
+		// Пропускает элементы, обработанные при прошлом запуске
+		if position <= offset {
+			continue
+		}
+		data, err := ParseElement(xml)
 		if err == nil && data != nil {
 			c <- data
 		}
+		// Передает контрольную точку после сохранения предыдущих элементов
+		if checkpoint != nil && position%checkpointStep == 0 {
+			current := position
+			c <- CheckpointObject{
+				Offset: current,
+				Commit: func() {
+					checkpoint(current)
+				},
+			}
+		}
 	}
 	bar.Finish()
+	// Прерывает импорт, если файл прочитан не полностью, например, при ошибке контрольной суммы архива
+	if er.err != nil {
+		logger.WithFields(interfaces.LoggerFields{"fileName": fileName, "error": er.err}).Fatal("Read xml file error")
+	}
 	close(c)
 	logger.WithFields(interfaces.LoggerFields{"fileName": fileName}).Info("Parse finished")
 }
+
+// Reader, запоминающий первую ошибку чтения
+type errorReader struct {
+	reader io.Reader // Исходный reader
+	err    error     // Ошибка чтения
+}
+
+// Прочитать данные
+func (r *errorReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+
+	return n, err
+}
@@ -0,0 +1,110 @@
+package util
+
+import (
+	"github.com/GarinAG/gofias/interfaces"
+	"github.com/tamerh/xml-stream-parser"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Логгер, не выводящий сообщения
+type testLogger struct{}
+
+func (l testLogger) Debug(format string, args ...interface{})  {}
+func (l testLogger) Info(format string, args ...interface{})   {}
+func (l testLogger) Warn(format string, args ...interface{})   {}
+func (l testLogger) Error(format string, args ...interface{})  {}
+func (l testLogger) Fatal(format string, args ...interface{})  {}
+func (l testLogger) Panic(format string, args ...interface{})  {}
+func (l testLogger) Printf(format string, args ...interface{}) {}
+func (l testLogger) WithFields(keyValues interfaces.LoggerFields) interfaces.LoggerInterface {
+	return l
+}
+
+// XML-файл с элементами ITEM, пронумерованными с единицы
+func testXml(count int) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="utf-8"?><ITEMS>`)
+	for i := 1; i <= count; i++ {
+		b.WriteString(`<ITEM ID="` + strconv.Itoa(i) + `" />`)
+	}
+	b.WriteString(`</ITEMS>`)
+
+	return b.String()
+}
+
+// Разбор элемента в номер, элементы с номером, кратным skip, пропускаются
+func testParseElement(skip int) ParseElement {
+	return func(element *xmlparser.XMLElement) (interface{}, error) {
+		id, err := strconv.Atoi(element.Attrs["ID"])
+		if err != nil || (skip > 0 && id%skip == 0) {
+			return nil, err
+		}
+
+		return id, nil
+	}
+}
+
+// Разбор продолжается после сохраненного смещения, контрольные точки передаются после элементов до них
+func TestParseFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		count       int
+		offset      int
+		skip        int
+		first       int
+		items       int
+		checkpoints []int
+	}{
+		{"from start", 25000, 0, 0, 1, 25000, []int{10000, 20000}},
+		{"resume from checkpoint", 25000, 10000, 0, 10001, 15000, []int{20000}},
+		{"resume between checkpoints", 25000, 15000, 0, 15001, 10000, []int{20000}},
+		{"resume from end", 25000, 25000, 0, 0, 0, nil},
+		{"skipped elements", 20000, 0, 10000, 1, 19998, []int{10000, 20000}},
+	}
+	for _, test := range tests {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		channel := make(chan interface{})
+		var committed []int
+		checkpoint := func(offset int) {
+			committed = append(committed, offset)
+		}
+		go ParseFile(&wg, strings.NewReader(testXml(test.count)), test.name, channel, testLogger{}, testParseElement(test.skip), "ITEM", -1, test.offset, checkpoint)
+
+		var items []int
+		last := test.offset
+		for d := range channel {
+			if c, ok := d.(CheckpointObject); ok {
+				// Контрольная точка следует сразу за последним элементом до нее
+				if c.Offset < last || c.Offset-last >= checkpointStep {
+					t.Errorf("%s: checkpoint %d after item %d", test.name, c.Offset, last)
+				}
+				c.Commit()
+				continue
+			}
+			last = d.(int)
+			items = append(items, last)
+		}
+		wg.Wait()
+
+		if len(items) != test.items {
+			t.Errorf("%s: got %d items, want %d", test.name, len(items), test.items)
+		}
+		if len(items) > 0 && items[0] != test.first {
+			t.Errorf("%s: got first item %d, want %d", test.name, items[0], test.first)
+		}
+		if len(committed) != len(test.checkpoints) {
+			t.Errorf("%s: got checkpoints %v, want %v", test.name, committed, test.checkpoints)
+			continue
+		}
+		for i := range committed {
+			if committed[i] != test.checkpoints[i] {
+				t.Errorf("%s: got checkpoints %v, want %v", test.name, committed, test.checkpoints)
+				break
+			}
+		}
+	}
+}